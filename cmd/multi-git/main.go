@@ -4,15 +4,45 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/alexgim961101/multi-git/internal/commands"
+	"github.com/alexgim961101/multi-git/internal/git"
 	"github.com/spf13/cobra"
 )
 
 var (
-	version    = "1.0.0"
-	configPath string
-	verbose    bool
+	version        = "1.0.0"
+	commit         = "" // -ldflags "-X main.commit=..."로 설정
+	buildDate      = "" // -ldflags "-X main.buildDate=..."로 설정
+	configPath     string
+	profile        string
+	overlays       []string
+	verbose        bool
+	groups         []string
+	repos          []string
+	estimate       bool
+	noProgress     bool
+	noHooks        bool
+	timeout        time.Duration
+	repoTimeout    time.Duration
+	errorBudget    int
+	throttle       float64
+	resume         bool
+	interactive    bool
+	logFile        string
+	logLevel       string
+	report         string
+	discover       string
+	discoverDepth  int
+	discoverIgnore []string
+	onlyDirty      bool
+	onlyClean      bool
+	onlyOnBranch   string
+	onlyBehind     bool
+	timing         bool
+	notifyDesktop  bool
+	notifyAfter    time.Duration
 )
 
 var rootCmd = &cobra.Command{
@@ -21,6 +51,12 @@ var rootCmd = &cobra.Command{
 	Long: `Multi-Git is a CLI tool that helps DevOps engineers efficiently manage multiple Git repositories.
 It provides commands to clone, checkout, tag, and push across multiple repositories simultaneously.`,
 	Version: version,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if err := commands.SetupLogging(logFile, logLevel); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		// Root command without subcommand - show help
 		cmd.Help()
@@ -35,15 +71,88 @@ func init() {
 	defaultConfigPath := filepath.Join(homeDir, ".multi-git", "config.yaml")
 
 	rootCmd.PersistentFlags().StringVarP(&configPath, "config", "c", defaultConfigPath, "config file path")
+	rootCmd.PersistentFlags().StringVar(&profile, "profile", "", "config profile to use (default: $MULTI_GIT_PROFILE, or the top-level settings)")
+	rootCmd.PersistentFlags().StringSliceVar(&overlays, "overlay", nil, "overlay files applied over the config in order, adding, changing, or removing repositories (repeatable)")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "enable verbose output")
+	rootCmd.PersistentFlags().StringSliceVarP(&groups, "group", "g", nil, "only operate on repositories in these groups (repeatable or comma-separated)")
+	rootCmd.PersistentFlags().StringSliceVar(&repos, "repos", nil, "only operate on these repositories, by name or glob (e.g. \"api-*,web\")")
+	rootCmd.PersistentFlags().BoolVar(&onlyDirty, "only-dirty", false, "only operate on repositories with uncommitted changes")
+	rootCmd.PersistentFlags().BoolVar(&onlyClean, "only-clean", false, "only operate on repositories without uncommitted changes")
+	rootCmd.PersistentFlags().StringVar(&onlyOnBranch, "only-on-branch", "", "only operate on repositories whose current branch is this one ('@default' for each repo's default_branch)")
+	rootCmd.PersistentFlags().BoolVar(&onlyBehind, "only-behind", false, "only operate on repositories whose current branch is behind its upstream (as of the last fetch)")
+	rootCmd.PersistentFlags().BoolVarP(&interactive, "interactive", "i", false, "pick the repositories to operate on from a list before running")
+	rootCmd.PersistentFlags().StringVar(&logFile, "log-file", "", "append a log of the run to this file")
+	rootCmd.PersistentFlags().StringVar(&logLevel, "log-level", "info", "log level for --log-file and per-repository logs (debug, info, warn, error)")
+	rootCmd.PersistentFlags().BoolVar(&noProgress, "no-progress", false, "do not show progress bars (e.g. in CI logs)")
+	rootCmd.PersistentFlags().BoolVar(&noHooks, "no-hooks", false, "do not run the pre/post hooks configured in 'hooks'")
+	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 0, "stop waiting for repositories after this long in total (default: config.command_timeout, 0 = no limit)")
+	rootCmd.PersistentFlags().DurationVar(&repoTimeout, "repo-timeout", 0, "report a repository as timed out after this long (default: config.repo_timeout, 0 = no limit)")
+	rootCmd.PersistentFlags().Float64Var(&throttle, "throttle", 0, "start at most this many network operations (clone, fetch, mirror, pull, push, sync) per second (default: config.max_ops_per_second, 0 = no limit)")
+	rootCmd.PersistentFlags().IntVar(&errorBudget, "error-budget", -1, "exit 0 unless more than this many repositories fail with hard (non-network) errors (-1 = disabled)")
+	rootCmd.PersistentFlags().BoolVar(&resume, "resume", false, "continue the interrupted run of the same command, skipping repositories it completed")
+	rootCmd.PersistentFlags().BoolVar(&estimate, "estimate", false, "print the estimated duration from previous runs and exit without running")
+	rootCmd.PersistentFlags().StringVar(&discover, "discover", "", "operate on the git repositories found in this directory instead of the config file")
+	rootCmd.PersistentFlags().IntVar(&discoverDepth, "discover-depth", git.DefaultDiscoverDepth, "how many directory levels below --discover to search for repositories")
+	rootCmd.PersistentFlags().StringSliceVar(&discoverIgnore, "discover-ignore", nil, "directories not searched with --discover, by name or relative path glob (in addition to hidden directories, node_modules, and vendor)")
+	rootCmd.PersistentFlags().BoolVar(&timing, "timing", false, "print duration percentiles, the slowest repositories, and per-step timings after the summary")
+	rootCmd.PersistentFlags().BoolVar(&notifyDesktop, "notify-desktop", false, "show a desktop notification with the result when a run takes longer than --notify-after")
+	rootCmd.PersistentFlags().DurationVar(&notifyAfter, "notify-after", 30*time.Second, "minimum duration of a run for --notify-desktop to notify")
+	rootCmd.PersistentFlags().StringVar(&report, "report", "", "write a report of the run (per-repository results, durations, error types) to this file (.json, or .yaml/.yml)")
+
+	commands.RegisterGlobalCompletions(rootCmd)
+
+	commands.SetBuildInfo(commands.BuildInfo{
+		Version: version,
+		Commit:  commit,
+		Date:    buildDate,
+	})
 
 	// Register subcommands
 	rootCmd.AddCommand(commands.GetCloneCmd())
 	rootCmd.AddCommand(commands.GetCheckoutCmd())
+	rootCmd.AddCommand(commands.GetCommitCmd())
+	rootCmd.AddCommand(commands.GetStashCmd())
+	rootCmd.AddCommand(commands.GetSnapshotCmd())
+	rootCmd.AddCommand(commands.GetUndoCmd())
 	rootCmd.AddCommand(commands.GetTagCmd())
+	rootCmd.AddCommand(commands.GetBranchCmd())
+	rootCmd.AddCommand(commands.GetBranchMatrixCmd())
+	rootCmd.AddCommand(commands.GetUnmergedCmd())
+	rootCmd.AddCommand(commands.GetRemoteCmd())
 	rootCmd.AddCommand(commands.GetPushCmd())
+	rootCmd.AddCommand(commands.GetUnpushedCmd())
 	rootCmd.AddCommand(commands.GetPullCmd())
+	rootCmd.AddCommand(commands.GetFetchCmd())
+	rootCmd.AddCommand(commands.GetDiffCmd())
+	rootCmd.AddCommand(commands.GetCompareTagsCmd())
+	rootCmd.AddCommand(commands.GetVerifyCmd())
+	rootCmd.AddCommand(commands.GetLogCmd())
+	rootCmd.AddCommand(commands.GetFileLogCmd())
+	rootCmd.AddCommand(commands.GetFormatPatchCmd())
+	rootCmd.AddCommand(commands.GetAmCmd())
+	rootCmd.AddCommand(commands.GetWatchRemotesCmd())
+	rootCmd.AddCommand(commands.GetMirrorSyncCmd())
+	rootCmd.AddCommand(commands.GetMirrorCmd())
+	rootCmd.AddCommand(commands.GetBundleCmd())
+	rootCmd.AddCommand(commands.GetSyncCmd())
+	rootCmd.AddCommand(commands.GetRevertReleaseCmd())
+	rootCmd.AddCommand(commands.GetUpdateDepsCmd())
+	rootCmd.AddCommand(commands.GetExportGraphCmd())
 	rootCmd.AddCommand(commands.GetExecCmd())
+	rootCmd.AddCommand(commands.GetOpenCmd())
+	rootCmd.AddCommand(commands.GetPathCmd())
+	rootCmd.AddCommand(commands.GetViewCmd())
+	rootCmd.AddCommand(commands.GetShellInitCmd())
+	rootCmd.AddCommand(commands.GetCompletionCmd())
+	rootCmd.AddCommand(commands.GetPolicyCmd())
+	rootCmd.AddCommand(commands.GetServeCmd())
+	rootCmd.AddCommand(commands.GetScheduleCmd())
+	rootCmd.AddCommand(commands.GetMetricsCmd())
+	rootCmd.AddCommand(commands.GetCacheCmd())
+	rootCmd.AddCommand(commands.GetConfigCmd())
+	rootCmd.AddCommand(commands.GetDoctorCmd())
+	rootCmd.AddCommand(commands.GetInfoCmd())
+	rootCmd.AddCommand(commands.GetVersionCmd())
 }
 
 func Execute() {
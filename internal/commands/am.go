@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Am 플래그 변수
+var (
+	amThreeWay bool // 패치가 그대로 적용되지 않으면 3-way merge 시도
+	amParallel int  // 병렬 처리 수
+)
+
+var amCmd = &cobra.Command{
+	Use:   "am <dir>",
+	Short: "Apply the patches exported by format-patch",
+	Long: `Apply a directory written by 'multi-git format-patch' to the repositories
+of this configuration, one commit per patch, on the current branch.
+
+Repositories are matched by name. A series is only applied if its base commit
+exists in the repository, so fetch or transfer the base first. If a patch does
+not apply, the series of that repository is aborted and the repository is left
+unchanged. Repositories without patches in the directory are skipped.
+
+Applying patches uses the git binary.
+
+Examples:
+  # Apply the exported patches
+  multi-git am patches/
+
+  # Fall back to a 3-way merge where the files have changed
+  multi-git am release-1.1 --3way`,
+	Args: cobra.ExactArgs(1),
+	Run:  runAm,
+}
+
+func init() {
+	amCmd.Flags().BoolVar(&amThreeWay, "3way", false,
+		"Fall back to a 3-way merge if a patch does not apply cleanly")
+	amCmd.Flags().IntVarP(&amParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runAm(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. manifest 읽기
+	dir, err := filepath.Abs(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid patch directory: %v\n", err)
+		os.Exit(1)
+	}
+	manifest, err := readPatchManifest(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "  hint: export the patches with 'multi-git format-patch --from <ref>'\n")
+		os.Exit(1)
+	}
+	series := make(map[string]patchManifestSeries, len(manifest.Repositories))
+	for _, s := range manifest.Repositories {
+		series[s.Name] = s
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 설정에 없는 저장소의 패치 경고
+	configured := make(map[string]bool, len(cfg.Repositories))
+	for _, repo := range cfg.Repositories {
+		configured[repo.Name] = true
+	}
+	for _, s := range manifest.Repositories {
+		if !configured[s.Name] {
+			fmt.Fprintf(os.Stderr, "Warning: patches for '%s' are not applied (no such repository in the config)\n", s.Name)
+		}
+	}
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 병렬 수 결정
+	workers := amParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 6. Am Task 정의
+	amTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+
+		s, ok := series[repo.Name]
+		if !ok || len(s.Patches) == 0 {
+			return skip("skipped: no patches"), nil
+		}
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// 이미 적용된 시리즈 스킵 (내보낸 커밋이 HEAD에 포함됨)
+		head, err := client.GetCommitAtRevision("HEAD")
+		if err != nil {
+			return fail(err), nil
+		}
+		if exported, err := client.GetCommitAtRevision(s.Head); err == nil {
+			if applied, err := exported.IsAncestor(head); err == nil && (applied || exported.Hash == head.Hash) {
+				return skip("skipped: already applied"), nil
+			}
+		}
+
+		// 시리즈의 기준 커밋이 있어야 적용 가능
+		if _, err := client.GetCommitAtRevision(s.Base); err != nil {
+			return fail(fmt.Errorf("base commit %s of the patches not found\n  hint: fetch '%s' first", s.Base, manifest.From)), nil
+		}
+
+		hasChanges, err := client.HasLocalChanges()
+		if err != nil {
+			return fail(fmt.Errorf("failed to check local changes: %w", err)), nil
+		}
+		if hasChanges {
+			return fail(fmt.Errorf("repository has uncommitted changes\n  hint: commit or stash them first")), nil
+		}
+
+		files := make([]string, 0, len(s.Patches))
+		for _, name := range s.Patches {
+			files = append(files, filepath.Join(dir, filepath.Base(s.Name), filepath.Base(name)))
+		}
+		if err := client.ApplyPatches(files, amThreeWay); err != nil {
+			return fail(err), nil
+		}
+
+		result.Success = true
+		result.Message = fmt.Sprintf("%s applied", plural(len(files), "patch"))
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Applying patches %s..%s from %s", manifest.From, manifest.To, dir))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, amTask)
+
+	// 8. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+func GetAmCmd() *cobra.Command {
+	return amCmd
+}
@@ -0,0 +1,320 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Branch 플래그 변수
+var (
+	branchList         bool   // 브랜치 목록 출력
+	branchCreate       string // 생성할 브랜치 이름
+	branchFrom         string // 생성 시작 브랜치 (기본: HEAD)
+	branchDelete       string // 삭제할 브랜치 이름
+	branchDeleteRemote bool   // 원격 브랜치도 삭제
+	branchRemote       string // 원격 이름
+	branchParallel     int    // 병렬 처리 수
+)
+
+var branchCmd = &cobra.Command{
+	Use:   "branch",
+	Short: "List, create, or delete branches across all repositories",
+	Long: `List, create, or delete local branches across all managed repositories.
+Without flags, the local branches of each repository are listed.
+
+Examples:
+  # List branches ('*' marks the current branch)
+  multi-git branch --list
+
+  # Create a branch from the current HEAD without checking it out
+  multi-git branch --create feature/login
+
+  # Create a branch from each repository's default_branch
+  multi-git branch --create hotfix/1.0.1 --from @default
+
+  # Delete a branch locally and on the remote
+  multi-git branch --delete feature/login --delete-remote`,
+	Args: cobra.NoArgs,
+	Run:  runBranch,
+}
+
+func init() {
+	branchCmd.Flags().BoolVarP(&branchList, "list", "l", false,
+		"List local branches (default when no other action is given)")
+	branchCmd.Flags().StringVar(&branchCreate, "create", "",
+		"Create a branch with this name")
+	branchCmd.Flags().StringVar(&branchFrom, "from", "",
+		"Branch to create from (default: HEAD, '@default' for each repo's default_branch)")
+	branchCmd.Flags().StringVar(&branchDelete, "delete", "",
+		"Delete the branch with this name")
+	_ = branchCmd.RegisterFlagCompletionFunc("from", completeBranchOrDefault)
+	_ = branchCmd.RegisterFlagCompletionFunc("delete", completeBranchNames)
+	branchCmd.Flags().BoolVar(&branchDeleteRemote, "delete-remote", false,
+		"Also delete the branch on the remote (with --delete)")
+	branchCmd.Flags().StringVarP(&branchRemote, "remote", "r", "",
+		"Remote name for --delete-remote (default: config default_remote)")
+	branchCmd.Flags().IntVarP(&branchParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runBranch(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 플래그 유효성 검증: 작업은 하나만 지정
+	actions := 0
+	for _, set := range []bool{branchList, branchCreate != "", branchDelete != ""} {
+		if set {
+			actions++
+		}
+	}
+	if actions > 1 {
+		fmt.Fprintf(os.Stderr, "Error: --list, --create, and --delete cannot be used together\n")
+		os.Exit(1)
+	}
+	if branchDeleteRemote && branchDelete == "" {
+		fmt.Fprintf(os.Stderr, "Error: --delete-remote requires --delete\n")
+		os.Exit(1)
+	}
+	if branchFrom != "" && branchCreate == "" {
+		fmt.Fprintf(os.Stderr, "Error: --from requires --create\n")
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 병렬 수 및 원격 결정
+	workers := branchParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	remoteName := branchRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 6. 작업 모드에 따라 실행
+	ctx := context.Background()
+	var summary *repository.Summary
+
+	switch {
+	case branchCreate != "":
+		reporter.PrintHeader(fmt.Sprintf("Creating branch '%s'", branchCreate))
+		summary = executeTasks(ctx, cmd, mgr, reporter, workers, branchCreateTask(mgr))
+		reporter.PrintFullReport(summary)
+	case branchDelete != "":
+		reporter.PrintHeader(fmt.Sprintf("Deleting branch '%s'", branchDelete))
+		summary = executeTasks(ctx, cmd, mgr, reporter, workers, branchDeleteTask(mgr, remoteName))
+		reporter.PrintFullReport(summary)
+	default:
+		reporter.PrintHeader("Listing branches")
+		summary = executeTasks(ctx, cmd, mgr, reporter, workers, branchListTask(mgr))
+		reporter.PrintFullReportWithOutput(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// branchListTask lists local branches with a marker on the current branch
+func branchListTask(mgr *repository.Manager) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(mgr.Config(), repo)
+
+		branches, err := client.ListBranches()
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		current, _ := client.GetCurrentBranch()
+
+		lines := make([]string, 0, len(branches))
+		for _, b := range branches {
+			marker := " "
+			if b == current {
+				marker = "*"
+			}
+			lines = append(lines, fmt.Sprintf("%s %s", marker, b))
+		}
+		if current == "" {
+			lines = append([]string{"* (detached HEAD)"}, lines...)
+		}
+
+		result.Success = true
+		result.Message = strings.Join(lines, "\n")
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+}
+
+// branchCreateTask creates the branch given by --create in each repository
+func branchCreateTask(mgr *repository.Manager) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// @default 등 저장소별 브랜치 이름 해석
+		from := branchFrom
+		if from != "" {
+			resolved, err := repo.ResolveBranch(from)
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			from = resolved
+		}
+
+		client := newGitClient(mgr.Config(), repo)
+
+		exists, err := client.BranchExists(branchCreate)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("failed to check branch: %w", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		if exists {
+			// 이미 있으면 스킵
+			result.Skip("branch already exists")
+			return result, nil
+		}
+
+		if err := client.CreateBranch(branchCreate, from); err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		result.Success = true
+		if from != "" {
+			result.Message = fmt.Sprintf("branch created from '%s'", from)
+		} else {
+			result.Message = "branch created"
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+}
+
+// branchDeleteTask deletes the branch given by --delete in each repository
+func branchDeleteTask(mgr *repository.Manager, remoteName string) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(mgr.Config(), repo)
+
+		exists, err := client.BranchExists(branchDelete)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("failed to check branch: %w", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// 로컬 브랜치 삭제
+		if exists {
+			if err := client.DeleteBranch(branchDelete); err != nil {
+				result.Success = false
+				result.Error = enhanceBranchError(err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+		}
+
+		// 원격 브랜치 삭제 (옵션)
+		if branchDeleteRemote {
+			if err := client.DeleteRemoteBranch(branchDelete, remoteName); err != nil {
+				result.Success = false
+				if exists {
+					result.Error = fmt.Errorf("local branch deleted but remote deletion failed: %w", err)
+				} else {
+					result.Error = err
+				}
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+		}
+
+		if !exists && !branchDeleteRemote {
+			// 브랜치가 없으면 스킵 (이미 삭제된 상태)
+			result.Skip("branch not found (already deleted)")
+			return result, nil
+		}
+
+		result.Success = true
+		switch {
+		case exists && branchDeleteRemote:
+			result.Message = "branch deleted (local + remote)"
+		case branchDeleteRemote:
+			result.Message = "branch deleted (remote only, no local branch)"
+		default:
+			result.Message = "branch deleted (local only)"
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+}
+
+// enhanceBranchError enhances error messages with helpful hints
+func enhanceBranchError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(err.Error(), "currently checked out") {
+		return fmt.Errorf("%w\n  hint: checkout another branch first, e.g. 'multi-git checkout @default'", err)
+	}
+
+	return err
+}
+
+func GetBranchCmd() *cobra.Command {
+	return branchCmd
+}
@@ -0,0 +1,337 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Branch-matrix 플래그 변수
+var (
+	matrixBranches []string // 확인할 브랜치 (필수)
+	matrixBase     string   // ahead/behind 기준 브랜치
+	matrixRemote   string   // 원격 이름
+	matrixLocal    bool     // 원격 추적 브랜치 대신 로컬 브랜치 비교
+	matrixMarkdown bool     // Markdown 출력
+	matrixJSON     bool     // JSON 출력
+	matrixParallel int      // 병렬 처리 수
+)
+
+// branchState is one cell of the matrix: whether a branch exists in a repository
+// and how it relates to the base branch
+type branchState struct {
+	Exists bool   `json:"exists"`
+	Commit string `json:"commit,omitempty"` // 브랜치 끝 커밋
+	Ahead  int    `json:"ahead"`            // 기준 브랜치에 없는 커밋 수
+	Behind int    `json:"behind"`           // 기준 브랜치에만 있는 커밋 수
+	IsBase bool   `json:"is_base,omitempty"`
+}
+
+// branchMatrixRow is the row of the matrix for one repository
+type branchMatrixRow struct {
+	Repository string                  `json:"repository"`
+	Base       string                  `json:"base"`
+	BaseExists bool                    `json:"base_exists"`
+	Branches   map[string]*branchState `json:"branches"` // --branches 값 -> 상태
+	Error      string                  `json:"error,omitempty"`
+}
+
+var branchMatrixCmd = &cobra.Command{
+	Use:   "branch-matrix --branches <branch>,...",
+	Short: "Show which branches exist in every repository",
+	Long: `Show a matrix of repositories and branches: whether each branch exists in each
+repository and how many commits it is ahead of and behind the base branch
+(main by default). Use it to check that release branches were cut everywhere.
+
+Branches are looked up as remote-tracking branches (e.g. origin/release/v1.4),
+so the matrix shows what was pushed as of the last fetch; run 'multi-git fetch'
+first for an up-to-date view, or use --local to compare local branches.
+
+A cell shows 'missing', '=' if the branch is at the same commit as the base,
+or the number of commits ahead (+) and behind (-), e.g. '+3 -1'. Repositories
+missing any of the branches fail, so the exit code is 1 if a branch was not
+cut everywhere. Comparing uses the git binary.
+
+Examples:
+  # Was the release branch cut everywhere, and from a recent main?
+  multi-git branch-matrix --branches main,release/v1.4
+
+  # Compare against each repository's default_branch
+  multi-git branch-matrix --branches develop,release/v1.4 --base @default
+
+  # The matrix for the release ticket
+  multi-git branch-matrix --branches release/v1.3,release/v1.4 --markdown`,
+	Args: cobra.NoArgs,
+	Run:  runBranchMatrix,
+}
+
+func init() {
+	branchMatrixCmd.Flags().StringSliceVar(&matrixBranches, "branches", nil,
+		"Branches to show (required, comma-separated or repeatable, '@default' for each repo's default_branch)")
+	branchMatrixCmd.Flags().StringVar(&matrixBase, "base", "main",
+		"Branch to count commits ahead and behind against ('@default' for each repo's default_branch)")
+	_ = branchMatrixCmd.RegisterFlagCompletionFunc("base", completeBranchOrDefault)
+	branchMatrixCmd.Flags().StringVarP(&matrixRemote, "remote", "r", "",
+		"Remote whose branches are compared (default: config default_remote)")
+	branchMatrixCmd.Flags().BoolVar(&matrixLocal, "local", false,
+		"Compare local branches instead of remote-tracking branches")
+	branchMatrixCmd.Flags().BoolVar(&matrixMarkdown, "markdown", false,
+		"Print the matrix as Markdown")
+	branchMatrixCmd.Flags().BoolVar(&matrixJSON, "json", false,
+		"Print the matrix as JSON")
+	branchMatrixCmd.Flags().IntVarP(&matrixParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	branchMatrixCmd.MarkFlagRequired("branches")
+	branchMatrixCmd.MarkFlagsMutuallyExclusive("markdown", "json")
+	branchMatrixCmd.MarkFlagsMutuallyExclusive("local", "remote")
+}
+
+func runBranchMatrix(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성 (보고서 출력 시 진행 상황은 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if matrixMarkdown || matrixJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 4. 병렬 수 및 원격 결정
+	workers := matrixParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+	remoteName := matrixRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+	refName := func(branch string) string {
+		if matrixLocal {
+			return "refs/heads/" + branch
+		}
+		return "refs/remotes/" + remoteName + "/" + branch
+	}
+
+	// 저장소별 행
+	var mu sync.Mutex
+	rows := make(map[string]*branchMatrixRow)
+
+	// 5. Branch-matrix Task 정의
+	matrixTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		row := &branchMatrixRow{Repository: repo.Name, Base: matrixBase, Branches: make(map[string]*branchState)}
+		finish := func(err error) (repository.Result, error) {
+			if err != nil {
+				row.Error = strings.SplitN(err.Error(), "\n", 2)[0]
+			}
+			mu.Lock()
+			rows[repo.Name] = row
+			mu.Unlock()
+
+			result.Success = err == nil
+			result.Error = err
+			if err == nil {
+				result.Message = fmt.Sprintf("%d branch(es) present", len(matrixBranches))
+			}
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			return finish(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath))
+		}
+		client := newGitClient(cfg, repo)
+
+		base, err := repo.ResolveBranch(matrixBase)
+		if err != nil {
+			return finish(err)
+		}
+		row.Base = base
+		if row.BaseExists, err = client.RefExists(refName(base)); err != nil {
+			return finish(err)
+		}
+
+		var missing []string
+		for _, name := range matrixBranches {
+			branch, err := repo.ResolveBranch(name)
+			if err != nil {
+				return finish(err)
+			}
+			state, err := branchMatrixCell(client, refName(branch), refName(base), row.BaseExists)
+			if err != nil {
+				return finish(err)
+			}
+			state.IsBase = branch == base
+			row.Branches[name] = state
+			if !state.Exists {
+				missing = append(missing, branch)
+			}
+		}
+
+		switch {
+		case len(missing) > 0:
+			return finish(fmt.Errorf("missing: %s", strings.Join(missing, ", ")))
+		case !row.BaseExists:
+			return finish(fmt.Errorf("base branch '%s' missing", base))
+		}
+		return finish(nil)
+	}
+
+	// 6. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Comparing branches %s with '%s'", strings.Join(matrixBranches, ", "), matrixBase))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, matrixTask)
+
+	// 7. 설정 파일 순서로 보고서 출력
+	var report []*branchMatrixRow
+	for _, repo := range cfg.Repositories {
+		if row, ok := rows[repo.Name]; ok {
+			report = append(report, row)
+		}
+	}
+	switch {
+	case matrixJSON:
+		if report == nil {
+			report = []*branchMatrixRow{}
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode matrix: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	case matrixMarkdown:
+		printBranchMatrixMarkdown(report)
+	default:
+		printBranchMatrix(report)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// branchMatrixCell reads whether the branch exists and counts its commits ahead
+// of and behind the base (only if the base exists)
+func branchMatrixCell(client *git.Client, ref, baseRef string, baseExists bool) (*branchState, error) {
+	state := &branchState{}
+	exists, err := client.RefExists(ref)
+	if err != nil || !exists {
+		return state, err
+	}
+	state.Exists = true
+
+	commit, err := client.GetCommitAtRevision(ref)
+	if err != nil {
+		return nil, err
+	}
+	state.Commit = commit.Hash.String()
+
+	if baseExists {
+		if state.Ahead, state.Behind, err = client.AheadBehind(baseRef, ref); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// text returns the cell text: missing, base, =, or the commits ahead and behind (e.g. "+3 -1")
+func (s *branchState) text(baseExists bool) string {
+	switch {
+	case !s.Exists:
+		return "missing"
+	case s.IsBase:
+		return "base"
+	case !baseExists:
+		return "exists"
+	case s.Ahead == 0 && s.Behind == 0:
+		return "="
+	}
+	var parts []string
+	if s.Ahead > 0 {
+		parts = append(parts, fmt.Sprintf("+%d", s.Ahead))
+	}
+	if s.Behind > 0 {
+		parts = append(parts, fmt.Sprintf("-%d", s.Behind))
+	}
+	return strings.Join(parts, " ")
+}
+
+// cells returns the row cells: the repository, then one per branch
+func (r *branchMatrixRow) cells() []string {
+	cells := []string{r.Repository}
+	if len(r.Branches) == 0 && r.Error != "" {
+		// 확인하지 못한 저장소 (예: 클론되지 않음)
+		cells = append(cells, "error: "+r.Error)
+		for range matrixBranches[1:] {
+			cells = append(cells, "")
+		}
+		return cells
+	}
+	for _, name := range matrixBranches {
+		state, ok := r.Branches[name]
+		if !ok {
+			cells = append(cells, "?")
+			continue
+		}
+		cells = append(cells, state.text(r.BaseExists))
+	}
+	return cells
+}
+
+// printBranchMatrix prints the matrix as an aligned table
+func printBranchMatrix(report []*branchMatrixRow) {
+	table := [][]string{append([]string{"REPOSITORY"}, matrixBranches...)}
+	for _, row := range report {
+		table = append(table, row.cells())
+	}
+
+	widths := make([]int, len(table[0]))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	fmt.Println()
+	for _, cells := range table {
+		line := make([]string, len(cells))
+		for i, cell := range cells {
+			line[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		fmt.Println(strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+// printBranchMatrixMarkdown prints the matrix as a Markdown table
+func printBranchMatrixMarkdown(report []*branchMatrixRow) {
+	fmt.Printf("## Branches compared with %s\n\n", matrixBase)
+	fmt.Printf("| Repository | %s |\n", strings.Join(matrixBranches, " | "))
+	fmt.Printf("|%s\n", strings.Repeat("---|", len(matrixBranches)+1))
+	for _, row := range report {
+		fmt.Printf("| %s |\n", strings.Join(row.cells(), " | "))
+	}
+}
+
+func GetBranchMatrixCmd() *cobra.Command {
+	return branchMatrixCmd
+}
@@ -0,0 +1,118 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Bundle 플래그 변수
+var (
+	bundleOutput   string // 출력 디렉토리
+	bundleParallel int    // 병렬 처리 수
+)
+
+var bundleCmd = &cobra.Command{
+	Use:   "bundle",
+	Short: "Back up all repositories as git bundles",
+	Long: `Write a git bundle of every cloned repository to an output directory: a
+single file per repository holding all branches, tags, and remote-tracking
+branches with their history, e.g. for an offline disaster-recovery backup.
+
+Bundles are named <repo-name>.bundle and replace the bundles of an earlier run.
+Restore a repository with 'git clone <repo-name>.bundle', or fetch everything
+from it into an existing clone with 'git fetch <file> "refs/*:refs/*"'.
+Uncommitted changes are not included. Creating bundles uses the git binary.
+
+Examples:
+  # Bundle every repository
+  multi-git bundle --output /backups/bundles
+
+  # Only the backend repositories
+  multi-git bundle -o /backups/bundles -g backend`,
+	Args: cobra.NoArgs,
+	Run:  runBundle,
+}
+
+func init() {
+	bundleCmd.Flags().StringVarP(&bundleOutput, "output", "o", "",
+		"Output directory (required)")
+	bundleCmd.Flags().IntVarP(&bundleParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	_ = bundleCmd.MarkFlagRequired("output")
+}
+
+func runBundle(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	outDir, err := filepath.Abs(bundleOutput)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid output directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 결정
+	workers := bundleParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 5. Bundle Task 정의
+	bundleTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		bundlePath := filepath.Join(outDir, repo.Name+".bundle")
+		err := newGitClient(cfg, repo).CreateBundle(bundlePath)
+		result.Duration = time.Since(startTime)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			return result, nil
+		}
+
+		result.Success = true
+		result.Message = "bundle written"
+		if info, err := os.Stat(bundlePath); err == nil {
+			result.Message = fmt.Sprintf("bundle written (%s)", formatSize(info.Size()))
+		}
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Bundling repositories to %s", outDir))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, bundleTask)
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+func GetBundleCmd() *cobra.Command {
+	return bundleCmd
+}
@@ -0,0 +1,264 @@
+package commands
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/provider"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/schedule"
+	"github.com/spf13/cobra"
+)
+
+// Cache 플래그 변수
+var (
+	cacheMaxAge  time.Duration // 보존 기간 (기본: config retention.max_age)
+	cacheMaxSize string        // 전체 크기 상한 (기본: config retention.max_size)
+	cacheDryRun  bool          // 삭제하지 않고 대상만 출력
+)
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Manage the run state kept next to the config file",
+	Long: `multi-git keeps run state in the directory of the config file: run logs
+(logs/), reports of the last run of each command (reports/), automatic snapshots
+taken before destructive operations (snapshots/), checkpoints of interrupted runs
+(checkpoints/), cached GitHub/GitLab API responses (provider_cache/), and the
+timing and schedule history (timings.json, schedule_history.json). Limit how long and how much of
+it is kept with the retention settings and 'multi-git cache clean':
+
+  config:
+    retention:
+      max_age: 720h    # 30 days
+      max_size: 500MB`,
+}
+
+var cacheCleanCmd = &cobra.Command{
+	Use:   "clean",
+	Short: "Remove run state beyond the retention limits",
+	Long: `Remove run logs, run reports, automatic snapshots, checkpoints, and cached
+provider API responses older than the maximum age, then the oldest of the rest until they fit in the maximum size.
+With a maximum age, timing and schedule history entries older than it are
+removed as well.
+
+Removing an automatic snapshot also deletes the references that kept the
+overwritten commits for 'multi-git undo', so the operation can no longer be
+undone. Snapshots created with 'multi-git snapshot create' are never removed.
+
+The limits are taken from --max-age / --max-size or the retention settings of
+the config; at least one is required.
+
+Examples:
+  # Apply the retention settings of the config
+  multi-git cache clean
+
+  # Remove everything older than two weeks
+  multi-git cache clean --max-age 336h
+
+  # Show what would be removed to stay under 100MB
+  multi-git cache clean --max-size 100MB --dry-run`,
+	Args: cobra.NoArgs,
+	Run:  runCacheClean,
+}
+
+func init() {
+	cacheCleanCmd.Flags().DurationVar(&cacheMaxAge, "max-age", 0,
+		"Remove run state older than this (default: config retention.max_age)")
+	cacheCleanCmd.Flags().StringVar(&cacheMaxSize, "max-size", "",
+		"Keep at most this much run state, e.g. 500MB (default: config retention.max_size)")
+	cacheCleanCmd.Flags().BoolVar(&cacheDryRun, "dry-run", false,
+		"Show what would be removed without removing it")
+
+	cacheCmd.AddCommand(cacheCleanCmd)
+}
+
+func runCacheClean(cmd *cobra.Command, args []string) {
+	// 1. 설정 파일 로드 (스냅샷의 보존 참조를 지우려면 --group과 무관하게 모든 저장소 필요)
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	cfg, err := config.LoadAndValidateProfile(configPath, configProfile(cmd), configOverlays(cmd)...)
+	if err != nil {
+		exitOnConfigError(cmd, configPath, err)
+	}
+	mgr := repository.NewManager(cfg)
+
+	// 2. 보존 기준 결정 (플래그가 설정보다 우선)
+	retention := cfg.Retention
+	if cmd.Flags().Changed("max-age") {
+		if cacheMaxAge < 0 {
+			fmt.Fprintf(os.Stderr, "Error: --max-age cannot be negative\n")
+			os.Exit(1)
+		}
+		retention.MaxAge = cacheMaxAge
+	}
+	if cacheMaxSize != "" {
+		size, err := config.ParseByteSize(cacheMaxSize)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: --max-size: %v\n", err)
+			os.Exit(1)
+		}
+		retention.MaxSize = size
+	}
+	if retention.MaxAge == 0 && retention.MaxSize == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no retention limits\n")
+		fmt.Fprintf(os.Stderr, "  hint: set 'config.retention.max_age' or 'max_size', or use '--max-age' / '--max-size'\n")
+		os.Exit(1)
+	}
+
+	var cutoff time.Time
+	if retention.MaxAge > 0 {
+		cutoff = time.Now().Add(-retention.MaxAge)
+	}
+
+	// 3. 정리 대상 수집 (실행 로그, 실행 보고서, 자동 스냅샷, 체크포인트, 제공자 캐시)
+	artifacts, err := listRunState(mgr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	expired := repository.ExpiredArtifacts(artifacts, cutoff, int64(retention.MaxSize))
+
+	verb := "Removing"
+	if cacheDryRun {
+		verb = "Would remove"
+	}
+	var freed int64
+	for _, artifact := range expired {
+		freed += artifact.Size
+	}
+	fmt.Printf("%s %d of %d items (%s) in %s\n", verb, len(expired), len(artifacts), formatSize(freed), cfg.ConfigDir)
+	for _, artifact := range expired {
+		fmt.Printf("  %-60s %10s  %s\n", artifact.Name, formatSize(artifact.Size), artifact.ModTime.Format("2006-01-02 15:04"))
+	}
+
+	// 4. 기록 파일의 오래된 항목 (최대 보존 기간이 있을 때만)
+	var timings *repository.TimingStore
+	var history *schedule.History
+	prunedTimings, prunedRuns := 0, 0
+	if !cutoff.IsZero() {
+		timings, err = repository.LoadTimingStore(mgr.StatePath(repository.TimingsFileName))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		history, err = schedule.LoadHistory(mgr.StatePath(schedule.HistoryFileName))
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		prunedTimings = timings.Prune(cutoff)
+		prunedRuns = history.Prune(cutoff)
+		if prunedTimings > 0 || prunedRuns > 0 {
+			fmt.Printf("%s %d timing entries and %d schedule runs older than %s\n", verb, prunedTimings, prunedRuns, retention.MaxAge)
+		}
+	}
+
+	if cacheDryRun {
+		return
+	}
+
+	// 5. 삭제 실행
+	failed := false
+	for _, artifact := range expired {
+		if err := removeRunState(cfg, mgr, artifact); err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %s: %v\n", artifact.Name, err)
+			failed = true
+			continue
+		}
+		log.Infof("cache clean: removed %s", artifact.Name)
+	}
+	if prunedTimings > 0 {
+		if err := timings.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+			failed = true
+		}
+	}
+	if prunedRuns > 0 {
+		if err := history.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "✗ %v\n", err)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+	fmt.Printf("✓ Freed %s\n", formatSize(freed))
+}
+
+// listRunState returns the run logs, run reports, automatic snapshots, checkpoints,
+// and cached provider API responses in the state directory
+func listRunState(mgr *repository.Manager) ([]repository.StateArtifact, error) {
+	kinds := []struct {
+		dir   string
+		match func(entry fs.DirEntry) bool
+	}{
+		{log.DirName, func(entry fs.DirEntry) bool { return entry.IsDir() }},
+		{reportDirName, func(entry fs.DirEntry) bool { return filepath.Ext(entry.Name()) == ".json" }},
+		{repository.SnapshotDirName, func(entry fs.DirEntry) bool {
+			return strings.HasPrefix(entry.Name(), repository.AutoSnapshotPrefix) && filepath.Ext(entry.Name()) == ".json"
+		}},
+		{repository.CheckpointDirName, func(entry fs.DirEntry) bool { return filepath.Ext(entry.Name()) == ".json" }},
+		{provider.CacheDirName, func(entry fs.DirEntry) bool { return filepath.Ext(entry.Name()) == ".json" }},
+	}
+
+	var artifacts []repository.StateArtifact
+	for _, kind := range kinds {
+		found, err := mgr.ListStateArtifacts(kind.dir, kind.match)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", kind.dir, err)
+		}
+		artifacts = append(artifacts, found...)
+	}
+	return artifacts, nil
+}
+
+// removeRunState removes an artifact; for an automatic snapshot, the references
+// keeping its overwritten commits are deleted from the repositories first
+func removeRunState(cfg *config.Config, mgr *repository.Manager, artifact repository.StateArtifact) error {
+	if artifact.Kind == repository.SnapshotDirName {
+		snapshot, err := repository.LoadSnapshot(artifact.Path)
+		if err != nil {
+			return err
+		}
+		for name, entry := range snapshot.Repositories {
+			repo, ok := mgr.FindRepository(name)
+			if !ok || !mgr.IsGitRepository(repo) {
+				continue
+			}
+			client := newGitClient(cfg, repo)
+			for _, ref := range entry.Refs {
+				if ref.Keep == "" {
+					continue
+				}
+				if err := client.SetRef(ref.Keep, ""); err != nil {
+					return fmt.Errorf("%s: %w", name, err)
+				}
+			}
+		}
+	}
+	return os.RemoveAll(artifact.Path)
+}
+
+// formatSize formats a byte count with a binary unit (e.g. "3.2 MiB")
+func formatSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func GetCacheCmd() *cobra.Command {
+	return cacheCmd
+}
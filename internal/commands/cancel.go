@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// watchCancelInput lets the user cancel the task of one repository during a streaming
+// run: typing its name and Enter cancels it while the other repositories continue,
+// Enter alone lists the running ones. Only used when stdin is a terminal and nothing
+// else reads it after the run (--resolve). The returned function stops watching.
+func watchCancelInput(cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter) func() {
+	if !reporter.Streaming() || !stdinIsTerminal() {
+		return func() {}
+	}
+	if resolve, err := cmd.Flags().GetBool("resolve"); err == nil && resolve {
+		return func() {}
+	}
+
+	fmt.Fprintln(os.Stderr, "Type a repository name and press Enter to cancel it (Enter alone lists the running ones)")
+
+	// 입력 대기 중인 읽기는 멈출 수 없으므로 줄 단위로 채널에 전달하고, 실행이 끝나면 무시
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+			lines <- strings.TrimSpace(scanner.Text())
+		}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case name := <-lines:
+				cancelRunningTask(mgr, reporter, name)
+			}
+		}
+	}()
+	return func() { close(done) }
+}
+
+// cancelRunningTask cancels the running task of the named repository, or lists the
+// running repositories if the name is empty or not running
+func cancelRunningTask(mgr *repository.Manager, reporter *repository.Reporter, name string) {
+	if name != "" && mgr.CancelTask(name) {
+		reporter.StreamResult(repository.Result{RepoName: name, Status: repository.StatusCancelled, Error: repository.ErrTaskCancelled})
+		return
+	}
+
+	running := mgr.RunningTasks()
+	switch {
+	case name != "":
+		fmt.Fprintf(os.Stderr, "'%s' is not running. Running: %s\n", name, strings.Join(running, ", "))
+	case len(running) == 0:
+		fmt.Fprintln(os.Stderr, "No repository is running")
+	default:
+		fmt.Fprintf(os.Stderr, "Running: %s\n", strings.Join(running, ", "))
+	}
+}
@@ -11,21 +11,35 @@ import (
 	"github.com/alexgim961101/multi-git/internal/git"
 	"github.com/alexgim961101/multi-git/internal/repository"
 	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
 )
 
 // Checkout 플래그 변수
 var (
-	checkoutCreate   bool // 브랜치가 없으면 생성
-	checkoutForce    bool // 로컬 변경사항 무시
-	checkoutFetch    bool // 체크아웃 전 fetch 수행
-	checkoutParallel int  // 병렬 처리 수
+	checkoutCreate   bool   // 브랜치가 없으면 생성
+	checkoutForce    bool   // 로컬 변경사항 무시
+	checkoutFetch    bool   // 체크아웃 전 fetch 수행
+	checkoutDryRun   bool   // 시뮬레이션 모드
+	checkoutParallel int    // 병렬 처리 수
+	checkoutFailFast bool   // 실패 시 중단
+	checkoutOverride bool   // 보호 브랜치 강제 체크아웃 허용
+	checkoutManifest string // 저장소별 브랜치/커밋 매니페스트 파일
 )
 
 var checkoutCmd = &cobra.Command{
 	Use:   "checkout [branch-name]",
 	Short: "Checkout branch across all repositories",
 	Long: `Checkout the specified branch across all managed repositories.
-The branch name must be the same across all repositories.
+The branch name must be the same across all repositories, or '@default'
+to use each repository's configured default_branch.
+
+With --manifest, each repository checks out its own branch, tag, or commit
+from a YAML file mapping repository names to refs, reproducing an exact
+cross-repository state such as a release snapshot. Tags and commits are
+checked out as a detached HEAD; repositories not in the manifest are skipped.
+
+--force is refused for branches matching 'protected_branches' in the config
+unless --override-protection is given.
 
 Examples:
   # Checkout develop branch
@@ -38,9 +52,19 @@ Examples:
   multi-git checkout --fetch develop
 
   # Force checkout (discard local changes)
-  multi-git checkout --force develop`,
-	Args: cobra.ExactArgs(1),
-	Run:  runCheckout,
+  multi-git checkout --force develop
+
+  # Checkout each repository's configured default_branch
+  multi-git checkout @default
+
+  # Show which branch each repository would switch from and to
+  multi-git checkout develop --dry-run
+
+  # Reproduce a release snapshot (api: release/1.4, web: v2.3.0, worker: 3f2c1a9)
+  multi-git checkout --manifest release-1.4.yaml --fetch`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeBranchArg,
+	Run:               runCheckout,
 }
 
 func init() {
@@ -50,27 +74,68 @@ func init() {
 		"Force checkout (discard local changes)")
 	checkoutCmd.Flags().BoolVar(&checkoutFetch, "fetch", false,
 		"Fetch from remote before checkout")
+	checkoutCmd.Flags().BoolVar(&checkoutDryRun, "dry-run", false,
+		"Show what would be checked out without changing anything")
 	checkoutCmd.Flags().IntVarP(&checkoutParallel, "parallel", "p", 0,
 		"Number of parallel operations (0 = use config value)")
+	checkoutCmd.Flags().BoolVar(&checkoutFailFast, "fail-fast", false,
+		"Stop on first failure")
+	checkoutCmd.Flags().BoolVar(&checkoutOverride, "override-protection", false,
+		"Allow --force on branches listed in protected_branches")
+	checkoutCmd.Flags().StringVar(&checkoutManifest, "manifest", "",
+		"YAML file mapping repository names to the branch, tag, or commit to check out")
 }
 
 func runCheckout(cmd *cobra.Command, args []string) {
 	// 1. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
-	// 2. 브랜치 이름 인자 검증
-	branchName := args[0]
-	if branchName == "" {
+	// 2. 브랜치 이름 인자 또는 매니페스트 검증
+	branchName := ""
+	if len(args) == 1 {
+		branchName = args[0]
+	}
+	var manifest map[string]string
+	switch {
+	case checkoutManifest != "" && branchName != "":
+		fmt.Fprintf(os.Stderr, "Error: cannot use a branch name with --manifest\n")
+		os.Exit(1)
+	case checkoutManifest != "":
+		m, err := readCheckoutManifest(checkoutManifest)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		manifest = m
+	case branchName == "":
 		fmt.Fprintf(os.Stderr, "Error: branch name is required\n")
+		fmt.Fprintf(os.Stderr, "  hint: use '--manifest <file>' to check out a different ref in each repository\n")
 		os.Exit(1)
 	}
 
 	// 3. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
-		os.Exit(1)
+	cfg := loadConfig(cmd)
+
+	// 설정에 없는 저장소의 매니페스트 항목 경고
+	if manifest != nil {
+		configured := make(map[string]bool, len(cfg.Repositories))
+		for _, repo := range cfg.Repositories {
+			configured[repo.Name] = true
+		}
+		for _, name := range sortedKeys(manifest) {
+			if !configured[name] {
+				fmt.Fprintf(os.Stderr, "Warning: '%s' in the manifest is not checked out (no such repository in the config)\n", name)
+			}
+		}
+	}
+
+	// 보호 브랜치 확인 (--force일 때, 어느 저장소도 변경하기 전에 거부)
+	if checkoutForce && !checkoutOverride {
+		targets := protectedBranchTargets(cfg, branchName)
+		if manifest != nil {
+			targets = protectedManifestTargets(cfg, manifest)
+		}
+		exitOnProtected("force checkout protected branches", "protected_branches", targets)
 	}
 
 	// 4. Manager와 Reporter 생성
@@ -85,7 +150,7 @@ func runCheckout(cmd *cobra.Command, args []string) {
 	}
 
 	// 6. Checkout Task 정의
-	checkoutTask := func(repo config.Repository) repository.Result {
+	checkoutTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{
 			RepoName: repo.Name,
 		}
@@ -97,11 +162,53 @@ func runCheckout(cmd *cobra.Command, args []string) {
 			result.Success = false
 			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
+		}
+
+		// 매니페스트: 저장소별 ref (없으면 스킵)
+		target := branchName
+		if manifest != nil {
+			ref, ok := manifest[repo.Name]
+			if !ok {
+				result.Skip("skipped: not in manifest")
+				return result, nil
+			}
+			target = ref
+		}
+
+		// @default 등 저장소별 브랜치 이름 해석
+		branch, err := repo.ResolveBranch(target)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
 		}
 
 		// Git Client 생성
-		client := git.NewClient(repoPath)
+		client := newGitClient(cfg, repo)
+
+		// 매니페스트의 태그나 커밋은 detached HEAD로 체크아웃
+		if manifest != nil {
+			if checkoutFetch {
+				// 브랜치인지 판단하기 전에 원격 참조 갱신 (실패해도 로컬 ref로 진행)
+				_ = client.Fetch("origin")
+			}
+			if !isCheckoutBranch(client, branch) {
+				result.Message, err = checkoutCommit(client, branch)
+				result.Duration = time.Since(startTime)
+				if err != nil {
+					result.Success = false
+					result.Error = enhanceCheckoutError(err, branch)
+					return result, nil
+				}
+				result.Success = true
+				if strings.HasPrefix(result.Message, "already") {
+					result.Skip(result.Message)
+				}
+				return result, nil
+			}
+		}
 
 		// 현재 브랜치 확인
 		currentBranch, err := client.GetCurrentBranch()
@@ -109,23 +216,35 @@ func runCheckout(cmd *cobra.Command, args []string) {
 			result.Success = false
 			result.Error = fmt.Errorf("failed to get current branch: %w", err)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
 		// 이미 해당 브랜치면 스킵
-		if currentBranch == branchName {
+		if currentBranch == branch {
+			result.Skip("already on branch")
+			return result, nil
+		}
+
+		// dry-run: 체크아웃 결과만 보고
+		if checkoutDryRun {
+			message, err := describeCheckout(client, branch, currentBranch)
+			result.Duration = time.Since(startTime)
+			if err != nil {
+				result.Success = false
+				result.Error = enhanceCheckoutError(err, branch)
+				return result, nil
+			}
 			result.Success = true
-			result.Message = "already on branch"
-			result.Duration = 0 // IsSkipped() 조건
-			return result
+			result.Message = message
+			return result, nil
 		}
 
 		// Checkout 옵션 설정
 		checkoutOpts := &git.CheckoutOptions{
-			Branch:     branchName,
+			Branch:     branch,
 			Create:     checkoutCreate,
 			Force:      checkoutForce,
-			FetchFirst: checkoutFetch,
+			FetchFirst: checkoutFetch && manifest == nil, // 매니페스트는 위에서 fetch함
 		}
 
 		// Checkout 실행
@@ -134,41 +253,153 @@ func runCheckout(cmd *cobra.Command, args []string) {
 
 		if err != nil {
 			result.Success = false
-			result.Error = enhanceCheckoutError(err, branchName)
-			return result
+			result.Error = enhanceCheckoutError(err, branch)
+			return result, nil
 		}
 
 		result.Success = true
-		return result
+		return result, nil
 	}
 
 	// 7. 작업 실행
-	reporter.PrintHeader(fmt.Sprintf("Checking out branch: %s", branchName))
+	headerMsg := fmt.Sprintf("Checking out branch: %s", branchName)
+	if manifest != nil {
+		headerMsg = fmt.Sprintf("Checking out manifest: %s", checkoutManifest)
+		branchName = checkoutManifest // 훅의 MG_BRANCH 대신 매니페스트 경로
+	}
+	if checkoutDryRun {
+		headerMsg += " (dry-run)"
+	}
+	reporter.PrintHeader(headerMsg)
 
 	ctx := context.Background()
 	var summary *repository.Summary
 
-	if workers > 1 {
-		// 임시로 ParallelWorkers 설정을 위해 config 수정
-		cfg.ParallelWorkers = workers
-		summary = mgr.ExecuteParallel(ctx, checkoutTask, nil)
-	} else {
-		summary = mgr.ExecuteSequential(ctx, checkoutTask, nil)
-	}
+	summary = executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "checkout", branchName, checkoutTask))
 
 	// 8. 결과 출력
 	reporter.PrintFullReport(summary)
 
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
-	}
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
 }
 
 func GetCheckoutCmd() *cobra.Command {
 	return checkoutCmd
 }
 
+// describeCheckout reports what checkout would do without changing the repository
+// Returns the same errors the checkout itself would fail with
+func describeCheckout(client *git.Client, branch, currentBranch string) (string, error) {
+	// 로컬 변경사항 확인 (--force가 아니면 체크아웃 실패)
+	hasChanges, err := client.HasLocalChanges()
+	if err != nil {
+		return "", fmt.Errorf("failed to check local changes: %w", err)
+	}
+	if hasChanges && !checkoutForce {
+		return "", fmt.Errorf("local changes would be overwritten by checkout (use --force to discard)")
+	}
+
+	from := currentBranch
+	if from == "" {
+		from = "detached HEAD"
+	}
+
+	exists, err := client.BranchExists(branch)
+	if err != nil {
+		return "", err
+	}
+
+	var message string
+	switch {
+	case exists:
+		message = fmt.Sprintf("would switch '%s' -> '%s'", from, branch)
+	case client.HasRemoteTrackingBranch("origin", branch):
+		message = fmt.Sprintf("would create '%s' from 'origin/%s' and switch from '%s'", branch, branch, from)
+	case checkoutCreate:
+		message = fmt.Sprintf("would create '%s' from '%s' and switch to it", branch, from)
+	default:
+		return "", fmt.Errorf("branch '%s' not found locally or remotely", branch)
+	}
+
+	if hasChanges {
+		message += ", discarding local changes"
+	}
+	if checkoutFetch {
+		// fetch도 원격 참조를 바꾸므로 dry-run에서는 수행하지 않음
+		message += " (remote branches not fetched)"
+	}
+	return message, nil
+}
+
+// readCheckoutManifest reads a checkout manifest: a YAML mapping of repository
+// names to the branch, tag, or commit to check out (e.g. "api: release/1.4")
+func readCheckoutManifest(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest map[string]string
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w\n  hint: map each repository name to a ref, e.g. 'api: release/1.4'", path, err)
+	}
+	if len(manifest) == 0 {
+		return nil, fmt.Errorf("manifest %s lists no repositories", path)
+	}
+	for name, ref := range manifest {
+		if strings.TrimSpace(ref) == "" {
+			return nil, fmt.Errorf("invalid manifest %s: no ref for '%s'", path, name)
+		}
+	}
+	return manifest, nil
+}
+
+// isCheckoutBranch returns true if the ref is checked out as a branch: a local
+// branch, a remote branch on origin, or a new branch with --create
+func isCheckoutBranch(client *git.Client, ref string) bool {
+	if exists, err := client.BranchExists(ref); err == nil && exists {
+		return true
+	}
+	return client.HasRemoteTrackingBranch("origin", ref) || checkoutCreate
+}
+
+// checkoutCommit checks out a tag or commit of a manifest as a detached HEAD
+// Returns the result message; nothing changes if HEAD is already at the commit.
+func checkoutCommit(client *git.Client, ref string) (string, error) {
+	commit, err := client.GetCommitAtRevision(ref)
+	if err != nil {
+		return "", fmt.Errorf("%w\n  hint: use '--fetch' to update remote references", err)
+	}
+	short := commit.Hash.String()[:7]
+	if !strings.HasPrefix(commit.Hash.String(), ref) {
+		short += fmt.Sprintf(" (%s)", ref) // 태그나 브랜치 이름 표시
+	}
+	if head, err := client.GetCommitAtRevision("HEAD"); err == nil && head.Hash == commit.Hash {
+		return fmt.Sprintf("already at %s", short), nil
+	}
+
+	if checkoutDryRun {
+		hasChanges, err := client.HasLocalChanges()
+		if err != nil {
+			return "", fmt.Errorf("failed to check local changes: %w", err)
+		}
+		if hasChanges && !checkoutForce {
+			return "", fmt.Errorf("local changes would be overwritten by checkout (use --force to discard)")
+		}
+		message := "would detach HEAD at " + short
+		if hasChanges {
+			message += ", discarding local changes"
+		}
+		return message, nil
+	}
+
+	if err := client.CheckoutCommit(commit.Hash.String(), checkoutForce); err != nil {
+		return "", err
+	}
+	return "HEAD detached at " + short, nil
+}
+
 // enhanceCheckoutError enhances error messages with helpful hints
 func enhanceCheckoutError(err error, branchName string) error {
 	if err == nil {
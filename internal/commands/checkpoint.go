@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// withCheckpoint wraps the task so that completed repositories are written to a
+// checkpoint under <config dir>/checkpoints/ every few seconds. With --resume, the
+// repositories completed by the interrupted run of the same command are removed from
+// the manager and their recorded results are returned, to be merged into the summary.
+// Dry-runs are not checkpointed. Exits if the checkpoint cannot be resumed.
+func withCheckpoint(cmd *cobra.Command, mgr *repository.Manager, task repository.TaskFunc) (repository.TaskFunc, *repository.Checkpoint, []repository.Result) {
+	resume, _ := cmd.Root().PersistentFlags().GetBool("resume")
+	if flag := cmd.Flags().Lookup("dry-run"); flag != nil && flag.Value.String() == "true" {
+		if resume {
+			fmt.Fprintf(os.Stderr, "Warning: --resume is ignored with --dry-run\n")
+		}
+		return task, nil, nil
+	}
+
+	operation := operationName(cmd)
+	args := checkpointArgs(os.Args[1:])
+	path := mgr.CheckpointPath(operation)
+
+	var cp *repository.Checkpoint
+	var restored []repository.Result
+	if resume {
+		loaded, err := repository.LoadCheckpoint(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		switch {
+		case loaded == nil:
+			fmt.Fprintf(os.Stderr, "Warning: no interrupted '%s' run to resume, running all repositories\n", operation)
+		case !loaded.SameRun(operation, args):
+			fmt.Fprintf(os.Stderr, "Error: the interrupted '%s' run used different arguments\n", operation)
+			fmt.Fprintf(os.Stderr, "  hint: resume it with: multi-git %s --resume\n", shellJoin(loaded.Args))
+			os.Exit(1)
+		default:
+			cp = loaded
+			restored = cp.Results(mgr.RepositoryNames())
+
+			// 이미 완료된 저장소 제외
+			remaining := make([]config.Repository, 0, mgr.RepositoryCount())
+			for _, repo := range mgr.Config().Repositories {
+				if !cp.Has(repo.Name) {
+					remaining = append(remaining, repo)
+				}
+			}
+			mgr.Config().Repositories = remaining
+			fmt.Printf("Resuming the run started %s: %d completed, %d remaining\n",
+				cp.StartedAt.Format("2006-01-02 15:04:05"), len(restored), len(remaining))
+		}
+	}
+	if cp == nil {
+		cp = repository.NewCheckpoint(path, operation, args)
+	}
+
+	var warnOnce sync.Once
+	wrapped := func(repo config.Repository) (repository.Result, error) {
+		result := task.Run(repo)
+		if err := cp.Add(result); err != nil {
+			warnOnce.Do(func() { log.Warnf("checkpoint not written: %v", err) })
+		}
+		return result, nil
+	}
+	return wrapped, cp, restored
+}
+
+// finishCheckpoint removes the checkpoint if every repository completed (or none did),
+// otherwise writes it and tells how to resume the remaining repositories
+func finishCheckpoint(cp *repository.Checkpoint, summary *repository.Summary) {
+	if cp == nil {
+		return
+	}
+	if cp.Count() == 0 || (!summary.HasFailures() && summary.CancelledCount == 0) {
+		if err := cp.Remove(); err != nil {
+			log.Warnf("%v", err)
+		}
+		return
+	}
+	if err := cp.Flush(); err != nil {
+		log.Warnf("checkpoint not written: %v", err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Progress saved: re-run with --resume to skip the %d repositories that completed\n",
+		cp.Count())
+}
+
+// checkpointArgs returns the command line arguments that identify a run, without --resume
+func checkpointArgs(args []string) []string {
+	filtered := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg == "--resume" || strings.HasPrefix(arg, "--resume=") {
+			continue
+		}
+		filtered = append(filtered, arg)
+	}
+	return filtered
+}
+
+// shellJoin joins arguments into a command line, quoting those a shell would split
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg == "" || strings.ContainsAny(arg, " \t\n'\"$`\\|&;<>()*?[]{}~#!") {
+			arg = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+		}
+		quoted[i] = arg
+	}
+	return strings.Join(quoted, " ")
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
 	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/log"
 	"github.com/alexgim961101/multi-git/internal/repository"
-	"github.com/schollz/progressbar/v3"
+	"github.com/alexgim961101/multi-git/internal/shell"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +21,14 @@ var (
 	cloneSkipExisting bool
 	cloneParallel     int
 	cloneDepth        int
+	cloneBranch       string // 체크아웃할 브랜치 (@default 지원)
+	cloneSingleBranch bool   // 한 브랜치의 히스토리만 가져옴
+	cloneFilter       string // partial clone 필터
+	cloneNoSparse     bool   // 설정의 sparse_paths 무시
+	cloneUpdateURLs   bool   // 이동한 저장소의 URL을 설정에 반영
+	cloneFailFast     bool   // 실패 시 중단
+	cloneDryRun       bool   // 시뮬레이션 모드
+	cloneStream       bool   // 진행 상황을 저장소 접두사와 함께 실시간 출력
 )
 
 func init() {
@@ -25,27 +36,73 @@ func init() {
 		"Skip repositories that already exist")
 	cloneCmd.Flags().IntVarP(&cloneParallel, "parallel", "p", 0,
 		"Number of parallel clones (0 = use config value)")
+	cloneCmd.Flags().BoolVar(&cloneFailFast, "fail-fast", false,
+		"Stop on first failure")
 	cloneCmd.Flags().IntVar(&cloneDepth, "depth", 0,
 		"Create a shallow clone with history truncated (0 = full clone)")
+	cloneCmd.Flags().StringVarP(&cloneBranch, "branch", "b", "",
+		"Branch to check out after cloning ('@default' = each repository's default_branch)")
+	_ = cloneCmd.RegisterFlagCompletionFunc("branch", completeBranchOrDefault)
+	cloneCmd.Flags().BoolVar(&cloneSingleBranch, "single-branch", false,
+		"Only fetch the history of the checked out branch")
+	cloneCmd.Flags().StringVar(&cloneFilter, "filter", "",
+		"Partial clone filter, e.g. 'blob:none' (requires the git binary)")
+	cloneCmd.Flags().BoolVar(&cloneNoSparse, "no-sparse", false,
+		"Check out the full tree even if 'sparse_paths' is configured")
+	cloneCmd.Flags().BoolVar(&cloneUpdateURLs, "update-config", false,
+		"Rewrite the config and remote URLs of repositories that have moved")
+	cloneCmd.Flags().BoolVar(&cloneDryRun, "dry-run", false,
+		"Show which repositories would be cloned and where, without cloning")
+	cloneCmd.Flags().BoolVar(&cloneStream, "stream", false,
+		"Print clone progress as it happens, each line prefixed with [repo-name]")
 }
 
 var cloneCmd = &cobra.Command{
 	Use:   "clone",
 	Short: "Clone multiple Git repositories",
 	Long: `Clone multiple Git repositories defined in the configuration file.
-All repositories will be cloned to the base directory specified in the config.`,
+All repositories will be cloned to the base directory specified in the config.
+
+Large repositories can be cloned faster with --depth, --single-branch, and
+--filter (partial clone). Repositories with 'sparse_paths' in the config only
+check out those directories (plus top-level files). --filter and sparse
+checkout use the git binary, since go-git supports neither.
+
+Repositories whose HTTPS URL is redirected by the hosting service (renamed
+or transferred) are reported with their new URL; --update-config rewrites
+the config and the remote of the new clone.
+
+New clones get the git settings of 'git_config' in the config (e.g.
+user.email, pull.rebase). Repositories with 'post_clone' steps in the config
+run them in the new clone (e.g. 'npm ci'); a failing step fails the repository.
+
+Examples:
+  # Recent history of the default branches only
+  multi-git clone --depth 50 --single-branch --branch @default
+
+  # Full history without file contents; blobs are fetched on demand
+  multi-git clone --filter blob:none
+
+  # Show which directories would be cloned
+  multi-git clone --dry-run
+
+  # Follow the progress of every clone live
+  multi-git clone --stream`,
 	Run: runClone,
 }
 
 func runClone(cmd *cobra.Command, args []string) {
 	// 1. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
 	// 2. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
+	cfg := loadConfig(cmd)
+
+	// 디렉토리 권한 (dir_mode, group)
+	dirs, err := git.NewDirPermissions(uint32(cfg.DirMode), cfg.Group)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "  hint: check 'group' in the config\n")
 		os.Exit(1)
 	}
 
@@ -53,6 +110,7 @@ func runClone(cmd *cobra.Command, args []string) {
 	mgr := repository.NewManager(cfg)
 	reporter := repository.NewReporter()
 	reporter.SetVerbose(verbose)
+	reporter.SetStream(cloneStream)
 
 	// 4. 병렬 수 결정
 	workers := cloneParallel
@@ -61,7 +119,9 @@ func runClone(cmd *cobra.Command, args []string) {
 	}
 
 	// 5. Clone Task 정의
-	cloneTask := func(repo config.Repository) repository.Result {
+	noHooks, _ := cmd.Root().PersistentFlags().GetBool("no-hooks")
+	var moved movedRepositories
+	cloneTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{
 			RepoName: repo.Name,
 		}
@@ -69,40 +129,141 @@ func runClone(cmd *cobra.Command, args []string) {
 		repoPath := mgr.GetRepositoryPath(repo)
 
 		// Clone 옵션 설정
+		branch, err := repo.ResolveBranch(cloneBranch)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
 		cloneOpts := &git.CloneOptions{
-			Depth: cloneDepth,
+			Depth:        cloneDepth,
+			Branch:       branch,
+			SingleBranch: cloneSingleBranch,
+			Filter:       cloneFilter,
+			Auth:         credentials.GitAuth(cfg, repo),
+			Progress:     streamWriter(reporter, repo),
+			Context:      mgr.TaskContext(repo.Name), // 실행 중 개별 취소
+			Dirs:         dirs,
+		}
+		if !cloneNoSparse {
+			cloneOpts.SparsePaths = repo.SparsePaths
+		}
+
+		// dry-run: 클론할 위치와 옵션만 보고
+		if cloneDryRun {
+			return describeClone(cfg, repo, repoPath, cloneOpts, startTime), nil
 		}
 
-		// Clone 실행
-		cloned, err := git.CloneIfNotExists(repo.URL, repoPath, cloneOpts)
+		// Clone 실행 (단계별 소요 시간 기록)
+		steps := repository.NewSteps(&result)
+		var cloned bool
+		err = steps.Run("clone", func() error {
+			var err error
+			cloned, err = git.CloneIfNotExists(repo.URL, repoPath, cloneOpts)
+			return err
+		})
 		result.Duration = time.Since(startTime)
 
 		if err != nil {
 			result.Success = false
 			result.Error = err
-			return result
+			return result, nil
 		}
 
 		result.Success = true
-		if !cloned {
+		if cloned {
+			if newURL := moved.check(cfg, repo); newURL != "" {
+				result.Message = "moved to " + newURL
+			}
+			// 설정의 추가 원격 (예: upstream)
+			added, err := addConfiguredRemotes(cfg, repo, repoPath)
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("cloned but %w\n  hint: run 'multi-git remote list' to check the remotes", err)
+				return result, nil
+			}
+			if len(added) > 0 {
+				if result.Message != "" {
+					result.Message += ", "
+				}
+				result.Message += "added " + strings.Join(added, ", ")
+			}
+			// 설정의 git 설정 (git_config, post_clone 단계보다 먼저)
+			keys, err := newGitClient(cfg, repo).SetConfigValues(cfg.GitConfigFor(repo))
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("cloned but failed to set git config: %w\n  hint: run 'multi-git doctor --fix' to apply it", err)
+				return result, nil
+			}
+			if len(keys) > 0 {
+				if result.Message != "" {
+					result.Message += ", "
+				}
+				result.Message += "set " + strings.Join(keys, ", ")
+			}
+			// 고정 리비전 체크아웃 (pin, post_clone 단계보다 먼저)
+			if repo.Pin != "" {
+				var message string
+				if err := steps.Run("pin", func() error {
+					var err error
+					message, err = checkoutPin(newGitClient(cfg, repo), repo, mgr.DefaultRemote())
+					return err
+				}); err != nil {
+					result.Success = false
+					result.Error = fmt.Errorf("cloned but %w", err)
+					result.Duration = time.Since(startTime)
+					return result, nil
+				}
+				if result.Message != "" {
+					result.Message += ", "
+				}
+				result.Message += message
+				result.Duration = time.Since(startTime)
+			}
+			// 저장소별 post_clone 단계
+			if len(repo.PostClone) > 0 && !noHooks {
+				if err := steps.Run("post-clone", func() error {
+					return runPostCloneSteps(mgr, repo, branch)
+				}); err != nil {
+					result.Success = false
+					result.Error = err
+					result.Duration = time.Since(startTime)
+					return result, nil
+				}
+				if result.Message != "" {
+					result.Message += ", "
+				}
+				result.Message += fmt.Sprintf("ran %d post_clone step(s)", len(repo.PostClone))
+				result.Duration = time.Since(startTime)
+			}
+		} else {
 			// 이미 존재하는 경우
 			if cloneSkipExisting {
-				result.Message = "skipped (already exists)"
-				result.Duration = 0 // IsSkipped() 조건
+				result.Skip("skipped (already exists)")
 			} else {
 				result.Success = false
 				result.Error = fmt.Errorf("directory already exists: %s", repoPath)
 			}
 		}
 
-		return result
+		return result, nil
 	}
 
 	// 6. 작업 실행
+	if cloneDryRun {
+		reporter.PrintHeader("Cloning repositories (dry-run)")
+		summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, cloneTask)
+		reporter.PrintFullReport(summary)
+		exitOnFailures(cmd, summary)
+		return
+	}
+
 	reporter.PrintHeader("Cloning repositories")
 
 	// BaseDir 생성 확인
-	if err := mgr.EnsureBaseDir(); err != nil {
+	if err := dirs.MkdirAll(cfg.BaseDir); err != nil {
 		fmt.Fprintf(os.Stderr, "Error creating base directory: %v\n", err)
 		os.Exit(1)
 	}
@@ -110,38 +271,106 @@ func runClone(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	var summary *repository.Summary
 
-	// Progress Bar 설정 (it/s 제거)
-	bar := progressbar.NewOptions64(
-		int64(len(cfg.Repositories)),
-		progressbar.OptionSetDescription("Cloning..."),
-		progressbar.OptionSetWriter(os.Stderr),
-		progressbar.OptionShowCount(),
-		progressbar.OptionSetWidth(10),
-		progressbar.OptionThrottle(65*time.Millisecond),
-		progressbar.OptionSpinnerType(14),
-		progressbar.OptionFullWidth(),
-		// progressbar.OptionShowIts(), // 이 옵션을 제거하여 속도 표시 숨김
-	)
+	summary = executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "clone", cloneBranch, cloneTask))
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
 
-	onProgress := func() {
-		_ = bar.Add(1)
+	// 8. 이동한 저장소 보고 (--update-config 시 설정 갱신)
+	if err := moved.report(reporter, mgr, cloneUpdateURLs); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
 	}
 
-	if workers > 1 {
-		// 임시로 ParallelWorkers 설정을 위해 config 수정
-		cfg.ParallelWorkers = workers
-		summary = mgr.ExecuteParallel(ctx, cloneTask, onProgress)
-	} else {
-		summary = mgr.ExecuteSequential(ctx, cloneTask, onProgress)
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// describeClone reports where and how a repository would be cloned
+// Existing directories are reported the same way the clone itself handles them
+func describeClone(cfg *config.Config, repo config.Repository, repoPath string, opts *git.CloneOptions, startTime time.Time) repository.Result {
+	result := repository.Result{RepoName: repo.Name}
+
+	if git.DirectoryExists(repoPath) {
+		switch {
+		case !git.RepositoryExists(repoPath):
+			result.Success = false
+			result.Error = fmt.Errorf("directory exists but is not a git repository: %s", repoPath)
+			result.Duration = time.Since(startTime)
+		case cloneSkipExisting:
+			result.Skip("skipped (already exists)")
+		default:
+			result.Success = false
+			result.Error = fmt.Errorf("directory already exists: %s", repoPath)
+			result.Duration = time.Since(startTime)
+		}
+		return result
 	}
 
-	// 7. 결과 출력
-	reporter.PrintFullReport(summary)
+	var details []string
+	if opts.Branch != "" {
+		details = append(details, "branch "+opts.Branch)
+	}
+	if opts.SingleBranch {
+		details = append(details, "single branch")
+	}
+	if opts.Depth > 0 {
+		details = append(details, fmt.Sprintf("depth %d", opts.Depth))
+	}
+	if opts.Filter != "" {
+		details = append(details, "filter "+opts.Filter)
+	}
+	if len(opts.SparsePaths) > 0 {
+		details = append(details, "sparse: "+strings.Join(opts.SparsePaths, ", "))
+	}
+	if repo.Pin != "" {
+		details = append(details, "pin "+repo.Pin)
+	}
+	if remotes, err := cfg.RemotesFor(repo); err == nil && len(remotes) > 0 {
+		details = append(details, "remotes: "+strings.Join(sortedKeys(remotes), ", "))
+	}
+	if values := cfg.GitConfigFor(repo); len(values) > 0 {
+		details = append(details, "git config: "+strings.Join(sortedKeys(values), ", "))
+	}
+	if len(repo.PostClone) > 0 {
+		details = append(details, fmt.Sprintf("%d post_clone step(s)", len(repo.PostClone)))
+	}
 
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
+	result.Success = true
+	result.Message = fmt.Sprintf("would clone %s into %s", repo.URL, repoPath)
+	if len(details) > 0 {
+		result.Message += " (" + strings.Join(details, "; ") + ")"
+	}
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// runPostCloneSteps runs the repository's post_clone steps in order in the new clone
+// with the hook environment, stopping at the first failing step
+func runPostCloneSteps(mgr *repository.Manager, repo config.Repository, branch string) error {
+	timeout := mgr.Config().HookTimeout
+	if timeout <= 0 {
+		timeout = shell.DefaultTimeout
+	}
+	repoPath := mgr.GetRepositoryPath(repo)
+	env := hookEnv(mgr, repo, "post_clone", branch)
+
+	for i, step := range repo.PostClone {
+		log.Debugf("%s: running post_clone step %d: %s", repo.Name, i+1, step)
+		output, err := shell.ExecuteWithEnv(repoPath, hookShell, step, env, timeout)
+		if err != nil {
+			message := fmt.Sprintf("cloned but post_clone step %d (%s) failed: %v", i+1, step, err)
+			if output = strings.TrimSpace(output); output != "" {
+				message += "\n" + output
+			}
+			message += "\n  hint: fix the step and run the remaining steps in " + repoPath + ", or remove the directory and clone again"
+			return fmt.Errorf("%s", message)
+		}
+		if output != "" {
+			log.Debugf("%s: post_clone step %d output:\n%s", repo.Name, i+1, output)
+		}
 	}
+	return nil
 }
 
 func GetCloneCmd() *cobra.Command {
@@ -0,0 +1,221 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/spf13/cobra"
+)
+
+// Commit 플래그 변수
+var (
+	commitMessage    string   // 커밋 메시지 (필수)
+	commitAddAll     bool     // 모든 변경사항 stage
+	commitInclude    []string // stage할 glob 패턴
+	commitAllowEmpty bool     // 빈 커밋 허용
+	commitSignoff    bool     // Signed-off-by 추가
+	commitAuthor     string   // 작성자 덮어쓰기 ("Name <email>")
+	commitCommitter  string   // 커미터 덮어쓰기 ("Name <email>")
+	commitParallel   int      // 병렬 처리 수
+)
+
+var commitCmd = &cobra.Command{
+	Use:   "commit",
+	Short: "Commit changes across all repositories",
+	Long: `Create a commit with the same message in every repository that has changes.
+Repositories with nothing to commit are skipped.
+
+Author and committer come from each repository's git config (user.name, user.email),
+unless commit_author / commit_committer are set in the config or --author /
+--committer are given, e.g. for automation accounts. The overrides apply to
+this run only; the repositories' git config is not changed. --author changes
+only the author; the committer defaults to the author.
+
+Examples:
+  # Commit everything changed by a previous exec run
+  multi-git exec "npm install lodash@4.17.21"
+  multi-git commit -m "Bump lodash to 4.17.21" --add-all
+
+  # Only commit dependency manifests
+  multi-git commit -m "Bump lodash" --include package.json --include package-lock.json
+
+  # Add a Signed-off-by trailer
+  multi-git commit -m "Update CODEOWNERS" -a --signoff
+
+  # Commit as a bot account
+  multi-git commit -m "Bump lodash" -a --author "Release Bot <release-bot@example.com>"`,
+	Args: cobra.NoArgs,
+	Run:  runCommit,
+}
+
+func init() {
+	commitCmd.Flags().StringVarP(&commitMessage, "message", "m", "",
+		"Commit message (required)")
+	commitCmd.Flags().BoolVarP(&commitAddAll, "add-all", "a", false,
+		"Stage all changes including untracked files before committing")
+	commitCmd.Flags().StringSliceVar(&commitInclude, "include", nil,
+		"Stage paths matching this glob before committing (repeatable)")
+	commitCmd.Flags().BoolVar(&commitAllowEmpty, "allow-empty", false,
+		"Create a commit even if there are no changes")
+	commitCmd.Flags().BoolVarP(&commitSignoff, "signoff", "s", false,
+		"Add a Signed-off-by trailer")
+	commitCmd.Flags().StringVar(&commitAuthor, "author", "",
+		"Author of the commits as 'Name <email>' (overrides commit_author and the git config)")
+	commitCmd.Flags().StringVar(&commitCommitter, "committer", "",
+		"Committer of the commits as 'Name <email>' (overrides commit_committer; default: the author)")
+	commitCmd.Flags().IntVarP(&commitParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	commitCmd.MarkFlagRequired("message")
+}
+
+func runCommit(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	if strings.TrimSpace(commitMessage) == "" {
+		fmt.Fprintf(os.Stderr, "Error: commit message cannot be empty\n")
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	author, committer, err := commitSignatures(cfg, commitAuthor, commitCommitter)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 결정
+	workers := commitParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 5. Commit Task 정의
+	commitTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		// Step 1: 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// Step 2: 커밋 생성
+		hash, err := client.Commit(&git.CommitOptions{
+			Message:    commitMessage,
+			AddAll:     commitAddAll,
+			Include:    commitInclude,
+			AllowEmpty: commitAllowEmpty,
+			Signoff:    commitSignoff,
+			Author:     author,
+			Committer:  committer,
+		})
+		if errors.Is(err, git.ErrNothingToCommit) {
+			// 변경사항이 없으면 스킵
+			result.Skip("nothing to commit")
+			return result, nil
+		}
+		if err != nil {
+			result.Success = false
+			result.Error = enhanceCommitError(err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		result.Success = true
+		result.Message = fmt.Sprintf("committed %s", hash[:7])
+		result.SetDetail("commit", hash)
+		if _, files, err := client.ChangeStats(hash+"~1", hash); err == nil {
+			result.SetDetail("files_changed", files)
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	subject, _, _ := strings.Cut(commitMessage, "\n")
+	reporter.PrintHeader(fmt.Sprintf("Committing '%s'", subject))
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, withHooks(cmd, mgr, "commit", "", commitTask))
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// commitSignatures returns the author and committer of commits: the --author and
+// --committer values, then commit_author and commit_committer of the config
+// Nil means the git config of each repository (author) or the author (committer).
+func commitSignatures(cfg *config.Config, authorFlag, committerFlag string) (author, committer *object.Signature, err error) {
+	configAuthor, configCommitter := cfg.CommitIdentity()
+	if author, err = identityFlag("author", authorFlag, configAuthor); err != nil {
+		return nil, nil, err
+	}
+	if committer, err = identityFlag("committer", committerFlag, configCommitter); err != nil {
+		return nil, nil, err
+	}
+	return author, committer, nil
+}
+
+// identityFlag returns the signature of an identity flag ("Name <email>"), or of
+// the configured identity if the flag is empty
+func identityFlag(flag, value string, configured config.Identity) (*object.Signature, error) {
+	if value == "" {
+		return identitySignature(configured), nil
+	}
+	identity, err := config.ParseIdentity(value)
+	if err != nil {
+		return nil, fmt.Errorf("--%s: %w", flag, err)
+	}
+	return identitySignature(identity), nil
+}
+
+// identitySignature returns the signature of an identity, or nil if it is not set
+// The time is filled in when the commit or tag is created.
+func identitySignature(identity config.Identity) *object.Signature {
+	if identity.IsZero() {
+		return nil
+	}
+	return &object.Signature{Name: identity.Name, Email: identity.Email}
+}
+
+// enhanceCommitError enhances error messages with helpful hints
+func enhanceCommitError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if strings.Contains(err.Error(), "user.name") {
+		return fmt.Errorf("%w\n  hint: run 'git config --global user.name \"Your Name\"' and 'git config --global user.email you@example.com', or set commit_author in the config", err)
+	}
+
+	return err
+}
+
+func GetCommitCmd() *cobra.Command {
+	return commitCmd
+}
@@ -0,0 +1,502 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/mailmap"
+	"github.com/alexgim961101/multi-git/internal/metrics"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// ProfileEnv is the environment variable read when --profile is not set
+const ProfileEnv = "MULTI_GIT_PROFILE"
+
+// configProfile returns the config profile selected with --profile or MULTI_GIT_PROFILE
+func configProfile(cmd *cobra.Command) string {
+	if profile, _ := cmd.Root().PersistentFlags().GetString("profile"); profile != "" {
+		return profile
+	}
+	return os.Getenv(ProfileEnv)
+}
+
+// configOverlays returns the overlay files selected with --overlay, in order
+func configOverlays(cmd *cobra.Command) []string {
+	overlays, _ := cmd.Root().PersistentFlags().GetStringSlice("overlay")
+	return overlays
+}
+
+// loadConfig loads and validates the configuration file (or discovers the
+// repositories with --discover), then applies the global repository filters
+// (--group, --repos, --only-*). Exits on error.
+func loadConfig(cmd *cobra.Command) *config.Config {
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+
+	var cfg *config.Config
+	var err error
+	if root := discoverRoot(cmd); root != "" {
+		// --discover: 설정 파일 대신 디렉토리에서 찾은 저장소 사용
+		cfg, err = discoverConfig(cmd, root)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			writeRunReport(cmd, nil, repository.ExitConfigError, err)
+			os.Exit(repository.ExitConfigError)
+		}
+	} else {
+		cfg, err = config.LoadAndValidateProfile(configPath, configProfile(cmd), configOverlays(cmd)...)
+		if err != nil {
+			exitOnConfigError(cmd, configPath, err)
+		}
+	}
+
+	// --group 필터 적용
+	groups, _ := cmd.Root().PersistentFlags().GetStringSlice("group")
+	if len(groups) > 0 {
+		cfg.Repositories = config.FilterByGroups(cfg.Repositories, groups)
+		if len(cfg.Repositories) == 0 {
+			fmt.Fprintf(os.Stderr, "Error: no repositories found in group(s): %s\n", strings.Join(groups, ", "))
+			fmt.Fprintf(os.Stderr, "  hint: check the 'groups' field of repositories in your config\n")
+			os.Exit(1)
+		}
+	}
+
+	// --repos 필터 적용 (이름 또는 glob)
+	if patterns, _ := cmd.Root().PersistentFlags().GetStringSlice("repos"); len(patterns) > 0 {
+		filtered, err := config.FilterByNames(cfg.Repositories, patterns)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "  hint: run 'multi-git path --list' to see repository names\n")
+			os.Exit(1)
+		}
+		if len(filtered) == 0 {
+			fmt.Fprintf(os.Stderr, "Error: no repositories match --repos %s\n", strings.Join(patterns, ","))
+			os.Exit(1)
+		}
+		cfg.Repositories = filtered
+	}
+
+	// --only-dirty, --only-clean, --only-on-branch, --only-behind: 현재 git 상태로 필터
+	filter, err := stateFilterFromFlags(cmd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if filter.active() {
+		filtered, warnings := filterByState(cfg, filter)
+		for _, warning := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+		}
+		if len(filtered) == 0 {
+			fmt.Fprintf(os.Stderr, "No repositories match %s\n", filter)
+			os.Exit(0)
+		}
+		cfg.Repositories = filtered
+	}
+
+	// --interactive: 대상 저장소 직접 선택
+	if interactive, _ := cmd.Root().PersistentFlags().GetBool("interactive"); interactive {
+		selected, err := selectRepositories(cfg.Repositories)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if len(selected) == 0 {
+			fmt.Fprintln(os.Stderr, "No repositories selected")
+			os.Exit(0)
+		}
+		cfg.Repositories = selected
+	}
+
+	// --timeout, --repo-timeout: 설정의 제한 시간 덮어쓰기
+	if flag := cmd.Root().PersistentFlags().Lookup("timeout"); flag != nil && flag.Changed {
+		cfg.CommandTimeout, _ = cmd.Root().PersistentFlags().GetDuration("timeout")
+	}
+	if flag := cmd.Root().PersistentFlags().Lookup("repo-timeout"); flag != nil && flag.Changed {
+		cfg.RepoTimeout, _ = cmd.Root().PersistentFlags().GetDuration("repo-timeout")
+	}
+	if cfg.CommandTimeout < 0 || cfg.RepoTimeout < 0 {
+		fmt.Fprintf(os.Stderr, "Error: --timeout and --repo-timeout cannot be negative\n")
+		os.Exit(1)
+	}
+
+	// --throttle: 설정의 속도 제한 덮어쓰기
+	if flag := cmd.Root().PersistentFlags().Lookup("throttle"); flag != nil && flag.Changed {
+		cfg.MaxOpsPerSecond, _ = cmd.Root().PersistentFlags().GetFloat64("throttle")
+		if cfg.MaxOpsPerSecond < 0 {
+			fmt.Fprintf(os.Stderr, "Error: --throttle cannot be negative\n")
+			os.Exit(1)
+		}
+	}
+
+	// umask: 이후 생성하는 파일과 디렉토리 권한 (공유 빌드 서버 등)
+	if cfg.Umask != nil {
+		setUmask(int(*cfg.Umask))
+	}
+
+	// --estimate: 예상 소요 시간만 출력하고 종료
+	if estimate, _ := cmd.Root().PersistentFlags().GetBool("estimate"); estimate {
+		printEstimate(cmd, cfg)
+		os.Exit(0)
+	}
+
+	return cfg
+}
+
+// executeTasks runs the task across all repositories with the given parallelism,
+// shows progress on the reporter, writes per-repository run logs and a checkpoint
+// for --resume, and records per-repository timings for later estimates
+func executeTasks(ctx context.Context, cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter, workers int, task repository.TaskFunc) *repository.Summary {
+	task, logDir := withRunLog(cmd, mgr, task)
+
+	// 체크포인트: 완료된 저장소 기록, --resume이면 이미 완료된 저장소 제외
+	task, checkpoint, restored := withCheckpoint(cmd, mgr, task)
+
+	// DNS 사전 확인: 호스트 이름을 해석할 수 없는 저장소는 연결을 시도하지 않고 바로 실패
+	if networkOperations[operationName(cmd)] {
+		task = failUnresolvedHosts(ctx, mgr, reporter, task)
+	}
+
+	// 다른 플랫폼 전용 저장소는 실행하지 않고 스킵 (platforms)
+	task = skipOtherPlatforms(task)
+
+	// 진행 표시줄 (--no-progress로 비활성화, 실시간 출력과 섞이지 않도록 --stream에서도 비활성화)
+	if noProgress, _ := cmd.Root().PersistentFlags().GetBool("no-progress"); noProgress || reporter.Streaming() {
+		reporter.SetProgress(false)
+	}
+	// --timing: 요약에 백분위, 가장 느린 저장소, 단계별 소요 시간 출력
+	if timing, _ := cmd.Root().PersistentFlags().GetBool("timing"); timing {
+		reporter.SetTiming(true)
+	}
+	reporter.StartProgress(mgr.RepositoryCount(), operationName(cmd))
+	reporter.StartStream(mgr.RepositoryNames())
+	progressTask := task
+	task = func(repo config.Repository) (repository.Result, error) {
+		taskCtx := mgr.TaskContext(repo.Name)
+		result := progressTask.Run(repo)
+		reporter.Tick(repo.Name)
+		switch cause := context.Cause(taskCtx); {
+		case errors.Is(cause, repository.ErrTaskCancelled):
+			// 실행 중 취소된 저장소의 결과 줄은 취소 시 이미 출력됨
+		case repository.IsRepoError(cause, repository.ErrTimeout):
+			// 제한 시간으로 중단된 작업의 에러 대신 시간 초과로 출력
+			reporter.StreamResult(repository.Result{RepoName: repo.Name, Status: repository.StatusTimedOut, Error: cause, Duration: result.Duration})
+		default:
+			reporter.StreamResult(result)
+		}
+		return result, nil
+	}
+
+	// --fail-fast: 첫 실패 후 나머지 저장소 취소
+	if failFast, err := cmd.Flags().GetBool("fail-fast"); err == nil {
+		mgr.SetFailFast(failFast)
+	}
+
+	// 제한 시간: 저장소별 (repo_timeout)과 명령어 전체 (command_timeout)
+	mgr.SetRepoTimeout(mgr.Config().RepoTimeout)
+
+	// 속도 제한: 원격 서버에 접속하는 작업만 (max_ops_per_second, --throttle)
+	if networkOperations[operationName(cmd)] {
+		mgr.SetRateLimit(mgr.Config().MaxOpsPerSecond)
+	}
+
+	// SSH 연결 공유: 같은 호스트의 저장소들이 연결 하나를 사용 (ssh_multiplex)
+	if mgr.Config().SSHMultiplex {
+		pool := git.EnableSSHPool(mgr.Config().SSHMaxSessions)
+		defer pool.Close()
+	}
+
+	// HTTP 연결 재사용: 모든 HTTPS 작업이 keep-alive 연결 풀 하나를 공유 (http_max_idle_conns_per_host)
+	idleConns := mgr.Config().HTTPMaxIdleConnsPerHost
+	if idleConns == 0 {
+		idleConns = max(git.DefaultHTTPMaxIdleConnsPerHost, workers) // 작업자마다 연결 하나는 유지
+	}
+	httpTransport := git.EnableHTTPTransport(idleConns)
+	defer httpTransport.Close()
+
+	// 공유 객체 캐시: 같은 저장소를 다시 열어도 읽은 객체 재사용 (object_cache_size)
+	if size := mgr.Config().ObjectCacheSize; size > 0 {
+		objectCache := git.EnableObjectCache(int64(size))
+		defer objectCache.Close()
+	}
+
+	if timeout := mgr.Config().CommandTimeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, timeout,
+			fmt.Errorf("not started: command timed out after %s", timeout))
+		defer cancel()
+	}
+
+	// Ctrl-C: 시작하지 않은 저장소는 취소하고 실행 중인 저장소는 마무리
+	ctx, stopInterrupt := cancelOnInterrupt(ctx)
+	defer stopInterrupt()
+
+	// --stream: 저장소 이름을 입력하면 실행 중인 그 저장소만 취소
+	stopCancelInput := watchCancelInput(cmd, mgr, reporter)
+	defer stopCancelInput()
+
+	var summary *repository.Summary
+	if workers > 1 {
+		// 임시로 ParallelWorkers 설정을 위해 config 수정
+		mgr.Config().ParallelWorkers = workers
+		summary = mgr.ExecuteParallel(ctx, task, nil)
+	} else {
+		summary = mgr.ExecuteSequential(ctx, task, nil)
+	}
+	reporter.FinishProgress()
+
+	recordTimings(cmd, mgr, summary)
+	recordMetrics(cmd, mgr, summary)
+	reportRunLogs(logDir, summary)
+
+	// 재개한 경우 이전 실행의 결과 포함
+	if len(restored) > 0 {
+		summary = repository.NewSummary(append(restored, summary.Results...), summary.TotalDuration)
+	}
+	finishCheckpoint(checkpoint, summary)
+
+	// 마지막 실행 보고서 저장 (open --failed)
+	saveLastRunReport(cmd, mgr, summary)
+
+	// --notify-desktop: 오래 걸린 실행의 결과를 데스크톱 알림으로
+	notifyDesktop(cmd, summary)
+	return summary
+}
+
+// cancelOnInterrupt returns a context cancelled by the first Ctrl-C (SIGINT) or SIGTERM,
+// so that the repositories not started yet are reported as cancelled while the
+// running ones finish. A second signal terminates the process as usual. The
+// returned function stops listening for the signals.
+func cancelOnInterrupt(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	done := make(chan struct{})
+	go func() {
+		select {
+		case sig := <-signals:
+			signal.Stop(signals)
+			fmt.Fprintf(os.Stderr, "\nInterrupted: finishing the running repositories (press Ctrl-C again to abort)\n")
+			cancel(fmt.Errorf("not started: interrupted (%s)", sig))
+		case <-done:
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		close(done)
+		cancel(nil)
+	}
+}
+
+// skipOtherPlatforms wraps the task so that repositories whose platforms do not include
+// the current OS and architecture are reported as skipped instead of running the task
+func skipOtherPlatforms(task repository.TaskFunc) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		if repo.SupportsPlatform(runtime.GOOS, runtime.GOARCH) {
+			return task.Run(repo), nil
+		}
+		return repository.Result{
+			RepoName: repo.Name,
+			Success:  true,
+			Status:   repository.StatusSkipped,
+			Message: fmt.Sprintf("skipped: not for %s/%s (platforms: %s)",
+				runtime.GOOS, runtime.GOARCH, strings.Join(repo.Platforms, ", ")),
+		}, nil
+	}
+}
+
+// dnsCheckTimeout is how long the DNS check before a network operation waits for all hosts
+const dnsCheckTimeout = 10 * time.Second
+
+// failUnresolvedHosts resolves the distinct remote hosts of the repositories once and
+// wraps the task so that repositories on hosts that cannot be resolved fail at once,
+// instead of each waiting for the OS resolver timeout. Unresolved hosts are reported
+// grouped before the run. Hosts that do not exist are hard failures (e.g. a typo in
+// the URL); other lookup failures are transient network errors.
+func failUnresolvedHosts(ctx context.Context, mgr *repository.Manager, reporter *repository.Reporter, task repository.TaskFunc) repository.TaskFunc {
+	// 호스트별 저장소 (설정 순서 유지)
+	var hosts []string
+	reposByHost := make(map[string][]string)
+	for _, repo := range mgr.Repositories() {
+		host := git.RemoteHost(repo.URL)
+		if host == "" {
+			continue
+		}
+		if _, ok := reposByHost[host]; !ok {
+			hosts = append(hosts, host)
+		}
+		reposByHost[host] = append(reposByHost[host], repo.Name)
+	}
+	if len(hosts) == 0 {
+		return task
+	}
+
+	failed := git.ResolveHosts(ctx, hosts, dnsCheckTimeout)
+	if len(failed) == 0 {
+		return task
+	}
+	for _, host := range hosts {
+		if err, ok := failed[host]; ok {
+			repos := reposByHost[host]
+			reporter.PrintWarning(fmt.Sprintf("%v (%s: %s)", err, plural(len(repos), "repository"), strings.Join(repos, ", ")))
+		}
+	}
+
+	return func(repo config.Repository) (repository.Result, error) {
+		host := git.RemoteHost(repo.URL)
+		err, ok := failed[host]
+		if !ok {
+			return task.Run(repo), nil
+		}
+		if errors.Is(err, git.ErrHostNotFound) {
+			err = fmt.Errorf("%w\n  hint: check the host in the repository URL: %s", err, repo.URL)
+		} else {
+			err = repository.ErrNetworkErrorError(repo.Name, err)
+		}
+		return repository.Result{RepoName: repo.Name, Error: err}, nil
+	}
+}
+
+// exitOnFailures writes the --report file and exits with the summary's exit code
+// if repositories failed or were cancelled
+// Without --error-budget, hard failures exit 1 and transient failures alone exit 75.
+// With --error-budget N, the command fails only if more than N repositories failed
+// with hard errors; failures within the budget are reported as a warning. A run
+// interrupted without failures exits 3.
+func exitOnFailures(cmd *cobra.Command, summary *repository.Summary) {
+	budget, _ := cmd.Root().PersistentFlags().GetInt("error-budget")
+	code := summary.ExitCode(budget)
+	writeRunReport(cmd, summary, code, nil)
+	if summary.HasFailures() && code != repository.ExitFailure && code != repository.ExitTransient {
+		fmt.Fprintf(os.Stderr, "Warning: %d failed (%d transient) within the error budget of %d hard failures\n",
+			summary.FailedCount, summary.TransientCount, budget)
+	}
+	if code != 0 {
+		os.Exit(code)
+	}
+}
+
+// exitOnConfigError reports a config file that cannot be loaded and exits with ExitConfigError
+func exitOnConfigError(cmd *cobra.Command, configPath string, err error) {
+	log.Errorf("loading config %s: %v", configPath, err)
+	fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+	writeRunReport(cmd, nil, repository.ExitConfigError, err)
+	os.Exit(repository.ExitConfigError)
+}
+
+// networkOperations are the commands whose tasks contact the remote and are rate limited
+var networkOperations = map[string]bool{
+	"clone":  true,
+	"fetch":  true,
+	"mirror": true,
+	"pull":   true,
+	"push":   true,
+	"sync":   true,
+}
+
+// operationName returns the command path without the root command (e.g. "policy check")
+func operationName(cmd *cobra.Command) string {
+	return strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+}
+
+// recordTimings stores the durations of a run, skipping dry-runs
+// Failing to store timings never fails the command
+func recordTimings(cmd *cobra.Command, mgr *repository.Manager, summary *repository.Summary) {
+	if flag := cmd.Flags().Lookup("dry-run"); flag != nil && flag.Value.String() == "true" {
+		return
+	}
+
+	store, err := repository.LoadTimingStore(mgr.StatePath(repository.TimingsFileName))
+	if err != nil {
+		log.Warnf("timings not recorded: %v", err)
+		return
+	}
+	store.Record(operationName(cmd), summary)
+	if err := store.Save(); err != nil {
+		log.Warnf("timings not recorded: %v", err)
+	}
+}
+
+// printEstimate prints the predicted duration of the command at the chosen parallelism
+func printEstimate(cmd *cobra.Command, cfg *config.Config) {
+	flag := cmd.Flags().Lookup("parallel")
+	if flag == nil {
+		fmt.Fprintf(os.Stderr, "Error: --estimate is not supported by '%s'\n", operationName(cmd))
+		os.Exit(1)
+	}
+
+	mgr := repository.NewManager(cfg)
+	workers, _ := cmd.Flags().GetInt("parallel")
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	store, err := repository.LoadTimingStore(mgr.StatePath(repository.TimingsFileName))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	estimate := mgr.EstimateDuration(store, operationName(cmd), workers)
+	fmt.Println(estimate)
+}
+
+// streamWriter returns the live output writer of a repository if the reporter streams,
+// or nil so that nothing is written
+func streamWriter(reporter *repository.Reporter, repo config.Repository) io.Writer {
+	if !reporter.Streaming() {
+		return nil
+	}
+	return reporter.StreamWriter(repo.Name)
+}
+
+// newGitClient creates a git client for the repository with its configured credentials
+func newGitClient(cfg *config.Config, repo config.Repository) *git.Client {
+	return credentials.NewClient(cfg, repo)
+}
+
+// authorMailmap returns the mailmap normalizing the authors of a repository:
+// its own .mailmap, then the fleet-level mailmap of the config
+func authorMailmap(cfg *config.Config, repoPath string) (*mailmap.Mailmap, error) {
+	repoMap, err := mailmap.Load(filepath.Join(repoPath, mailmap.FileName))
+	if err != nil {
+		return nil, err
+	}
+	fleetMap, err := mailmap.ParseLines(cfg.Mailmap)
+	if err != nil {
+		return nil, err
+	}
+	return repoMap.Chain(fleetMap), nil
+}
+
+// recordMetrics aggregates the run into the local metrics store if metrics are enabled
+func recordMetrics(cmd *cobra.Command, mgr *repository.Manager, summary *repository.Summary) {
+	if !mgr.Config().Metrics.Enabled {
+		return
+	}
+
+	store, err := metrics.Load(mgr.StatePath(metrics.FileName))
+	if err != nil {
+		log.Warnf("metrics not recorded: %v", err)
+		return
+	}
+	store.Record(operationName(cmd), summary.TotalCount, summary.FailedCount, summary.TotalDuration)
+	if err := store.Save(); err != nil {
+		log.Warnf("metrics not recorded: %v", err)
+	}
+}
@@ -0,0 +1,289 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Compare-tags 플래그 변수
+var (
+	compareTagsA        string // 이전 태그 (예: 현재 운영 버전)
+	compareTagsB        string // 새 태그 (예: 배포할 버전)
+	compareTagsChanges  int    // 저장소별 출력할 주요 변경 수
+	compareTagsMarkdown bool   // Markdown 출력 (변경 티켓용)
+	compareTagsJSON     bool   // JSON 출력
+	compareTagsParallel int    // 병렬 처리 수
+)
+
+// tagComparison is the delta between the two tags in one repository
+type tagComparison struct {
+	Repository  string         `json:"repository"`
+	Missing     []string       `json:"missing,omitempty"` // 저장소에 없는 태그
+	Commits     int            `json:"commits"`           // a..b 커밋 수 (merge 포함)
+	Files       int            `json:"files"`             // 변경된 파일 수
+	Additions   int            `json:"additions"`         // 추가된 줄 수
+	Deletions   int            `json:"deletions"`         // 삭제된 줄 수
+	Changes     []git.LogEntry `json:"changes"`           // merge가 아닌 커밋 (최신 우선)
+	Unreachable bool           `json:"unreachable"`       // b가 a를 포함하지 않음 (a에만 있는 커밋이 있음)
+}
+
+var compareTagsCmd = &cobra.Command{
+	Use:   "compare-tags --a <tag> --b <tag>",
+	Short: "Report the changes between two tags in every repository",
+	Long: `Compare two tags (e.g. what runs in staging and what runs in production) in
+every repository: the number of commits and changed lines between them and
+the subjects of the commits, newest first. Repositories where either tag does
+not exist are reported as "tag missing".
+
+Use --markdown for a report to paste into a change ticket. Merge commits are
+counted but not listed. Comparing uses the git binary.
+
+Examples:
+  # What goes out when v1.4.0 replaces v1.3.0
+  multi-git compare-tags --a v1.3.0 --b v1.4.0
+
+  # The delta report for the change ticket
+  multi-git compare-tags --a v1.3.0 --b v1.4.0 --markdown > delta.md
+
+  # Every change, as JSON
+  multi-git compare-tags --a v1.3.0 --b v1.4.0 --changes 0 --json`,
+	Args: cobra.NoArgs,
+	Run:  runCompareTags,
+}
+
+func init() {
+	compareTagsCmd.Flags().StringVar(&compareTagsA, "a", "",
+		"Tag to compare from (required, e.g. the deployed release)")
+	compareTagsCmd.Flags().StringVar(&compareTagsB, "b", "",
+		"Tag to compare to (required, e.g. the release to deploy)")
+	compareTagsCmd.Flags().IntVar(&compareTagsChanges, "changes", 10,
+		"Maximum number of commit subjects listed per repository (0 = all)")
+	compareTagsCmd.Flags().BoolVar(&compareTagsMarkdown, "markdown", false,
+		"Print the report as Markdown")
+	compareTagsCmd.Flags().BoolVar(&compareTagsJSON, "json", false,
+		"Print the report as JSON")
+	compareTagsCmd.Flags().IntVarP(&compareTagsParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	compareTagsCmd.MarkFlagRequired("a")
+	compareTagsCmd.MarkFlagRequired("b")
+}
+
+func runCompareTags(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 플래그 유효성 검증
+	if compareTagsMarkdown && compareTagsJSON {
+		fmt.Fprintf(os.Stderr, "Error: --markdown and --json cannot be combined\n")
+		os.Exit(1)
+	}
+	if compareTagsA == compareTagsB {
+		fmt.Fprintf(os.Stderr, "Error: --a and --b are the same tag '%s'\n", compareTagsA)
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성 (보고서 출력 시 진행 상황은 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if compareTagsMarkdown || compareTagsJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 5. 병렬 수 결정
+	workers := compareTagsParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 저장소별 비교 결과
+	var mu sync.Mutex
+	comparisons := make(map[string]*tagComparison)
+
+	// 6. Compare-tags Task 정의
+	compareTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+		comparison := &tagComparison{Repository: repo.Name, Changes: []git.LogEntry{}}
+		record := func() {
+			mu.Lock()
+			comparisons[repo.Name] = comparison
+			mu.Unlock()
+		}
+
+		// 두 태그가 모두 있는 저장소만 비교
+		for _, tag := range []string{compareTagsA, compareTagsB} {
+			exists, err := client.TagExists(tag)
+			if err != nil {
+				return fail(fmt.Errorf("failed to check tag: %w", err)), nil
+			}
+			if !exists {
+				comparison.Missing = append(comparison.Missing, tag)
+			}
+		}
+		if len(comparison.Missing) > 0 {
+			record()
+			result.Skip("tag missing: " + strings.Join(comparison.Missing, ", "))
+			return result, nil
+		}
+
+		// 같은 이름의 브랜치와 헷갈리지 않도록 태그 ref로 비교
+		from, to := "refs/tags/"+compareTagsA, "refs/tags/"+compareTagsB
+		stat, err := client.GetDiffStat(from, to)
+		if err != nil {
+			return fail(err), nil
+		}
+		changes, err := client.RangeLog(from, to)
+		if err != nil {
+			return fail(err), nil
+		}
+		behind, err := client.RangeLog(to, from)
+		if err != nil {
+			return fail(err), nil
+		}
+
+		authors, err := authorMailmap(cfg, repoPath)
+		if err != nil {
+			return fail(err), nil
+		}
+		for i := range changes {
+			changes[i].Author, changes[i].Email = authors.Resolve(changes[i].Author, changes[i].Email)
+		}
+
+		comparison.Commits = stat.Commits
+		comparison.Files = len(stat.Files)
+		comparison.Additions = stat.Additions
+		comparison.Deletions = stat.Deletions
+		comparison.Changes = append(comparison.Changes, changes...)
+		comparison.Unreachable = len(behind) > 0
+		record()
+
+		result.Success = true
+		result.Message = formatDiffStat(stat)
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Comparing tags %s..%s", compareTagsA, compareTagsB))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, compareTask)
+
+	// 8. 설정 파일 순서로 보고서 출력
+	var report []*tagComparison
+	for _, repo := range cfg.Repositories {
+		if comparison, ok := comparisons[repo.Name]; ok {
+			report = append(report, comparison)
+		}
+	}
+	switch {
+	case compareTagsJSON:
+		if report == nil {
+			report = []*tagComparison{}
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode comparison: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	case compareTagsMarkdown:
+		printTagComparisonMarkdown(report)
+	default:
+		printTagComparison(report)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// describe returns the one-line summary of the comparison (e.g. "3 commits, 5 files changed, +120 -30")
+func (t *tagComparison) describe() string {
+	if len(t.Missing) > 0 {
+		return "tag missing: " + strings.Join(t.Missing, ", ")
+	}
+	description := formatDiffStat(&git.DiffStat{Commits: t.Commits, Additions: t.Additions, Deletions: t.Deletions, Files: make([]git.FileChange, t.Files)})
+	if t.Unreachable {
+		description += fmt.Sprintf(" (%s is not based on %s)", compareTagsB, compareTagsA)
+	}
+	return description
+}
+
+// listedChanges returns the changes to print, limited by --changes, and how many were left out
+func (t *tagComparison) listedChanges() ([]git.LogEntry, int) {
+	if compareTagsChanges > 0 && len(t.Changes) > compareTagsChanges {
+		return t.Changes[:compareTagsChanges], len(t.Changes) - compareTagsChanges
+	}
+	return t.Changes, 0
+}
+
+// printTagComparison prints the comparison of each repository with its headline changes
+func printTagComparison(report []*tagComparison) {
+	width := 0
+	for _, comparison := range report {
+		width = max(width, len(comparison.Repository))
+	}
+	for _, comparison := range report {
+		fmt.Printf("\n%-*s  %s\n", width, comparison.Repository, comparison.describe())
+		changes, more := comparison.listedChanges()
+		for _, change := range changes {
+			fmt.Printf("  %s %s\n", change.ShortHash(), change.Subject)
+		}
+		if more > 0 {
+			fmt.Printf("  ... and %d more\n", more)
+		}
+	}
+}
+
+// printTagComparisonMarkdown prints the comparison as a Markdown section per repository
+func printTagComparisonMarkdown(report []*tagComparison) {
+	fmt.Printf("## Changes from %s to %s\n", compareTagsA, compareTagsB)
+	for _, comparison := range report {
+		fmt.Printf("\n### %s\n\n%s\n", comparison.Repository, comparison.describe())
+		changes, more := comparison.listedChanges()
+		if len(changes) > 0 {
+			fmt.Println()
+		}
+		for _, change := range changes {
+			fmt.Printf("- %s (`%s`, %s)\n", change.Subject, change.ShortHash(), change.Author)
+		}
+		if more > 0 {
+			fmt.Printf("- ... and %d more\n", more)
+		}
+	}
+}
+
+func GetCompareTagsCmd() *cobra.Command {
+	return compareTagsCmd
+}
@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+var completionCmd = &cobra.Command{
+	Use:   "completion [bash|zsh|fish|powershell]",
+	Short: "Generate a shell completion script",
+	Long: `Print a completion script for commands and flags of multi-git.
+
+Besides commands and flags, repository names (--repos, path), group names
+(--group), and branch names (checkout, sync, --branch) are completed from the
+config file and the local repositories.
+
+Examples:
+  # bash (requires the bash-completion package)
+  multi-git completion bash > /etc/bash_completion.d/multi-git
+  # or, for the current user (~/.bashrc)
+  source <(multi-git completion bash)
+
+  # zsh (~/.zshrc, after compinit)
+  source <(multi-git completion zsh)
+
+  # fish
+  multi-git completion fish > ~/.config/fish/completions/multi-git.fish
+
+  # PowerShell ($PROFILE)
+  multi-git completion powershell | Out-String | Invoke-Expression`,
+	Args:                  cobra.ExactArgs(1),
+	ValidArgs:             []string{"bash", "zsh", "fish", "powershell"},
+	DisableFlagsInUseLine: true,
+	Run:                   runCompletion,
+}
+
+func runCompletion(cmd *cobra.Command, args []string) {
+	out := cmd.OutOrStdout()
+	root := cmd.Root()
+
+	var err error
+	switch args[0] {
+	case "bash":
+		err = root.GenBashCompletionV2(out, true)
+	case "zsh":
+		err = root.GenZshCompletion(out)
+	case "fish":
+		err = root.GenFishCompletion(out, true)
+	case "powershell":
+		err = root.GenPowerShellCompletionWithDesc(out)
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unsupported shell '%s' (supported: bash, zsh, fish, powershell)\n", args[0])
+		os.Exit(1)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to generate completion: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// RegisterGlobalCompletions adds dynamic completion to the global flags of the root command
+// Called after the persistent flags are defined
+func RegisterGlobalCompletions(root *cobra.Command) {
+	_ = root.RegisterFlagCompletionFunc("repos", completeRepoList)
+	_ = root.RegisterFlagCompletionFunc("group", completeGroupList)
+	_ = root.RegisterFlagCompletionFunc("only-on-branch", completeBranchOrDefault)
+}
+
+// completionConfig loads the config file for completion without validating it
+// Returns nil if it cannot be loaded; completion then offers nothing
+func completionConfig(cmd *cobra.Command) *config.Config {
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	cfg, err := config.LoadConfigProfile(configPath, configProfile(cmd), configOverlays(cmd)...)
+	if err != nil {
+		return nil
+	}
+	return cfg
+}
+
+// completeRepoList completes the last element of a comma-separated list of repository names
+func completeRepoList(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := completionConfig(cmd)
+	if cfg == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(cfg.Repositories))
+	for _, repo := range cfg.Repositories {
+		names = append(names, repo.Name)
+	}
+	return completeListElement(names, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeGroupList completes the last element of a comma-separated list of group names
+func completeGroupList(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := completionConfig(cmd)
+	if cfg == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	seen := make(map[string]bool)
+	var groups []string
+	for _, repo := range cfg.Repositories {
+		for _, group := range repo.Groups {
+			if !seen[group] {
+				seen[group] = true
+				groups = append(groups, group)
+			}
+		}
+	}
+	sort.Strings(groups)
+	return completeListElement(groups, toComplete), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// completeListElement returns the candidates for the part of toComplete after the last
+// comma, prefixed with the elements already typed (e.g. "api,we" -> "api,web")
+func completeListElement(candidates []string, toComplete string) []string {
+	typed, current := "", toComplete
+	if i := strings.LastIndex(toComplete, ","); i >= 0 {
+		typed, current = toComplete[:i+1], toComplete[i+1:]
+	}
+
+	done := make(map[string]bool)
+	for _, name := range strings.Split(typed, ",") {
+		done[name] = true
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if !done[candidate] && strings.HasPrefix(candidate, current) {
+			matches = append(matches, typed+candidate)
+		}
+	}
+	return matches
+}
+
+// completeBranchNames completes branch names from the configured default branches and
+// the local and remote-tracking branches of the cloned repositories
+func completeBranchNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	cfg := completionConfig(cmd)
+	if cfg == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	mgr := repository.NewManager(cfg)
+
+	seen := make(map[string]bool)
+	add := func(branch string) {
+		if branch != "" && strings.HasPrefix(branch, toComplete) {
+			seen[branch] = true
+		}
+	}
+	for _, repo := range cfg.Repositories {
+		add(repo.DefaultBranch)
+		if !mgr.IsGitRepository(repo) {
+			continue
+		}
+		client := git.NewClient(mgr.GetRepositoryPath(repo))
+		local, _ := client.ListBranches()
+		tracking, _ := client.ListRemoteTrackingBranches()
+		for _, branch := range append(local, tracking...) {
+			add(branch)
+		}
+	}
+
+	branches := make([]string, 0, len(seen))
+	for branch := range seen {
+		branches = append(branches, branch)
+	}
+	sort.Strings(branches)
+	return branches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeBranchOrDefault completes branch names and '@default' (each repository's default_branch)
+func completeBranchOrDefault(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	branches, directive := completeBranchNames(cmd, args, toComplete)
+	if strings.HasPrefix("@default", toComplete) {
+		branches = append([]string{"@default"}, branches...)
+	}
+	return branches, directive
+}
+
+// completeBranchArg completes the branch argument of checkout and sync
+func completeBranchArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return completeBranchOrDefault(cmd, args, toComplete)
+}
+
+func GetCompletionCmd() *cobra.Command {
+	return completionCmd
+}
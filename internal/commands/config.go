@@ -0,0 +1,474 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/provider"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Config import 플래그 변수
+var (
+	importGitHubOrg       string   // 가져올 GitHub 조직
+	importGitLabGroup     string   // 가져올 GitLab 그룹
+	importAPIURL          string   // GitHub Enterprise / 자체 호스팅 GitLab 주소
+	importTokenEnv        string   // 토큰을 읽을 환경 변수
+	importIncludeArchived bool     // 보관된 저장소 포함
+	importIncludeForks    bool     // 포크 포함
+	importTopics          []string // 이 토픽이 있는 저장소만
+	importSSH             bool     // SSH URL 사용
+	importGroups          []string // 가져온 저장소에 지정할 그룹
+	importOutput          string   // 쓸 설정 파일 (기본: --config)
+	importDryRun          bool     // 설정 파일을 쓰지 않고 출력만
+)
+
+// Config add 플래그 변수
+var (
+	addName          string   // 저장소 이름 (기본: URL에서 추출)
+	addURL           string   // 저장소 URL (필수)
+	addPath          string   // 로컬 경로 (선택적)
+	addGroups        []string // 소속 그룹
+	addDefaultBranch string   // 기본 브랜치 (@default)
+)
+
+// Config lint 플래그 변수
+var (
+	lintCheckArchived bool // 제공자 API로 보관된 저장소 확인
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Manage the configuration file",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import the repositories of a GitHub organization or GitLab group",
+	Long: `List the repositories of a GitHub organization or a GitLab group (including
+subgroups) and add them to the config file.
+
+Archived repositories and forks are left out unless requested. Repositories
+already in the config file (same name or URL) are kept as they are, so import
+can be re-run to pick up new repositories. Comments and key order of the
+config file are preserved; if it does not exist, a new one is created.
+
+The API token is read from $GITHUB_TOKEN or $GITLAB_TOKEN (see --token-env);
+it is only needed for private repositories.
+
+Examples:
+  # Import all active repositories of an organization
+  multi-git config import --github-org myorg
+
+  # Only repositories with the 'backend' topic, in the 'backend' group, cloned over SSH
+  multi-git config import --github-org myorg --topic backend --add-group backend --ssh
+
+  # Self-hosted GitLab group, preview only
+  multi-git config import --gitlab-group platform/services --api-url https://gitlab.example.com --dry-run`,
+	Args: cobra.NoArgs,
+	Run:  runConfigImport,
+}
+
+func init() {
+	configImportCmd.Flags().StringVar(&importGitHubOrg, "github-org", "",
+		"GitHub organization to import")
+	configImportCmd.Flags().StringVar(&importGitLabGroup, "gitlab-group", "",
+		"GitLab group to import, including subgroups (e.g. platform/services)")
+	configImportCmd.Flags().StringVar(&importAPIURL, "api-url", "",
+		"API address for GitHub Enterprise (https://host/api/v3) or self-hosted GitLab (https://host)")
+	configImportCmd.Flags().StringVar(&importTokenEnv, "token-env", "",
+		"Environment variable holding the API token (default: GITHUB_TOKEN or GITLAB_TOKEN)")
+	configImportCmd.Flags().BoolVar(&importIncludeArchived, "include-archived", false,
+		"Also import archived repositories")
+	configImportCmd.Flags().BoolVar(&importIncludeForks, "include-forks", false,
+		"Also import forks")
+	configImportCmd.Flags().StringSliceVar(&importTopics, "topic", nil,
+		"Only import repositories with any of these topics (repeatable)")
+	configImportCmd.Flags().BoolVar(&importSSH, "ssh", false,
+		"Use SSH clone URLs instead of HTTPS")
+	configImportCmd.Flags().StringSliceVar(&importGroups, "add-group", nil,
+		"Put the imported repositories in these groups (repeatable)")
+	configImportCmd.Flags().StringVarP(&importOutput, "output", "o", "",
+		"Config file to write (default: the --config file)")
+	configImportCmd.Flags().BoolVar(&importDryRun, "dry-run", false,
+		"Print the repositories that would be added without writing the config file")
+
+	configImportCmd.MarkFlagsMutuallyExclusive("github-org", "gitlab-group")
+	configImportCmd.MarkFlagsOneRequired("github-org", "gitlab-group")
+
+	configLintCmd.Flags().BoolVar(&lintCheckArchived, "check-archived", false,
+		"Ask the GitHub/GitLab API whether repositories on github.com and gitlab.com are archived")
+
+	configAddCmd.Flags().StringVar(&addName, "name", "",
+		"Repository name (default: the last part of the URL without .git)")
+	configAddCmd.Flags().StringVar(&addURL, "url", "",
+		"Repository URL (required)")
+	configAddCmd.Flags().StringVar(&addPath, "path", "",
+		"Local path relative to base_dir (default: the name)")
+	configAddCmd.Flags().StringSliceVar(&addGroups, "group", nil,
+		"Groups of the repository (repeatable or comma-separated)")
+	configAddCmd.Flags().StringVar(&addDefaultBranch, "default-branch", "",
+		"Branch used for '@default'")
+	_ = configAddCmd.MarkFlagRequired("url")
+
+	configCmd.AddCommand(configImportCmd)
+	configCmd.AddCommand(configLintCmd)
+	configCmd.AddCommand(configAddCmd)
+	configCmd.AddCommand(configRemoveCmd)
+	configCmd.AddCommand(configListCmd)
+}
+
+var configLintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Check the config file for suspicious entries",
+	Long: `Check the config file for entries that are valid but probably not intended.
+
+Warnings:
+  - repositories with different names pointing to the same URL
+  - paths that escape base_dir through '..' (with allow_external_paths: true)
+  - scheduled commands selecting a --group no repository belongs to
+  - archived repositories (with --check-archived)
+
+Suggestions:
+  - paths with '..' that can be written without it
+  - groups used by a single repository whose name is close to another group
+
+The config file is validated first; validation errors are reported as errors
+and exit with code 2. Exits with code 1 if there are warnings; suggestions alone
+do not fail.
+
+--check-archived asks the API of github.com and gitlab.com about every repository
+hosted there, using $GITHUB_TOKEN or $GITLAB_TOKEN if set.
+
+Examples:
+  # Lint the config file
+  multi-git config lint
+
+  # Also find repositories that were archived on GitHub or GitLab
+  multi-git config lint --check-archived`,
+	Args: cobra.NoArgs,
+	Run:  runConfigLint,
+}
+
+var configAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Add a repository to the config file",
+	Long: `Add a repository to the config file.
+
+The config file is checked before it is written: a name or URL that is already
+configured, or a path conflicting with another repository, is an error and the
+file is left unchanged. Comments and key order are preserved, and concurrent
+edits wait for each other instead of overwriting changes.
+
+Examples:
+  # Add a repository (the name defaults to the last part of the URL)
+  multi-git config add --url git@github.com:myorg/billing.git
+
+  # With a name, groups, and default branch
+  multi-git config add --name web --url https://github.com/myorg/frontend.git --group frontend --default-branch main`,
+	Args: cobra.NoArgs,
+	Run:  runConfigAdd,
+}
+
+var configRemoveCmd = &cobra.Command{
+	Use:   "remove <repo-name>",
+	Short: "Remove a repository from the config file",
+	Long: `Remove a repository from the config file.
+
+The local clone is not deleted. Comments and key order of the config file
+are preserved.
+
+Examples:
+  multi-git config remove legacy-service`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completeRepoNames,
+	Run:               runConfigRemove,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the configured repositories",
+	Long: `List the configured repositories with their groups, local path, and URL.
+The global --group and --repos flags filter the list.
+
+Examples:
+  multi-git config list
+  multi-git config list --group backend`,
+	Args: cobra.NoArgs,
+	Run:  runConfigList,
+}
+
+func runConfigImport(cmd *cobra.Command, args []string) {
+	// 1. 제공자와 토큰 결정
+	source, tokenEnv := importGitHubOrg, "GITHUB_TOKEN"
+	if importGitLabGroup != "" {
+		source, tokenEnv = importGitLabGroup, "GITLAB_TOKEN"
+	}
+	if importTokenEnv != "" {
+		tokenEnv = importTokenEnv
+	}
+	opts := provider.Options{BaseURL: importAPIURL, Token: os.Getenv(tokenEnv)}
+	// 응답 캐시는 설정 파일 옆에 (다시 가져올 때 변경되지 않은 페이지는 재사용)
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	if expanded, err := config.ExpandPath(configPath); err == nil {
+		opts.CacheDir = filepath.Join(filepath.Dir(expanded), provider.CacheDirName)
+	}
+
+	// 2. 저장소 목록 조회
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Printf("Listing repositories of %s...\n", source)
+	var listed []provider.Repository
+	var err error
+	if importGitHubOrg != "" {
+		listed, err = provider.ListGitHubOrg(ctx, importGitHubOrg, opts)
+	} else {
+		listed, err = provider.ListGitLabGroup(ctx, importGitLabGroup, opts)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if opts.Token == "" {
+			fmt.Fprintf(os.Stderr, "  hint: set $%s to an access token to list private repositories\n", tokenEnv)
+		}
+		os.Exit(1)
+	}
+
+	// 3. 필터 적용
+	filter := provider.Filter{
+		IncludeArchived: importIncludeArchived,
+		IncludeForks:    importIncludeForks,
+		Topics:          importTopics,
+	}
+	matched := filter.Apply(listed)
+	fmt.Printf("Found %d repositories, %d match the filters\n", len(listed), len(matched))
+	if len(matched) == 0 {
+		return
+	}
+
+	repos := make([]config.Repository, 0, len(matched))
+	for _, r := range matched {
+		url := r.CloneURL
+		if importSSH && r.SSHURL != "" {
+			url = r.SSHURL
+		}
+		repos = append(repos, config.Repository{
+			Name:          r.Name,
+			URL:           url,
+			Groups:        importGroups,
+			DefaultBranch: r.DefaultBranch,
+		})
+	}
+
+	// 4. --dry-run: 추가될 저장소만 출력
+	if importDryRun {
+		fmt.Println("\nWould import (dry-run, repositories already in the config are skipped when writing):")
+		for _, repo := range repos {
+			fmt.Printf("  %-30s %s\n", repo.Name, repo.URL)
+		}
+		return
+	}
+
+	// 5. 설정 파일에 추가
+	output := importOutput
+	if output == "" {
+		output, _ = cmd.Root().PersistentFlags().GetString("config")
+	}
+	added, skipped, err := config.AppendRepositories(output, repos)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, name := range added {
+		fmt.Printf("  + %s\n", name)
+	}
+	fmt.Printf("✓ Added %d repositories to %s", len(added), output)
+	if len(skipped) > 0 {
+		fmt.Printf(" (%d already configured)", len(skipped))
+	}
+	fmt.Println()
+
+	// 6. 결과 설정 검증 (경로 충돌 등)
+	if len(added) > 0 {
+		if _, err := config.LoadAndValidate(output); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: the config file is not valid yet: %v\n", err)
+			fmt.Fprintf(os.Stderr, "  hint: rename the conflicting repositories or set their 'path'\n")
+		}
+	}
+}
+
+func runConfigAdd(cmd *cobra.Command, args []string) {
+	// 1. 저장소 항목 구성
+	name := addName
+	if name == "" {
+		name = strings.TrimSuffix(path.Base(strings.TrimSuffix(addURL, "/")), ".git")
+		if i := strings.LastIndex(name, ":"); i >= 0 {
+			name = name[i+1:] // git@host:repo.git
+		}
+	}
+	repo := config.Repository{
+		Name:          name,
+		URL:           addURL,
+		Path:          addPath,
+		Groups:        addGroups,
+		DefaultBranch: addDefaultBranch,
+	}
+
+	// 2. 검증 후 설정 파일에 추가
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	if err := config.AddRepository(configPath, configProfile(cmd), repo); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if addName == "" && strings.Contains(err.Error(), "already exists") {
+			fmt.Fprintf(os.Stderr, "  hint: use '--name' to choose a different name\n")
+		}
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Added '%s' to %s\n", repo.Name, configPath)
+	fmt.Printf("  hint: run 'multi-git clone --repos %s' to clone it\n", repo.Name)
+}
+
+func runConfigRemove(cmd *cobra.Command, args []string) {
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	if err := config.RemoveRepository(configPath, configProfile(cmd), args[0]); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if strings.Contains(err.Error(), "not found") {
+			fmt.Fprintf(os.Stderr, "  hint: run 'multi-git config list' to see configured repositories\n")
+		}
+		os.Exit(1)
+	}
+
+	fmt.Printf("✓ Removed '%s' from %s (the local clone was not deleted)\n", args[0], configPath)
+}
+
+func runConfigList(cmd *cobra.Command, args []string) {
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+
+	// 열 너비 계산
+	rows := make([][3]string, 0, len(cfg.Repositories))
+	widths := [3]int{len("NAME"), len("GROUPS"), len("PATH")}
+	for _, repo := range cfg.Repositories {
+		groups := strings.Join(repo.Groups, ",")
+		if groups == "" {
+			groups = "-"
+		}
+		row := [3]string{repo.Name, groups, mgr.GetRepositoryPath(repo)}
+		for i, value := range row {
+			widths[i] = max(widths[i], len(value))
+		}
+		rows = append(rows, row)
+	}
+
+	fmt.Printf("%-*s  %-*s  %-*s  %s\n", widths[0], "NAME", widths[1], "GROUPS", widths[2], "PATH", "URL")
+	for i, repo := range cfg.Repositories {
+		fmt.Printf("%-*s  %-*s  %-*s  %s\n", widths[0], rows[i][0], widths[1], rows[i][1], widths[2], rows[i][2], repo.URL)
+	}
+}
+
+func runConfigLint(cmd *cobra.Command, args []string) {
+	// 1. 설정 파일 로드 및 검증 (그룹/저장소 필터 없이 전체)
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	cfg, err := config.LoadAndValidateProfile(configPath, configProfile(cmd))
+	if err != nil {
+		exitOnConfigError(cmd, configPath, err)
+	}
+
+	// 2. 오프라인 검사
+	issues := config.Lint(cfg)
+
+	// 3. 보관된 저장소 검사 (선택적)
+	if lintCheckArchived {
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		issues = append(issues, lintArchived(ctx, cfg)...)
+	}
+
+	// 4. 결과 출력
+	warnings, suggestions := 0, 0
+	for _, issue := range issues {
+		if issue.Severity == config.LintWarning {
+			warnings++
+			fmt.Printf("⚠ %s\n", issue)
+		} else {
+			suggestions++
+			fmt.Printf("→ %s\n", issue)
+		}
+	}
+	if len(issues) == 0 {
+		fmt.Printf("✓ %s: no issues found (%d repositories)\n", cfg.ConfigPath, len(cfg.Repositories))
+		return
+	}
+	fmt.Printf("\n%s, %s\n", plural(warnings, "warning"), plural(suggestions, "suggestion"))
+	if warnings > 0 {
+		os.Exit(1)
+	}
+}
+
+// lintArchived asks the provider API whether the repositories hosted on github.com
+// or gitlab.com are archived or no longer exist
+// Repositories on other hosts are not checked. API failures are printed as warnings.
+func lintArchived(ctx context.Context, cfg *config.Config) []config.LintIssue {
+	cacheDir := filepath.Join(cfg.ConfigDir, provider.CacheDirName)
+	githubOpts := provider.Options{Token: os.Getenv("GITHUB_TOKEN"), CacheDir: cacheDir}
+	gitlabOpts := provider.Options{Token: os.Getenv("GITLAB_TOKEN"), CacheDir: cacheDir}
+
+	var issues []config.LintIssue
+	checked := 0
+	for _, repo := range cfg.Repositories {
+		web := repo.WebURL()
+		var found *provider.Repository
+		var err error
+		switch {
+		case strings.HasPrefix(web, "https://github.com/"):
+			found, err = provider.GetGitHubRepository(ctx, strings.TrimPrefix(web, "https://github.com/"), githubOpts)
+		case strings.HasPrefix(web, "https://gitlab.com/"):
+			found, err = provider.GetGitLabProject(ctx, strings.TrimPrefix(web, "https://gitlab.com/"), gitlabOpts)
+		default:
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		checked++
+
+		field := fmt.Sprintf("repositories.%s.url", repo.Name)
+		var apiErr *provider.APIError
+		switch {
+		case errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound:
+			issues = append(issues, config.LintIssue{
+				Severity: config.LintWarning,
+				Field:    field,
+				Message:  fmt.Sprintf("%s was not found (deleted, renamed, or private without a token)", web),
+			})
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "Warning: could not check %s: %v\n", repo.Name, err)
+		case found.Archived:
+			issues = append(issues, config.LintIssue{
+				Severity: config.LintWarning,
+				Field:    field,
+				Message:  fmt.Sprintf("%s is archived (read-only); consider removing it", web),
+			})
+		}
+	}
+	fmt.Printf("Checked %d repositories for archival\n", checked)
+	return issues
+}
+
+func GetConfigCmd() *cobra.Command {
+	return configCmd
+}
@@ -0,0 +1,238 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Diff 플래그 변수
+var (
+	diffSinceTag string // 비교 시작 태그 (<tag>..HEAD)
+	diffPaths    bool   // 변경된 파일 목록 출력
+	diffParallel int    // 병렬 처리 수
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [<from>..<to> | <from>]",
+	Short: "Summarize the changes between two refs across all repositories",
+	Long: `Show, for every repository, how many commits and files changed between two
+refs and how many lines were added and deleted.
+
+The range is given as <from>..<to>, or as a single ref meaning <from>..HEAD.
+--since-tag <tag> compares a release tag with HEAD. Refs can be branches,
+tags, remote branches (origin/main), or commits. Repositories where a ref
+does not exist are skipped.
+
+Computing the diff uses the git binary.
+
+Examples:
+  # What changed everywhere since the last release
+  multi-git diff --since-tag v1.0.0
+
+  # Changes between two releases, with the changed files
+  multi-git diff v1.0.0..v1.1.0 --paths
+
+  # Local commits not pushed yet
+  multi-git diff origin/main..HEAD`,
+	Args: cobra.MaximumNArgs(1),
+	Run:  runDiff,
+}
+
+func init() {
+	diffCmd.Flags().StringVar(&diffSinceTag, "since-tag", "",
+		"Compare this tag with HEAD (same as 'diff <tag>..HEAD')")
+	diffCmd.Flags().BoolVar(&diffPaths, "paths", false,
+		"List the changed files of each repository")
+	diffCmd.Flags().IntVarP(&diffParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runDiff(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 비교 범위 결정
+	from, to, err := parseDiffRange(args, diffSinceTag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "  hint: use 'multi-git diff <from>..<to>' or 'multi-git diff --since-tag <tag>'\n")
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 병렬 수 결정
+	workers := diffParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 저장소별 변경 내역 (결과 출력 시 파일 목록과 합계에 사용)
+	var mu sync.Mutex
+	stats := make(map[string]*git.DiffStat)
+
+	// 6. Diff Task 정의
+	diffTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// 두 ref가 모두 있는 저장소만 비교
+		for _, ref := range []string{from, to} {
+			exists, err := client.RefExists(ref)
+			if err != nil {
+				result.Success = false
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			if !exists {
+				return skip(fmt.Sprintf("skipped: '%s' not found", ref)), nil
+			}
+		}
+
+		stat, err := client.GetDiffStat(from, to)
+		result.Duration = time.Since(startTime)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			return result, nil
+		}
+
+		mu.Lock()
+		stats[repo.Name] = stat
+		mu.Unlock()
+
+		result.Success = true
+		result.Message = formatDiffStat(stat)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Comparing %s..%s", from, to))
+
+	ctx := context.Background()
+	summary := executeTasks(ctx, cmd, mgr, reporter, workers, diffTask)
+
+	// 8. 결과 출력 (저장소별 변경 요약과 파일 목록)
+	total := &git.DiffStat{}
+	changedRepos := 0
+	for _, result := range summary.Results {
+		reporter.PrintResult(result)
+		stat, ok := stats[result.RepoName]
+		if !ok {
+			continue
+		}
+		if len(stat.Files) > 0 || stat.Commits > 0 {
+			changedRepos++
+		}
+		total.Commits += stat.Commits
+		total.Additions += stat.Additions
+		total.Deletions += stat.Deletions
+		total.Files = append(total.Files, stat.Files...)
+
+		if diffPaths {
+			for _, file := range stat.Files {
+				if file.Binary {
+					fmt.Printf("      %-12s %s\n", "binary", file.Path)
+				} else {
+					fmt.Printf("      %-12s %s\n", fmt.Sprintf("+%d -%d", file.Additions, file.Deletions), file.Path)
+				}
+			}
+		}
+	}
+
+	fmt.Printf("\nTotal: %s in %d of %d repositories\n", formatDiffStat(total), changedRepos, len(stats))
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// parseDiffRange returns the refs to compare from the range argument or --since-tag
+func parseDiffRange(args []string, sinceTag string) (from, to string, err error) {
+	if sinceTag != "" {
+		if len(args) > 0 {
+			return "", "", fmt.Errorf("--since-tag cannot be combined with a range argument")
+		}
+		return sinceTag, "HEAD", nil
+	}
+	if len(args) == 0 {
+		return "", "", fmt.Errorf("no range given")
+	}
+
+	arg := args[0]
+	if strings.Contains(arg, "...") {
+		return "", "", fmt.Errorf("symmetric ranges (%s) are not supported", arg)
+	}
+	from, to, found := strings.Cut(arg, "..")
+	if !found {
+		to = "HEAD"
+	}
+	if from == "" {
+		return "", "", fmt.Errorf("invalid range '%s': missing <from> ref", arg)
+	}
+	if to == "" {
+		to = "HEAD"
+	}
+	return from, to, nil
+}
+
+// formatDiffStat returns a one-line summary like "3 commits, 5 files changed, +120 -30"
+func formatDiffStat(stat *git.DiffStat) string {
+	if stat.Commits == 0 && len(stat.Files) == 0 {
+		return "no changes"
+	}
+	return fmt.Sprintf("%s, %s changed, +%d -%d",
+		plural(stat.Commits, "commit"), plural(len(stat.Files), "file"), stat.Additions, stat.Deletions)
+}
+
+// plural returns "1 file" or "n files" ("patches", "repositories" for words ending in ch or y)
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	switch {
+	case strings.HasSuffix(word, "ch"):
+		return fmt.Sprintf("%d %ses", n, word)
+	case strings.HasSuffix(word, "y"):
+		return fmt.Sprintf("%d %sies", n, strings.TrimSuffix(word, "y"))
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+func GetDiffCmd() *cobra.Command {
+	return diffCmd
+}
@@ -0,0 +1,108 @@
+package commands
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/spf13/cobra"
+)
+
+// discoverRoot returns the directory given with --discover, or "" without it
+func discoverRoot(cmd *cobra.Command) string {
+	root, _ := cmd.Root().PersistentFlags().GetString("discover")
+	return root
+}
+
+// discoverConfig builds a config from the git repositories found under the
+// --discover directory instead of a config file. Every repository is named by
+// its path relative to the directory and is in the group of its parent
+// directory. Run state (logs, checkpoints, timings) is kept per directory
+// under ~/.multi-git/discover.
+func discoverConfig(cmd *cobra.Command, root string) (*config.Config, error) {
+	flags := cmd.Root().PersistentFlags()
+	if flag := flags.Lookup("config"); flag != nil && flag.Changed {
+		return nil, fmt.Errorf("--discover and --config cannot be used together")
+	}
+	if flag := flags.Lookup("profile"); flag != nil && flag.Changed {
+		return nil, fmt.Errorf("--discover and --profile cannot be used together")
+	}
+	if flag := flags.Lookup("overlay"); flag != nil && flag.Changed {
+		return nil, fmt.Errorf("--discover and --overlay cannot be used together")
+	}
+
+	depth, _ := flags.GetInt("discover-depth")
+	if depth < 1 {
+		return nil, fmt.Errorf("--discover-depth must be at least 1")
+	}
+	ignore, _ := flags.GetStringSlice("discover-ignore")
+	for _, pattern := range ignore {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid --discover-ignore pattern '%s': %w", pattern, err)
+		}
+	}
+
+	root, err := config.ExpandPath(root)
+	if err != nil {
+		return nil, err
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path for %s: %w", root, err)
+	}
+
+	found, err := git.Discover(root, git.DiscoverOptions{
+		MaxDepth: depth,
+		Ignore:   append(append([]string{}, git.DefaultDiscoverIgnore...), ignore...),
+		Remote:   "origin",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search %s: %w", root, err)
+	}
+
+	repos := make([]config.Repository, 0, len(found))
+	for _, d := range found {
+		repo := config.Repository{Name: d.Path, URL: d.URL, Path: d.Path}
+		if d.Path == "." {
+			repo.Name = filepath.Base(root)
+		}
+		if parent := path.Dir(d.Path); parent != "." {
+			repo.Groups = []string{parent}
+		}
+		repos = append(repos, repo)
+	}
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("no git repositories found in %s (depth %d)", root, depth)
+	}
+
+	stateDir, err := discoverStateDir(root)
+	if err != nil {
+		return nil, err
+	}
+
+	// 설정 파일의 기본값과 동일 (default_remote, parallel_workers)
+	return &config.Config{
+		BaseDir:         root,
+		DefaultRemote:   "origin",
+		ParallelWorkers: 3,
+		Repositories:    repos,
+		ConfigDir:       stateDir,
+	}, nil
+}
+
+// discoverStateDir returns the directory for the run state of a discovered
+// directory, e.g. ~/.multi-git/discover/work-1a2b3c4d
+func discoverStateDir(root string) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	sum := sha256.Sum256([]byte(root))
+	name := filepath.Base(root) + "-" + hex.EncodeToString(sum[:4])
+	return filepath.Join(homeDir, ".multi-git", "discover", name), nil
+}
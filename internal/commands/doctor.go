@@ -0,0 +1,228 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Doctor 플래그 변수
+var (
+	doctorFix      bool // 발견한 문제 수정
+	doctorParallel int  // 병렬 처리 수
+	doctorFailFast bool // 실패 시 중단
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check that every clone matches the config",
+	Long: `Check every clone against the config and report where it has drifted:
+
+  - the URL of the remote the repository is cloned from (default_remote)
+  - the additional remotes of 'remotes' (missing, or with another URL)
+  - the git settings of 'git_config' (not set, or with another value)
+  - the revision of 'pin' (HEAD on a branch or at another commit)
+
+With --fix, the drift is repaired: missing remotes are added, remote URLs
+and git settings are set to the configured values, and pinned repositories
+are checked out at their pin. Repositories that are
+not cloned fail; run 'multi-git clone' first.
+
+Exits with code 1 if any repository has drifted (without --fix) or could
+not be repaired.
+
+Examples:
+  # Report drift
+  multi-git doctor
+
+  # Re-apply the config to every clone, e.g. after adding git_config keys
+  multi-git doctor --fix`,
+	Args: cobra.NoArgs,
+	Run:  runDoctor,
+}
+
+func init() {
+	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false,
+		"Repair the drift instead of only reporting it")
+	doctorCmd.Flags().IntVarP(&doctorParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	doctorCmd.Flags().BoolVar(&doctorFailFast, "fail-fast", false,
+		"Stop on first failure")
+}
+
+// doctorIssue is one way a clone differs from the config, with its repair
+type doctorIssue struct {
+	description string       // 문제 설명 (예: remote 'upstream' missing)
+	fix         func() error // 수정 방법
+}
+
+func runDoctor(cmd *cobra.Command, args []string) {
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+	cfg := loadConfig(cmd)
+
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	workers := doctorParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	if doctorFix {
+		reporter.PrintHeader("Checking and repairing repositories")
+	} else {
+		reporter.PrintHeader("Checking repositories")
+	}
+
+	doctorTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		issues, err := diagnoseRepository(cfg, repo, newGitClient(cfg, repo))
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		descriptions := make([]string, len(issues))
+		for i, issue := range issues {
+			descriptions[i] = issue.description
+		}
+
+		switch {
+		case len(issues) == 0:
+			result.Success = true
+			result.Message = "ok"
+		case !doctorFix:
+			result.Success = false
+			result.Error = fmt.Errorf("%d issue(s): %s\n  hint: run 'multi-git doctor --fix' to repair", len(issues), strings.Join(descriptions, "; "))
+		default:
+			for i, issue := range issues {
+				if err := issue.fix(); err != nil {
+					result.Success = false
+					result.Error = fmt.Errorf("failed to fix %s: %w", issue.description, err)
+					if i > 0 {
+						result.Error = fmt.Errorf("%w (fixed: %s)", result.Error, strings.Join(descriptions[:i], "; "))
+					}
+					result.Duration = time.Since(startTime)
+					return result, nil
+				}
+			}
+			result.Success = true
+			result.Message = "fixed: " + strings.Join(descriptions, "; ")
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, doctorTask)
+	reporter.PrintFullReport(summary)
+
+	exitOnFailures(cmd, summary)
+}
+
+// diagnoseRepository compares a clone with the config: the remote it is cloned
+// from, the additional remotes, the git settings, and the pinned revision
+func diagnoseRepository(cfg *config.Config, repo config.Repository, client *git.Client) ([]doctorIssue, error) {
+	wantRemotes, err := cfg.RemotesFor(repo)
+	if err != nil {
+		return nil, err
+	}
+	if repo.URL != "" {
+		wantRemotes[cfg.DefaultRemote] = repo.URL
+	}
+
+	remotes, err := client.ListRemotes()
+	if err != nil {
+		return nil, err
+	}
+	currentURLs := make(map[string][]string, len(remotes))
+	for _, remote := range remotes {
+		currentURLs[remote.Name] = remote.URLs
+	}
+
+	var issues []doctorIssue
+	for _, name := range sortedKeys(wantRemotes) {
+		url := wantRemotes[name]
+		urls, ok := currentURLs[name]
+		switch {
+		case !ok:
+			issues = append(issues, doctorIssue{
+				description: fmt.Sprintf("remote '%s' missing", name),
+				fix:         func() error { return client.AddRemote(name, url) },
+			})
+		case len(urls) != 1 || urls[0] != url:
+			issues = append(issues, doctorIssue{
+				description: fmt.Sprintf("remote '%s' is %s (config: %s)", name, strings.Join(urls, ", "), url),
+				fix:         func() error { return client.SetRemoteURL(name, url) },
+			})
+		}
+	}
+
+	drift, err := client.ConfigDrift(cfg.GitConfigFor(repo))
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range drift {
+		description := fmt.Sprintf("git config %s is '%s' (config: '%s')", d.Key, d.Current, d.Want)
+		if d.Missing {
+			description = fmt.Sprintf("git config %s not set (config: '%s')", d.Key, d.Want)
+		}
+		issues = append(issues, doctorIssue{
+			description: description,
+			fix: func() error {
+				_, err := client.SetConfigValues(map[string]string{d.Key: d.Want})
+				return err
+			},
+		})
+	}
+
+	// 고정 리비전 (pin)
+	if repo.Pin != "" {
+		fixPin := func() error {
+			_, err := checkoutPin(client, repo, cfg.DefaultRemote)
+			return err
+		}
+		pinned, err := resolvePin(client, repo, cfg.DefaultRemote, false)
+		switch {
+		case errors.Is(err, git.ErrRevisionNotFound):
+			issues = append(issues, doctorIssue{
+				description: fmt.Sprintf("pin '%s' not in the clone", repo.Pin),
+				fix:         fixPin,
+			})
+		case err != nil:
+			return nil, err
+		default:
+			drift, err := pinDrift(client, repo, pinned)
+			if err != nil {
+				return nil, err
+			}
+			if drift != "" {
+				issues = append(issues, doctorIssue{description: "HEAD " + drift, fix: fixPin})
+			}
+		}
+	}
+	return issues, nil
+}
+
+func GetDoctorCmd() *cobra.Command {
+	return doctorCmd
+}
@@ -1,14 +1,19 @@
 package commands
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strings"
-	"sync/atomic"
+	"text/template"
 	"time"
 
 	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/guard"
 	"github.com/alexgim961101/multi-git/internal/repository"
 	"github.com/alexgim961101/multi-git/internal/shell"
 	"github.com/spf13/cobra"
@@ -16,11 +21,21 @@ import (
 
 // Exec 플래그 변수
 var (
-	execParallel   int    // 병렬 처리 수
-	execFailFast   bool   // 실패 시 중단
-	execShell      string // 사용할 셸
-	execDryRun     bool   // 시뮬레이션 모드
-	execShowOutput bool   // 출력 표시
+	execParallel       int    // 병렬 처리 수
+	execFailFast       bool   // 실패 시 중단
+	execShell          string // 사용할 셸
+	execDryRun         bool   // 시뮬레이션 모드
+	execShowOutput     bool   // 출력 표시
+	execAllowProtected bool   // 보호 경로 수정 허용
+	execExpectExit     int    // 기대 종료 코드 (--expect-exit 지정 시)
+	execExpectOutput   string // 출력이 일치해야 하는 정규식
+	execStream         bool   // 출력을 저장소 접두사와 함께 실시간 출력
+	execNoPager        bool   // 긴 출력도 페이저 없이 출력
+	execMaxOutput      string // 저장소별 유지할 최대 출력 크기 (설정 덮어씀, 0 = 제한 없음)
+	execOutputKeep     string // 출력이 넘칠 때 유지할 부분 (설정 덮어씀)
+	execNice           int    // 명령어 CPU 우선순위 낮추기 (설정 덮어씀)
+	execMaxMemory      string // 명령어 최대 메모리 (설정 덮어씀, 0 = 제한 없음)
+	execNoTemplate     bool   // {{ }}를 템플릿으로 해석하지 않음
 )
 
 var execCmd = &cobra.Command{
@@ -34,6 +49,17 @@ This command runs the same command in each repository's directory, making it use
 - Creating common files (e.g., .gitkeep, .env.example)
 - Running build or test commands
 
+When the output does not fit the terminal, the report is shown through $PAGER
+(default: less) with the summary at the top. Use --no-pager to print it directly.
+
+With --stream in a terminal, type a repository name and press Enter to cancel
+its command while the other repositories continue.
+
+At most max_output (default: 10MB) of each repository's stdout and stderr is
+kept; the rest is dropped and marked "... [N truncated] ...". output_keep
+chooses the part kept: the beginning (head), the end (tail), or half of each
+(both, the default).
+
 Examples:
   # Run npm install in all repositories
   multi-git exec "npm install"
@@ -44,9 +70,18 @@ Examples:
   # Create a file in all repositories
   multi-git exec "touch .gitkeep"
 
+  # Build an image per repository ({{.Name}}, {{.Path}}, {{.Branch}}, {{.URL}})
+  multi-git exec "docker build -t registry.example.com/{{.Name}}:latest ."
+
+  # Pass '{{' through to the command unchanged
+  multi-git exec "docker ps --format '{{.Names}}'" --no-template
+
   # Run with bash instead of sh
   multi-git exec "echo \$PWD" --shell /bin/bash
 
+  # On Windows, run with PowerShell instead of cmd.exe
+  multi-git exec "Get-ChildItem -Recurse -Filter *.csproj" --shell powershell
+
   # Run sequentially (no parallel)
   multi-git exec "npm test" --parallel 0
 
@@ -57,7 +92,25 @@ Examples:
   multi-git exec "rm -rf node_modules" --dry-run
 
   # Hide command output
-  multi-git exec "npm install" --show-output=false`,
+  multi-git exec "npm install" --show-output=false
+
+  # Print a long report directly instead of through $PAGER
+  multi-git exec "npm install" --no-pager
+
+  # Keep only the last 64KB of each repository's build log
+  multi-git exec "make build" --max-output 64KB --output-keep tail
+
+  # Follow the output of a long build as it happens, prefixed with [repo-name]
+  multi-git exec "make build" --stream
+
+  # Allow the command to modify protected paths (config: protected_paths)
+  multi-git exec "./scripts/bump-manifests.sh" --allow-protected
+
+  # Audit: fail every repository whose CI image does not pin Node 20
+  multi-git exec "grep -h '^FROM' Dockerfile" --expect-output-regex 'node:20'
+
+  # Audit: the lint config must be absent everywhere (grep exits 1 on no match)
+  multi-git exec "grep -q eslint-disable .eslintrc.json" --expect-exit 1`,
 	Args: cobra.ExactArgs(1),
 	Run:  runExec,
 }
@@ -67,12 +120,32 @@ func init() {
 		"Number of parallel operations (0 = use config value)")
 	execCmd.Flags().BoolVar(&execFailFast, "fail-fast", false,
 		"Stop on first failure")
-	execCmd.Flags().StringVarP(&execShell, "shell", "s", "/bin/sh",
-		"Shell to use for executing commands")
+	execCmd.Flags().StringVarP(&execShell, "shell", "s", shell.DefaultShell(),
+		"Shell to use for executing commands (cmd, powershell, and pwsh are also supported)")
 	execCmd.Flags().BoolVar(&execDryRun, "dry-run", false,
 		"Simulate without actually executing")
 	execCmd.Flags().BoolVarP(&execShowOutput, "show-output", "o", true,
 		"Show command output")
+	execCmd.Flags().BoolVar(&execStream, "stream", false,
+		"Print output as it is produced, each line prefixed with [repo-name]")
+	execCmd.Flags().BoolVar(&execNoPager, "no-pager", false,
+		"Do not show long output through $PAGER")
+	execCmd.Flags().StringVar(&execMaxOutput, "max-output", "",
+		"Maximum output kept per repository and stream, e.g. 64KB, 10MB (default: config max_output, 0 = unlimited)")
+	execCmd.Flags().StringVar(&execOutputKeep, "output-keep", "",
+		"Part of longer output to keep: head, tail, or both (default: config output_keep)")
+	execCmd.Flags().IntVar(&execNice, "nice", 0,
+		"Lower the CPU priority of the command, 0-19 (default: config nice)")
+	execCmd.Flags().StringVar(&execMaxMemory, "max-memory", "",
+		"Maximum memory of the command and its children, e.g. 2GB (default: config max_memory, 0 = unlimited)")
+	execCmd.Flags().BoolVar(&execNoTemplate, "no-template", false,
+		"Run the command as written, without replacing {{.Name}} and other placeholders")
+	execCmd.Flags().BoolVar(&execAllowProtected, "allow-protected", false,
+		"Allow the command to modify protected paths")
+	execCmd.Flags().IntVar(&execExpectExit, "expect-exit", 0,
+		"Fail repositories where the command exits with a different code")
+	execCmd.Flags().StringVar(&execExpectOutput, "expect-output-regex", "",
+		"Fail repositories where the command output does not match this regular expression")
 }
 
 func runExec(cmd *cobra.Command, args []string) {
@@ -80,20 +153,77 @@ func runExec(cmd *cobra.Command, args []string) {
 	command := args[0]
 
 	// 2. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
-	// 3. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+	// 셸 확인 (저장소마다 실패하지 않도록 미리)
+	if err := shell.Validate(execShell); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid --shell: %v\n", err)
 		os.Exit(1)
 	}
 
+	// 결과 검증 조건 (지정된 경우에만 적용)
+	expectExit := cmd.Flags().Changed("expect-exit")
+	var expectOutput *regexp.Regexp
+	if execExpectOutput != "" {
+		re, err := regexp.Compile(execExpectOutput)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --expect-output-regex: %v\n", err)
+			os.Exit(1)
+		}
+		expectOutput = re
+	}
+
+	// 명령어 템플릿 ({{.Name}} 등, 저장소마다 렌더링)
+	var commandTemplate *template.Template
+	if !execNoTemplate && config.IsPathTemplate(command) {
+		tmpl, err := template.New("command").Option("missingkey=error").Parse(command)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid command template: %v\n", err)
+			fmt.Fprintf(os.Stderr, "  hint: use '--no-template' to pass '{{' to the command unchanged\n")
+			os.Exit(1)
+		}
+		commandTemplate = tmpl
+	}
+
+	// 출력 제한 (지정된 경우 설정 대신 사용)
+	var maxOutput config.ByteSize
+	if execMaxOutput != "" {
+		size, err := config.ParseByteSize(execMaxOutput)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --max-output: %v\n", err)
+			os.Exit(1)
+		}
+		maxOutput = size
+	}
+	if execOutputKeep != "" && !slices.Contains(config.OutputKeepPolicies, execOutputKeep) {
+		fmt.Fprintf(os.Stderr, "Error: invalid --output-keep '%s' (expected %s)\n", execOutputKeep, strings.Join(config.OutputKeepPolicies, ", "))
+		os.Exit(1)
+	}
+
+	// 자원 제한 (지정된 경우 설정 대신 사용)
+	niceSet := cmd.Flags().Changed("nice")
+	if niceSet && (execNice < 0 || execNice > config.MaxNice) {
+		fmt.Fprintf(os.Stderr, "Error: invalid --nice %d (expected 0-%d)\n", execNice, config.MaxNice)
+		os.Exit(1)
+	}
+	var maxMemory config.ByteSize
+	if execMaxMemory != "" {
+		size, err := config.ParseByteSize(execMaxMemory)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --max-memory: %v\n", err)
+			os.Exit(1)
+		}
+		maxMemory = size
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
 	// 4. Manager와 Reporter 생성
 	mgr := repository.NewManager(cfg)
 	reporter := repository.NewReporter()
 	reporter.SetVerbose(verbose)
+	reporter.SetStream(execStream)
 
 	// 5. 병렬 수 결정
 	workers := execParallel
@@ -108,92 +238,208 @@ func runExec(cmd *cobra.Command, args []string) {
 	}
 	reporter.PrintHeader(headerMsg)
 
-	// 7. fail-fast를 위한 취소 함수
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
-	var hasFailed atomic.Bool
-
-	// 8. Exec Task 정의
-	execTask := func(repo config.Repository) repository.Result {
+	// 7. Exec Task 정의 (--fail-fast는 executeTasks에서 처리)
+	execTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{RepoName: repo.Name}
 		startTime := time.Now()
 		repoPath := mgr.GetRepositoryPath(repo)
 
-		// fail-fast 체크
-		if execFailFast && hasFailed.Load() {
-			result.Success = false
-			result.Error = fmt.Errorf("skipped due to previous failure")
-			result.Duration = time.Since(startTime)
-			return result
-		}
-
 		// Step 1: 저장소 존재 확인
 		if !mgr.RepositoryExists(repo) {
 			result.Success = false
 			result.Error = fmt.Errorf("repository not found: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			if execFailFast {
-				hasFailed.Store(true)
-				cancel()
+			return result, nil
+		}
+
+		// Step 2: 명령어 템플릿 렌더링 및 MG_* 환경 변수
+		branch := ""
+		if mgr.IsGitRepository(repo) {
+			branch, _ = newGitClient(cfg, repo).GetCurrentBranch()
+		}
+		repoCommand := command
+		if commandTemplate != nil {
+			rendered, err := renderExecCommand(commandTemplate, mgr, repo, branch)
+			if err != nil {
+				result.Success = false
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
 			}
-			return result
+			repoCommand = rendered
 		}
+		env := repoEnv(mgr, repo, branch)
 
-		// Step 2: dry-run 처리
+		// Step 3: dry-run 처리
 		if execDryRun {
 			result.Success = true
-			result.Message = fmt.Sprintf("would execute: %s", command)
+			result.Message = fmt.Sprintf("would execute: %s", repoCommand)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
+		}
+
+		// Step 4: 보호 경로 스냅샷
+		protected := cfg.ProtectedPathsFor(repo)
+		guardEnabled := !execAllowProtected && len(protected) > 0
+		var before guard.Snapshot
+		if guardEnabled {
+			snapshot, err := guard.Take(repoPath, protected)
+			if err != nil {
+				result.Success = false
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			before = snapshot
 		}
 
-		// Step 3: 명령어 실행
-		output, err := shell.Execute(repoPath, execShell, command)
+		// Step 5: 명령어 실행
+		limit, keep := cfg.OutputLimitFor(repo)
+		if execMaxOutput != "" {
+			limit = maxOutput
+		}
+		if execOutputKeep != "" {
+			keep = execOutputKeep
+		}
+		nice, memory := cfg.ResourceLimitsFor(repo)
+		if niceSet {
+			nice = execNice
+		}
+		if execMaxMemory != "" {
+			memory = maxMemory
+		}
+		output, err := shell.ExecuteConstrained(mgr.TaskContext(repo.Name), repoPath, execShell, repoCommand, env, shell.DefaultTimeout,
+			streamWriter(reporter, repo), shell.OutputLimit{Max: int64(limit), Keep: keep},
+			shell.ResourceLimits{Nice: nice, MaxMemory: int64(memory)})
 		result.Duration = time.Since(startTime)
 
+		// Step 6: 결과 검증
+		if expectExit || expectOutput != nil {
+			err = checkExecAssertions(output, err, expectExit, expectOutput)
+		}
+
+		// Step 7: 보호 경로 변경 검사
+		if guardEnabled {
+			after, snapErr := guard.Take(repoPath, protected)
+			if snapErr != nil {
+				err = snapErr
+			} else if changed := guard.Diff(before, after); len(changed) > 0 {
+				err = fmt.Errorf("command modified protected paths: %s\n  hint: review the changes, or use '--allow-protected' if this was intended", strings.Join(changed, ", "))
+			}
+		}
+
 		if err != nil {
 			result.Success = false
 			result.Error = enhanceExecError(err)
-			if execShowOutput && output != "" {
+			if execShowOutput && output != "" && !execStream {
 				result.Message = strings.TrimSpace(output)
+			} else if errors.Is(err, errAssertionFailed) {
+				// 출력을 숨겨도 검증 실패 원인은 보이도록 에러에 포함
+				result.Error = fmt.Errorf("%w\n  output: %s", err, strings.TrimSpace(output))
 			}
-			if execFailFast {
-				hasFailed.Store(true)
-				cancel()
-			}
-			return result
+			return result, nil
 		}
 
 		result.Success = true
-		if execShowOutput && output != "" {
+		if execShowOutput && output != "" && !execStream {
 			result.Message = strings.TrimSpace(output)
 		} else {
 			result.Message = "executed successfully"
 		}
-		return result
+		return result, nil
 	}
 
-	// 9. 실행
-	var summary *repository.Summary
+	// 8. 실행
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, execTask)
 
-	if workers > 1 {
-		summary = mgr.ExecuteParallel(ctx, execTask, nil)
+	// 9. 결과 출력 (--stream이면 출력은 이미 표시됨)
+	if execShowOutput && !execStream {
+		if execNoPager || !pageExecReport(reporter, summary) {
+			reporter.PrintFullReportWithOutput(summary)
+		}
 	} else {
-		summary = mgr.ExecuteSequential(ctx, execTask, nil)
+		reporter.PrintFullReport(summary)
 	}
 
-	// 10. 결과 출력
-	if execShowOutput {
-		reporter.PrintFullReportWithOutput(summary)
-	} else {
-		reporter.PrintFullReport(summary)
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// execTemplateData is the repository metadata available to the exec command template
+// e.g. "docker build -t registry/{{.Name}}:{{.Branch}} ."
+type execTemplateData struct {
+	config.PathTemplateData        // Name, Group, Groups, Host, Owner
+	Path                    string // 저장소 경로 (절대 경로)
+	Branch                  string // 현재 브랜치 (detached HEAD면 빈 문자열)
+	URL                     string // 저장소 URL
+	DefaultBranch           string // 설정의 default_branch
+}
+
+// renderExecCommand renders the command template for the repository
+func renderExecCommand(tmpl *template.Template, mgr *repository.Manager, repo config.Repository, branch string) (string, error) {
+	data := execTemplateData{
+		PathTemplateData: config.TemplateData(repo),
+		Path:             mgr.GetRepositoryPath(repo),
+		Branch:           branch,
+		URL:              repo.URL,
+		DefaultBranch:    repo.DefaultBranch,
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render command: %w\n  hint: use '--no-template' to pass '{{' to the command unchanged", err)
 	}
+	return buf.String(), nil
+}
 
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
+// pageExecReport shows the report through the pager, summary first, if stdout is
+// a terminal the report does not fit on. Returns false if it was not paged.
+func pageExecReport(reporter *repository.Reporter, summary *repository.Summary) bool {
+	height := shell.TerminalHeight()
+	pager := shell.ResolvePager()
+	if height == 0 || pager == "" {
+		return false
+	}
+
+	// 요약을 먼저, 저장소별 출력은 그 아래에
+	var report bytes.Buffer
+	reporter.SetOutput(&report)
+	reporter.PrintSummary(summary)
+	reporter.PrintResultsWithOutput(summary.Results)
+	reporter.SetOutput(os.Stdout)
+	if strings.Count(report.String(), "\n") < height {
+		return false
+	}
+
+	if err := shell.Page(pager, report.String()); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return false
+	}
+	return true
+}
+
+// errAssertionFailed marks results that ran but did not meet --expect-exit or --expect-output-regex
+var errAssertionFailed = errors.New("assertion failed")
+
+// checkExecAssertions checks the exit code and output of a command against the expectations
+// Errors other than a non-zero exit (command not started, timeout) are returned unchanged
+func checkExecAssertions(output string, runErr error, expectExit bool, expectOutput *regexp.Regexp) error {
+	code := shell.ExitCode(runErr)
+	if code < 0 {
+		return runErr
+	}
+
+	if expectExit {
+		if code != execExpectExit {
+			return fmt.Errorf("%w: exit code %d, expected %d", errAssertionFailed, code, execExpectExit)
+		}
+	} else if runErr != nil {
+		return runErr
+	}
+
+	if expectOutput != nil && !expectOutput.MatchString(output) {
+		return fmt.Errorf("%w: output does not match /%s/", errAssertionFailed, expectOutput.String())
 	}
+	return nil
 }
 
 // enhanceExecError enhances error messages with helpful hints
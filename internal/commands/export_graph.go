@@ -0,0 +1,196 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Export-graph 플래그 변수
+var (
+	graphSince      string // 이 revision 이후 커밋만 포함
+	graphOutput     string // 출력 파일 경로 ("-"는 stdout)
+	graphMaxCommits int    // 저장소별 최대 커밋 수
+	graphParallel   int    // 병렬 처리 수
+)
+
+// graphExport is the document written by export-graph
+type graphExport struct {
+	GeneratedAt  time.Time         `json:"generated_at"`
+	Since        string            `json:"since,omitempty"`
+	Repositories []graphRepository `json:"repositories"`
+}
+
+// graphRepository is the per-repository entry of a graph export
+type graphRepository struct {
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+	Error string `json:"error,omitempty"`
+	*git.CommitGraph
+}
+
+var exportGraphCmd = &cobra.Command{
+	Use:   "export-graph",
+	Short: "Export commits, tags, and branch tips of all repositories as JSON",
+	Long: `Export a machine-readable commit graph for every managed repository:
+branch tips, tags, and commits reachable from local branches. With --since,
+commits already contained in that tag, branch, or commit are left out.
+
+Repositories that fail (e.g. the --since tag does not exist) are still listed
+with an "error" field, so consumers always see the full fleet.
+
+Examples:
+  # Export everything since the v1.0.0 tag
+  multi-git export-graph --since v1.0.0 -o graph.json
+
+  # Print the latest 50 commits per repository to stdout
+  multi-git export-graph --max-commits 50`,
+	Args: cobra.NoArgs,
+	Run:  runExportGraph,
+}
+
+func init() {
+	exportGraphCmd.Flags().StringVar(&graphSince, "since", "",
+		"Only include commits not reachable from this tag, branch, or commit")
+	exportGraphCmd.Flags().StringVarP(&graphOutput, "output", "o", "-",
+		"Output file path ('-' for stdout)")
+	exportGraphCmd.Flags().IntVar(&graphMaxCommits, "max-commits", 1000,
+		"Maximum number of commits per repository (0 = unlimited)")
+	exportGraphCmd.Flags().IntVarP(&graphParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runExportGraph(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성 (stdout 출력 시 리포트는 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if graphOutput == "-" {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 4. 병렬 수 결정
+	workers := graphParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 5. 그래프 수집 Task 정의
+	var mu sync.Mutex
+	graphs := make(map[string]*git.CommitGraph)
+	opts := &git.GraphOptions{Since: graphSince, MaxCommits: graphMaxCommits}
+
+	graphTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		graph, err := git.NewClient(repoPath).GetCommitGraph(opts)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		mu.Lock()
+		graphs[repo.Name] = graph
+		mu.Unlock()
+
+		result.Success = true
+		result.Message = fmt.Sprintf("%d commits, %d tags, %d branches", len(graph.Commits), len(graph.Tags), len(graph.Branches))
+		if graph.Truncated {
+			result.Message += " (truncated)"
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	if graphSince != "" {
+		reporter.PrintHeader(fmt.Sprintf("Exporting commit graph since '%s'", graphSince))
+	} else {
+		reporter.PrintHeader("Exporting commit graph")
+	}
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, graphTask)
+
+	// 7. 설정 순서대로 문서 구성
+	errs := make(map[string]error)
+	for _, result := range summary.Results {
+		if result.Error != nil {
+			errs[result.RepoName] = result.Error
+		}
+	}
+
+	export := graphExport{
+		GeneratedAt:  time.Now().UTC(),
+		Since:        graphSince,
+		Repositories: make([]graphRepository, 0, len(cfg.Repositories)),
+	}
+	for _, repo := range cfg.Repositories {
+		entry := graphRepository{Name: repo.Name, URL: repo.URL, CommitGraph: graphs[repo.Name]}
+		if err, ok := errs[repo.Name]; ok {
+			entry.Error = err.Error()
+		}
+		export.Repositories = append(export.Repositories, entry)
+	}
+
+	// 8. JSON 출력
+	if err := writeGraphExport(&export, graphOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 9. 결과 출력
+	reporter.PrintFullReport(summary)
+	if graphOutput != "-" {
+		reporter.PrintSuccess(fmt.Sprintf("Graph written to %s", graphOutput))
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// writeGraphExport encodes the export as indented JSON to a file or stdout
+func writeGraphExport(export *graphExport, output string) error {
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode graph: %w", err)
+	}
+	data = append(data, '\n')
+
+	if output == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("failed to write graph: %w", err)
+	}
+	return nil
+}
+
+func GetExportGraphCmd() *cobra.Command {
+	return exportGraphCmd
+}
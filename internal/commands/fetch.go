@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Fetch 플래그 변수
+var (
+	fetchRemote     string // 원격 이름
+	fetchAllRemotes bool   // 모든 원격에서 fetch
+	fetchPrune      bool   // 삭제된 원격 브랜치 참조 제거
+	fetchTags       bool   // 모든 태그 fetch
+	fetchParallel   int    // 병렬 처리 수
+	fetchUpdateURLs bool   // 이동한 저장소의 URL을 설정에 반영
+)
+
+var fetchCmd = &cobra.Command{
+	Use:   "fetch",
+	Short: "Fetch remote changes across all repositories without merging",
+	Long: `Fetch remote references for all managed repositories without touching
+local branches or the working tree. Useful before a status or checkout sweep.
+
+Examples:
+  # Fetch from the default remote
+  multi-git fetch
+
+  # Fetch from every configured remote
+  multi-git fetch --all-remotes
+
+  # Remove references to deleted remote branches and fetch all tags
+  multi-git fetch --prune --tags
+
+  # Rewrite the config for repositories that were renamed or transferred
+  multi-git fetch --update-config
+
+Repositories whose HTTPS URL is redirected by the hosting service (renamed
+or transferred) are reported with their new URL.`,
+	Run: runFetch,
+}
+
+func init() {
+	fetchCmd.Flags().StringVarP(&fetchRemote, "remote", "r", "",
+		"Remote name to fetch from (default: config default_remote)")
+	fetchCmd.Flags().BoolVar(&fetchAllRemotes, "all-remotes", false,
+		"Fetch from all configured remotes")
+	fetchCmd.Flags().BoolVar(&fetchPrune, "prune", false,
+		"Remove references to branches deleted on the remote")
+	fetchCmd.Flags().BoolVar(&fetchTags, "tags", false,
+		"Fetch all tags from the remote")
+	fetchCmd.Flags().IntVarP(&fetchParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	fetchCmd.Flags().BoolVar(&fetchUpdateURLs, "update-config", false,
+		"Rewrite the config and remote URLs of repositories that have moved")
+}
+
+func runFetch(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 및 원격 결정
+	workers := fetchParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	remoteName := fetchRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 5. Fetch Task 정의
+	var moved movedRepositories
+	fetchTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		// 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// 대상 원격 결정
+		remotes := []string{remoteName}
+		if fetchAllRemotes {
+			names, err := client.ListRemoteNames()
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("failed to list remotes: %w", err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			remotes = names
+		}
+
+		// Fetch 실행
+		for _, remote := range remotes {
+			fetchOpts := &git.FetchOptions{
+				Remote: remote,
+				Prune:  fetchPrune,
+				Tags:   fetchTags,
+			}
+			if err := client.FetchWithOptions(fetchOpts); err != nil {
+				result.Success = false
+				result.Error = enhanceFetchError(err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+		}
+
+		result.Success = true
+		if fetchAllRemotes {
+			result.Message = fmt.Sprintf("fetched %s", strings.Join(remotes, ", "))
+		}
+		if newURL := moved.check(cfg, repo); newURL != "" {
+			result.Message = strings.TrimPrefix(result.Message+", moved to "+newURL, ", ")
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	if fetchAllRemotes {
+		reporter.PrintHeader("Fetching all remotes")
+	} else {
+		reporter.PrintHeader(fmt.Sprintf("Fetching from %s", remoteName))
+	}
+
+	ctx := context.Background()
+	var summary *repository.Summary
+
+	summary = executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "fetch", "", fetchTask))
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 8. 이동한 저장소 보고 (--update-config 시 설정 갱신)
+	if err := moved.report(reporter, mgr, fetchUpdateURLs); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// enhanceFetchError enhances error messages with helpful hints
+func enhanceFetchError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errMsg := err.Error()
+
+	// 원격 없음
+	if strings.Contains(errMsg, "remote") && strings.Contains(errMsg, "not found") {
+		return fmt.Errorf("%w\n  hint: check remote name with 'git remote -v'", err)
+	}
+
+	// 인증 오류
+	if strings.Contains(errMsg, "authentication") || strings.Contains(errMsg, "auth") {
+		return fmt.Errorf("%w\n  hint: check your credentials", err)
+	}
+
+	// 네트워크 오류
+	if strings.Contains(errMsg, "network") || strings.Contains(errMsg, "connection") {
+		return fmt.Errorf("%w\n  hint: check your network connection", err)
+	}
+
+	return err
+}
+
+func GetFetchCmd() *cobra.Command {
+	return fetchCmd
+}
@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// File-log 플래그 변수
+var (
+	fileLogSince    string // 이 시각 이후 커밋만
+	fileLogAuthor   string // 작성자 이름 또는 이메일 필터
+	fileLogMax      int    // 최대 커밋 수 (전체 저장소 합계)
+	fileLogJSON     bool   // JSON 출력
+	fileLogParallel int    // 병렬 처리 수
+)
+
+// fileLogEntry is a commit of the file-log output together with its repository
+type fileLogEntry struct {
+	Repository string `json:"repository"`
+	git.LogEntry
+}
+
+var fileLogCmd = &cobra.Command{
+	Use:   "file-log <path>...",
+	Short: "Show the commits that changed matching files in any repository",
+	Long: `List the commits that changed files matching the given paths in every
+repository, merged into one list sorted by date, newest first. Use it to audit
+when a file shared across the fleet (CI config, license, lint rules) changed
+anywhere.
+
+Paths are relative to the repository root and may use glob patterns: '*' within
+a directory, '**' for any number of directories. A directory matches every file
+below it. Deleted files are included; merge commits are not listed, the commits
+they merge are.
+
+Examples:
+  # When did the CI workflows change, anywhere?
+  multi-git file-log .github/workflows
+
+  # Every change to a Makefile at any depth in the last month
+  multi-git file-log "**/Makefile" --since 1mo
+
+  # Changes to two shared files as JSON
+  multi-git file-log .golangci.yml renovate.json --max 0 --json`,
+	Args: cobra.MinimumNArgs(1),
+	Run:  runFileLog,
+}
+
+func init() {
+	fileLogCmd.Flags().StringVar(&fileLogSince, "since", "",
+		"Only show commits more recent than this (e.g. '2 weeks', 'yesterday', '2024-01-31')")
+	fileLogCmd.Flags().StringVar(&fileLogAuthor, "author", "",
+		"Only show commits whose author name or email contains this (case-insensitive)")
+	fileLogCmd.Flags().IntVarP(&fileLogMax, "max", "n", 20,
+		"Maximum number of commits across all repositories (0 = unlimited)")
+	fileLogCmd.Flags().BoolVar(&fileLogJSON, "json", false,
+		"Print the commits as JSON")
+	fileLogCmd.Flags().IntVarP(&fileLogParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runFileLog(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 경로 패턴 및 조회 옵션 확인
+	for _, pattern := range args {
+		if err := guard.ValidatePattern(pattern); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	// 저장소마다 최신 max개면 전체의 최신 max개를 모두 포함
+	opts := &git.LogOptions{Author: fileLogAuthor, Max: fileLogMax, Paths: args}
+	if fileLogSince != "" {
+		since, err := git.ParseSince(fileLogSince, time.Now())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		opts.Since = since
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성 (JSON 출력 시 리포트는 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if fileLogJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 5. 병렬 수 결정
+	workers := fileLogParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 전체 저장소의 커밋 목록
+	var mu sync.Mutex
+	var entries []fileLogEntry
+
+	// 6. File-log Task 정의
+	fileLogTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		repoOpts := *opts
+		authors, err := authorMailmap(cfg, repoPath)
+		if err != nil {
+			return fail(err), nil
+		}
+		repoOpts.Mailmap = authors
+
+		commits, err := git.NewClient(repoPath).Log(&repoOpts)
+		if err != nil {
+			return fail(err), nil
+		}
+
+		mu.Lock()
+		for _, commit := range commits {
+			entries = append(entries, fileLogEntry{Repository: repo.Name, LogEntry: commit})
+		}
+		mu.Unlock()
+
+		result.Success = true
+		if len(commits) == 0 {
+			result.Message = "no matching commits"
+		} else {
+			result.Message = plural(len(commits), "commit")
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader("Searching file history")
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, fileLogTask)
+
+	// 8. 날짜순 병합 (최신 우선, 같은 시각은 저장소 이름순)
+	sort.SliceStable(entries, func(i, j int) bool {
+		if !entries[i].Date.Equal(entries[j].Date) {
+			return entries[i].Date.After(entries[j].Date)
+		}
+		return entries[i].Repository < entries[j].Repository
+	})
+	if fileLogMax > 0 && len(entries) > fileLogMax {
+		entries = entries[:fileLogMax]
+	}
+
+	// 9. 결과 출력
+	if fileLogJSON {
+		if entries == nil {
+			entries = []fileLogEntry{}
+		}
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode commits: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		printFileLog(entries)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// printFileLog prints the commits with their repository and the matching files
+func printFileLog(entries []fileLogEntry) {
+	if len(entries) == 0 {
+		fmt.Println("\nNo commits changed matching files")
+		return
+	}
+
+	width := 0
+	for _, entry := range entries {
+		width = max(width, len(entry.Repository))
+	}
+	fmt.Println()
+	for _, entry := range entries {
+		fmt.Printf("%s  %-*s  %s  %-16s %s\n", entry.Date.Format("2006-01-02 15:04"), width,
+			entry.Repository, entry.ShortHash(), entry.Author, entry.Subject)
+		for _, file := range entry.Files {
+			fmt.Printf("%*s%s\n", 16+2+width+2+7+2, "", file)
+		}
+	}
+}
+
+func GetFileLogCmd() *cobra.Command {
+	return fileLogCmd
+}
@@ -0,0 +1,251 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// PatchManifestName is the file describing the patch series of an export directory
+const PatchManifestName = "manifest.json"
+
+// Format-patch 플래그 변수
+var (
+	patchFrom     string // 시작 ref (이 ref 이후 커밋만 내보냄)
+	patchTo       string // 끝 ref (기본: HEAD)
+	patchOutput   string // 출력 디렉토리
+	patchForce    bool   // 기존 출력 디렉토리 덮어쓰기
+	patchParallel int    // 병렬 처리 수
+)
+
+// patchManifest describes the patch series written by format-patch
+type patchManifest struct {
+	CreatedAt    time.Time             `json:"created_at"`
+	From         string                `json:"from"`
+	To           string                `json:"to"`
+	Repositories []patchManifestSeries `json:"repositories"`
+}
+
+// patchManifestSeries is the patch series of one repository
+type patchManifestSeries struct {
+	Name    string   `json:"name"`
+	Base    string   `json:"base"`    // --from가 가리키는 커밋 (적용 대상 저장소에 있어야 함)
+	Head    string   `json:"head"`    // --to가 가리키는 커밋
+	Patches []string `json:"patches"` // <name>/ 아래 패치 파일 (적용 순서)
+}
+
+var formatPatchCmd = &cobra.Command{
+	Use:   "format-patch",
+	Short: "Export the commits of all repositories as patch files",
+	Long: `Export the commits since a ref as email-style patch files, one directory per
+repository, to carry changes into an environment without access to the remotes.
+Apply them there with 'multi-git am <dir>'.
+
+The output directory contains <repo-name>/0001-*.patch files and a manifest.json
+recording the range and the base commit of every series. Repositories without
+commits in the range, or where a ref does not exist, are skipped.
+
+Exporting patches uses the git binary.
+
+Examples:
+  # Export everything since the v1.0.0 tag
+  multi-git format-patch --from v1.0.0 -o patches/
+
+  # Only the backend repositories, between two releases
+  multi-git format-patch --from v1.0.0 --to v1.1.0 -g backend -o release-1.1`,
+	Args: cobra.NoArgs,
+	Run:  runFormatPatch,
+}
+
+func init() {
+	formatPatchCmd.Flags().StringVar(&patchFrom, "from", "",
+		"Export the commits after this tag, branch, or commit (required)")
+	formatPatchCmd.Flags().StringVar(&patchTo, "to", "HEAD",
+		"Export the commits up to this ref")
+	formatPatchCmd.Flags().StringVarP(&patchOutput, "output", "o", "patches",
+		"Output directory")
+	formatPatchCmd.Flags().BoolVarP(&patchForce, "force", "f", false,
+		"Replace the patches of an existing output directory")
+	formatPatchCmd.Flags().IntVarP(&patchParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	_ = formatPatchCmd.MarkFlagRequired("from")
+}
+
+func runFormatPatch(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 출력 디렉토리 확인 (이전 내보내기와 섞이지 않도록)
+	outDir, err := filepath.Abs(patchOutput)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid output directory: %v\n", err)
+		os.Exit(1)
+	}
+	if entries, err := os.ReadDir(outDir); err == nil && len(entries) > 0 && !patchForce {
+		fmt.Fprintf(os.Stderr, "Error: output directory %s is not empty\n", outDir)
+		fmt.Fprintf(os.Stderr, "  hint: use '--force' to replace the exported patches, or choose another '--output'\n")
+		os.Exit(1)
+	}
+	if patchForce {
+		// 이전 내보내기의 manifest에 있던 저장소 제거
+		if previous, err := readPatchManifest(outDir); err == nil {
+			for _, s := range previous.Repositories {
+				_ = os.RemoveAll(filepath.Join(outDir, filepath.Base(s.Name)))
+			}
+			_ = os.Remove(filepath.Join(outDir, PatchManifestName))
+		}
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 병렬 수 결정
+	workers := patchParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 저장소별 패치 시리즈 (manifest 작성에 사용)
+	var mu sync.Mutex
+	series := make(map[string]patchManifestSeries)
+
+	// 6. Format-patch Task 정의
+	patchTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// 두 ref를 커밋으로 해석 (없는 저장소는 스킵)
+		base, err := client.GetCommitAtRevision(patchFrom)
+		if err != nil {
+			return skip(fmt.Sprintf("skipped: '%s' not found", patchFrom)), nil
+		}
+		head, err := client.GetCommitAtRevision(patchTo)
+		if err != nil {
+			return skip(fmt.Sprintf("skipped: '%s' not found", patchTo)), nil
+		}
+
+		// 이전 내보내기의 패치 제거 후 작성
+		repoDir := filepath.Join(outDir, repo.Name)
+		if err := os.RemoveAll(repoDir); err != nil {
+			return fail(fmt.Errorf("failed to clear %s: %w", repoDir, err)), nil
+		}
+		files, err := client.FormatPatch(base.Hash.String(), head.Hash.String(), repoDir)
+		if err != nil {
+			return fail(err), nil
+		}
+		if len(files) == 0 {
+			return skip(fmt.Sprintf("no commits since '%s'", patchFrom)), nil
+		}
+
+		mu.Lock()
+		series[repo.Name] = patchManifestSeries{
+			Name:    repo.Name,
+			Base:    base.Hash.String(),
+			Head:    head.Hash.String(),
+			Patches: files,
+		}
+		mu.Unlock()
+
+		result.Success = true
+		result.Message = fmt.Sprintf("%s exported", plural(len(files), "patch"))
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Exporting patches %s..%s to %s", patchFrom, patchTo, outDir))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, patchTask)
+
+	// 8. manifest 작성 (설정 순서)
+	manifest := patchManifest{
+		CreatedAt:    time.Now().UTC(),
+		From:         patchFrom,
+		To:           patchTo,
+		Repositories: []patchManifestSeries{},
+	}
+	for _, repo := range cfg.Repositories {
+		if s, ok := series[repo.Name]; ok {
+			manifest.Repositories = append(manifest.Repositories, s)
+		}
+	}
+	if len(manifest.Repositories) > 0 {
+		if err := writePatchManifest(outDir, &manifest); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 9. 결과 출력
+	reporter.PrintFullReport(summary)
+	if len(manifest.Repositories) > 0 {
+		reporter.PrintSuccess(fmt.Sprintf("Patches of %s written to %s (apply with 'multi-git am %s')",
+			plural(len(manifest.Repositories), "repository"), outDir, patchOutput))
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// writePatchManifest writes the manifest of an export directory
+func writePatchManifest(dir string, manifest *patchManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+	data = append(data, '\n')
+	if err := os.WriteFile(filepath.Join(dir, PatchManifestName), data, 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// readPatchManifest reads the manifest of an export directory
+func readPatchManifest(dir string) (*patchManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, PatchManifestName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s has no %s (not written by 'multi-git format-patch')", dir, PatchManifestName)
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest patchManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", filepath.Join(dir, PatchManifestName), err)
+	}
+	return &manifest, nil
+}
+
+func GetFormatPatchCmd() *cobra.Command {
+	return formatPatchCmd
+}
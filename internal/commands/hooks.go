@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// hookShell is the shell hook commands run in (/bin/sh, cmd.exe on Windows)
+var hookShell = shell.DefaultShell()
+
+// withHooks wraps the task with the pre_<operation> and post_<operation> hooks of the config
+// The pre hook runs before the task and a failing pre hook fails the repository without
+// running the task. The post hook runs only after the task succeeded (not when skipped).
+// branch is exported as MG_BRANCH ('@default' is resolved per repository); if empty,
+// the repository's current branch is used. Hooks are not run with --no-hooks or --dry-run.
+func withHooks(cmd *cobra.Command, mgr *repository.Manager, operation, branch string, task repository.TaskFunc, extraEnv ...string) repository.TaskFunc {
+	cfg := mgr.Config()
+	pre, post := cfg.Hook("pre_"+operation), cfg.Hook("post_"+operation)
+	if pre == "" && post == "" {
+		return task
+	}
+	if noHooks, _ := cmd.Root().PersistentFlags().GetBool("no-hooks"); noHooks {
+		return task
+	}
+	if flag := cmd.Flags().Lookup("dry-run"); flag != nil && flag.Value.String() == "true" {
+		return task
+	}
+
+	timeout := cfg.HookTimeout
+	if timeout <= 0 {
+		timeout = shell.DefaultTimeout
+	}
+
+	return func(repo config.Repository) (repository.Result, error) {
+		startTime := time.Now()
+
+		if pre != "" {
+			if err := runHook(mgr, repo, "pre_"+operation, pre, branch, timeout, extraEnv); err != nil {
+				return repository.Result{
+					RepoName: repo.Name,
+					Error:    err,
+					Duration: time.Since(startTime),
+				}, nil
+			}
+		}
+
+		result := task.Run(repo)
+		if post == "" || !result.Success || result.IsSkipped() {
+			return result, nil
+		}
+
+		if err := runHook(mgr, repo, "post_"+operation, post, branch, timeout, extraEnv); err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+		}
+		return result, nil
+	}
+}
+
+// runHook runs a hook command in the repository directory (the base directory
+// if the repository does not exist yet, e.g. pre_clone)
+func runHook(mgr *repository.Manager, repo config.Repository, name, command, branch string, timeout time.Duration, extraEnv []string) error {
+	repoPath := mgr.GetRepositoryPath(repo)
+	workDir := repoPath
+	if !repository.DirectoryExists(repoPath) {
+		workDir = mgr.BaseDir()
+	}
+
+	env := append(hookEnv(mgr, repo, name, branch), extraEnv...)
+	log.Debugf("%s: running %s hook: %s", repo.Name, name, command)
+
+	output, err := shell.ExecuteWithEnv(workDir, hookShell, command, env, timeout)
+	if err != nil {
+		message := fmt.Sprintf("%s hook failed: %v", name, err)
+		if output = strings.TrimSpace(output); output != "" {
+			message += "\n" + output
+		}
+		return fmt.Errorf("%s", message)
+	}
+	if output != "" {
+		log.Debugf("%s: %s hook output:\n%s", repo.Name, name, output)
+	}
+	return nil
+}
+
+// hookEnv returns the MG_* environment variables of a hook: the hook name and the repository
+func hookEnv(mgr *repository.Manager, repo config.Repository, name, branch string) []string {
+	resolved, err := repo.ResolveBranch(branch)
+	if err != nil || resolved == "" {
+		resolved = ""
+		if mgr.IsGitRepository(repo) {
+			resolved, _ = newGitClient(mgr.Config(), repo).GetCurrentBranch()
+		}
+	}
+	return append([]string{"MG_HOOK=" + name}, repoEnv(mgr, repo, resolved)...)
+}
+
+// repoEnv returns the MG_* environment variables describing the repository
+func repoEnv(mgr *repository.Manager, repo config.Repository, branch string) []string {
+	return []string{
+		"MG_REPO_NAME=" + repo.Name,
+		"MG_REPO_PATH=" + mgr.GetRepositoryPath(repo),
+		"MG_REPO_URL=" + repo.URL,
+		"MG_REPO_GROUPS=" + strings.Join(repo.Groups, ","),
+		"MG_BRANCH=" + branch,
+		"MG_BASE_DIR=" + mgr.BaseDir(),
+		"MG_CONFIG=" + mgr.Config().ConfigPath,
+	}
+}
@@ -0,0 +1,219 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/spf13/cobra"
+)
+
+// BuildInfo holds build metadata injected by the main package
+type BuildInfo struct {
+	Version string // 버전
+	Commit  string // 빌드 커밋 (ldflags, 없으면 Go VCS 정보)
+	Date    string // 빌드 시각 (ldflags, 없으면 Go VCS 정보)
+}
+
+// buildInfo is set once at startup by SetBuildInfo
+var buildInfo BuildInfo
+
+// SetBuildInfo sets the build metadata, filling missing fields from the Go build info
+func SetBuildInfo(info BuildInfo) {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		var modified bool
+		for _, setting := range bi.Settings {
+			switch setting.Key {
+			case "vcs.revision":
+				if info.Commit == "" && len(setting.Value) >= 7 {
+					info.Commit = setting.Value[:7]
+				}
+			case "vcs.time":
+				if info.Date == "" {
+					info.Date = setting.Value
+				}
+			case "vcs.modified":
+				modified = setting.Value == "true"
+			}
+		}
+		if modified && info.Commit != "" && !strings.HasSuffix(info.Commit, "-dirty") {
+			info.Commit += "-dirty"
+		}
+	}
+	buildInfo = info
+}
+
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Print the version of multi-git",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		fmt.Printf("multi-git %s (%s)\n", buildInfo.Version, orUnknown(buildInfo.Commit))
+	},
+}
+
+var infoCmd = &cobra.Command{
+	Use:   "info",
+	Short: "Show build, configuration, git, and authentication details",
+	Long: `Show diagnostic information useful when reporting issues:
+build metadata, Go version, config file resolution, the git engine in use,
+and the status of configured credentials. Secrets are never printed.`,
+	Args: cobra.NoArgs,
+	Run:  runInfo,
+}
+
+func runInfo(cmd *cobra.Command, args []string) {
+	// 1. 빌드 정보
+	fmt.Printf("multi-git %s\n", buildInfo.Version)
+	fmt.Printf("  Commit:   %s\n", orUnknown(buildInfo.Commit))
+	fmt.Printf("  Built:    %s\n", orUnknown(buildInfo.Date))
+	fmt.Printf("  Go:       %s %s/%s\n", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+
+	// 2. 설정 파일 경로 확인
+	fmt.Println()
+	fmt.Println("Config:")
+	fmt.Println("  Resolution order: --config flag > ~/.multi-git/config.yaml")
+
+	configFlag := cmd.Root().PersistentFlags().Lookup("config")
+	configPath := configFlag.Value.String()
+	source := "default"
+	if configFlag.Changed {
+		source = "--config flag"
+	}
+
+	status := "found"
+	if _, err := os.Stat(configPath); err != nil {
+		status = "not found"
+	}
+	fmt.Printf("  Using:    %s (%s, %s)\n", configPath, source, status)
+	if profile := configProfile(cmd); profile != "" {
+		fmt.Printf("  Profile:  %s\n", profile)
+	}
+
+	cfg, err := config.LoadConfigProfile(configPath, configProfile(cmd), configOverlays(cmd)...)
+	if err == nil {
+		if len(cfg.Profiles) > 0 {
+			fmt.Printf("  Profiles: %s\n", strings.Join(cfg.Profiles, ", "))
+		}
+		for _, overlay := range cfg.Overlays {
+			fmt.Printf("  Overlay:  %s\n", overlay)
+		}
+		fmt.Printf("  Base dir: %s\n", cfg.BaseDir)
+		fmt.Printf("  Repositories: %d\n", len(cfg.Repositories))
+		fmt.Printf("  Run logs: %s\n", filepath.Join(cfg.ConfigDir, log.DirName))
+		if err := config.ValidateConfig(cfg); err != nil {
+			fmt.Printf("  Validation: %v\n", err)
+		} else {
+			fmt.Println("  Validation: ok")
+		}
+	} else if status == "found" {
+		fmt.Printf("  Error:    %v\n", err)
+	}
+
+	// 3. Git 엔진
+	fmt.Println()
+	fmt.Println("Git:")
+	fmt.Printf("  Engine:   go-git %s (embedded)\n", moduleVersion("github.com/go-git/go-git/v5"))
+	if gitPath, err := exec.LookPath("git"); err == nil {
+		output, _ := exec.Command(gitPath, "--version").Output()
+		fmt.Printf("  Binary:   %s (%s)\n", gitPath, strings.TrimSpace(string(output)))
+	} else {
+		fmt.Println("  Binary:   not found (only needed by exec and sync --rebase/--stash-local)")
+	}
+
+	// 4. 인증 상태
+	fmt.Println()
+	fmt.Println("Auth:")
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		fmt.Println("  ssh-agent: available (SSH_AUTH_SOCK set)")
+	} else {
+		fmt.Println("  ssh-agent: not available")
+	}
+
+	if cfg == nil {
+		return
+	}
+	fmt.Printf("  Global:   %s\n", describeAuth(cfg.Auth))
+
+	overrides := 0
+	for _, repo := range cfg.Repositories {
+		if repo.Auth != nil && !repo.Auth.IsEmpty() {
+			overrides++
+			fmt.Printf("  %s: %s\n", repo.Name, describeAuth(*repo.Auth))
+		}
+	}
+	if overrides == 0 {
+		fmt.Println("  Per-repository overrides: none")
+	}
+}
+
+// describeAuth summarizes credentials without revealing secrets
+func describeAuth(auth config.AuthConfig) string {
+	if auth.IsEmpty() {
+		return "not configured (system defaults)"
+	}
+
+	var parts []string
+	if auth.SSHKey != "" {
+		state := "found"
+		if _, err := os.Stat(auth.SSHKey); err != nil {
+			state = "missing"
+		}
+		parts = append(parts, fmt.Sprintf("ssh_key %s (%s)", auth.SSHKey, state))
+	}
+	if auth.SSHKeyPassphraseEnv != "" {
+		parts = append(parts, fmt.Sprintf("passphrase $%s (%s)", auth.SSHKeyPassphraseEnv, envState(auth.SSHKeyPassphraseEnv)))
+	}
+	if auth.Username != "" {
+		parts = append(parts, fmt.Sprintf("username %s", auth.Username))
+	}
+	if auth.Token != "" {
+		parts = append(parts, "token (inline)")
+	}
+	if auth.TokenEnv != "" {
+		parts = append(parts, fmt.Sprintf("token $%s (%s)", auth.TokenEnv, envState(auth.TokenEnv)))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// envState reports whether an environment variable is set
+func envState(name string) string {
+	if os.Getenv(name) != "" {
+		return "set"
+	}
+	return "not set"
+}
+
+// moduleVersion returns the version of a dependency from the Go build info
+func moduleVersion(path string) string {
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range bi.Deps {
+			if dep.Path == path {
+				return dep.Version
+			}
+		}
+	}
+	return "unknown"
+}
+
+// orUnknown returns "unknown" for empty values
+func orUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
+
+func GetInfoCmd() *cobra.Command {
+	return infoCmd
+}
+
+func GetVersionCmd() *cobra.Command {
+	return versionCmd
+}
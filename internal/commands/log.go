@@ -0,0 +1,344 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Log 플래그 변수
+var (
+	logSince     string // 이 시각 이후 커밋만 (예: "2 weeks", "2024-01-31")
+	logAuthor    string // 작성자 이름 또는 이메일 필터
+	logGrep      string // 커밋 메시지 검색 (정규식, 대소문자 무시)
+	logPickaxe   string // 변경 내용 검색 (이 문자열의 등장 횟수를 바꾼 커밋)
+	logMax       int    // 저장소별 최대 커밋 수
+	logOneline   bool   // 커밋당 한 줄 출력
+	logJSON      bool   // JSON 출력
+	logAuthors   bool   // 작성자별 커밋 수 요약 출력
+	logNoMailmap bool   // .mailmap 및 설정의 mailmap 적용 안 함
+	logParallel  int    // 병렬 처리 수
+)
+
+// logRepository is the per-repository entry of the JSON output of log
+type logRepository struct {
+	Name    string         `json:"name"`
+	URL     string         `json:"url"`
+	Commits []git.LogEntry `json:"commits"`
+	Error   string         `json:"error,omitempty"`
+}
+
+var logCmd = &cobra.Command{
+	Use:   "log [ref]",
+	Short: "Show recent commits of all repositories",
+	Long: `Show the recent commit history of every managed repository, newest first,
+grouped by repository. Without a ref, the history of HEAD is shown.
+
+--since accepts relative times ("2 weeks", "3 days ago", "12h", "1mo"),
+"today", "yesterday", or a date (2024-01-31). --author matches part of the
+author name or email, case-insensitively. Repositories without matching
+commits are listed as such, so an audit covers the whole fleet.
+
+--grep searches the commit messages with a regular expression (ignoring case),
+-S the changes: it finds the commits that add or remove the given string, like
+'git log -S'. Both search every repository in parallel, which makes them the
+fastest way to find where the changes for a ticket landed.
+
+Authors are normalized with each repository's .mailmap and then the
+mailmap entries of the config, so one person committing under several
+emails shows up (and matches --author) as a single identity. --authors
+summarizes the commits per author across all repositories.
+
+Examples:
+  # What landed in the last two weeks
+  multi-git log --since "2 weeks"
+
+  # The last 5 commits by alice in each repository, one line each
+  multi-git log --author alice --max 5 --oneline
+
+  # Where did the changes for a ticket land?
+  multi-git log --grep "JIRA-1234" --max 0 --oneline
+
+  # Which commits added or removed a function call
+  multi-git log -S "legacyAuth(" --max 0
+
+  # Who contributed where this quarter
+  multi-git log --since 3mo --max 0 --authors
+
+  # Commits on main since the start of the month, as JSON
+  multi-git log main --since 2024-06-01 --json > audit.json`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeBranchArg,
+	Run:               runLog,
+}
+
+func init() {
+	logCmd.Flags().StringVar(&logSince, "since", "",
+		"Only show commits more recent than this (e.g. '2 weeks', 'yesterday', '2024-01-31')")
+	logCmd.Flags().StringVar(&logAuthor, "author", "",
+		"Only show commits whose author name or email contains this (case-insensitive)")
+	logCmd.Flags().StringVar(&logGrep, "grep", "",
+		"Only show commits whose message matches this regular expression (case-insensitive)")
+	logCmd.Flags().StringVarP(&logPickaxe, "pickaxe", "S", "",
+		"Only show commits that add or remove this string in a file")
+	logCmd.Flags().IntVarP(&logMax, "max", "n", 10,
+		"Maximum number of commits per repository (0 = unlimited)")
+	logCmd.Flags().BoolVar(&logOneline, "oneline", false,
+		"Show each commit on a single line")
+	logCmd.Flags().BoolVar(&logJSON, "json", false,
+		"Print the commits as JSON")
+	logCmd.Flags().BoolVar(&logAuthors, "authors", false,
+		"Summarize the commits per author across all repositories")
+	logCmd.Flags().BoolVar(&logNoMailmap, "no-mailmap", false,
+		"Show authors as recorded, without applying .mailmap and the config's mailmap")
+	logCmd.Flags().IntVarP(&logParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	logCmd.MarkFlagsMutuallyExclusive("oneline", "json", "authors")
+}
+
+func runLog(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 조회 옵션 결정
+	opts := &git.LogOptions{Author: logAuthor, Max: logMax, Pickaxe: logPickaxe}
+	if logGrep != "" {
+		grep, err := regexp.Compile("(?i)" + logGrep)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --grep pattern: %v\n", err)
+			os.Exit(1)
+		}
+		opts.Grep = grep
+	}
+	if len(args) > 0 {
+		opts.Ref = args[0]
+	}
+	if logSince != "" {
+		since, err := git.ParseSince(logSince, time.Now())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		opts.Since = since
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성 (JSON 출력 시 리포트는 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if logJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 5. 병렬 수 결정
+	workers := logParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 저장소별 커밋 목록
+	var mu sync.Mutex
+	commits := make(map[string][]git.LogEntry)
+
+	// 6. Log Task 정의
+	logTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		repoOpts := *opts
+		if repoOpts.Ref != "" {
+			ref, err := repo.ResolveBranch(repoOpts.Ref)
+			if err != nil {
+				result.Success = false
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			repoOpts.Ref = ref
+		}
+
+		if !logNoMailmap {
+			authors, err := authorMailmap(cfg, repoPath)
+			if err != nil {
+				result.Success = false
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			repoOpts.Mailmap = authors
+		}
+
+		entries, err := git.NewClient(repoPath).Log(&repoOpts)
+		result.Duration = time.Since(startTime)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			return result, nil
+		}
+
+		mu.Lock()
+		commits[repo.Name] = entries
+		mu.Unlock()
+
+		result.Success = true
+		if len(entries) == 0 {
+			result.Message = "no matching commits"
+		} else {
+			result.Message = plural(len(entries), "commit")
+		}
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	if opts.Grep != nil || opts.Pickaxe != "" {
+		reporter.PrintHeader("Searching commit history")
+	} else {
+		reporter.PrintHeader("Reading commit history")
+	}
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, logTask)
+
+	// 8. 결과 출력
+	if logJSON {
+		printLogJSON(cfg, summary, commits)
+		reporter.PrintSummary(summary)
+	} else if logAuthors {
+		printLogAuthors(cfg, commits)
+		reporter.PrintSummary(summary)
+	} else {
+		for _, result := range summary.Results {
+			entries, ok := commits[result.RepoName]
+			if !ok || len(entries) == 0 {
+				reporter.PrintResult(result)
+				continue
+			}
+			fmt.Printf("\n== %s (%s) ==\n", result.RepoName, plural(len(entries), "commit"))
+			for _, entry := range entries {
+				printLogEntry(entry)
+			}
+		}
+		reporter.PrintSummary(summary)
+	}
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// printLogEntry prints a commit in the --oneline or the default format
+func printLogEntry(entry git.LogEntry) {
+	if logOneline {
+		fmt.Printf("%s  %s  %-16s %s\n", entry.ShortHash(), entry.Date.Format("2006-01-02"), entry.Author, entry.Subject)
+		return
+	}
+
+	fmt.Printf("%s  %s\n", entry.ShortHash(), entry.Subject)
+	fmt.Printf("         %s <%s>, %s\n", entry.Author, entry.Email, entry.Date.Format("2006-01-02 15:04"))
+	if entry.Body != "" {
+		for _, line := range strings.Split(entry.Body, "\n") {
+			fmt.Printf("         %s\n", line)
+		}
+	}
+}
+
+// logAuthorSummary is an author's line of the --authors summary
+type logAuthorSummary struct {
+	identity string
+	commits  int
+	repos    []string
+}
+
+// printLogAuthors prints the number of commits per author, most commits first,
+// with the repositories (in config order) each author committed to
+func printLogAuthors(cfg *config.Config, commits map[string][]git.LogEntry) {
+	authors := make(map[string]*logAuthorSummary)
+	for _, repo := range cfg.Repositories {
+		for _, entry := range commits[repo.Name] {
+			identity := fmt.Sprintf("%s <%s>", entry.Author, entry.Email)
+			author, ok := authors[strings.ToLower(identity)]
+			if !ok {
+				author = &logAuthorSummary{identity: identity}
+				authors[strings.ToLower(identity)] = author
+			}
+			author.commits++
+			if len(author.repos) == 0 || author.repos[len(author.repos)-1] != repo.Name {
+				author.repos = append(author.repos, repo.Name)
+			}
+		}
+	}
+
+	list := make([]*logAuthorSummary, 0, len(authors))
+	width := 0
+	for _, author := range authors {
+		list = append(list, author)
+		width = max(width, len(author.identity))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].commits != list[j].commits {
+			return list[i].commits > list[j].commits
+		}
+		return list[i].identity < list[j].identity
+	})
+
+	fmt.Printf("\n%s:\n", plural(len(list), "author"))
+	for _, author := range list {
+		fmt.Printf("%6d  %-*s  %s\n", author.commits, width, author.identity, strings.Join(author.repos, ", "))
+	}
+}
+
+// printLogJSON prints the commits of every repository in config order as indented JSON
+func printLogJSON(cfg *config.Config, summary *repository.Summary, commits map[string][]git.LogEntry) {
+	errs := make(map[string]error)
+	for _, result := range summary.Results {
+		if result.Error != nil {
+			errs[result.RepoName] = result.Error
+		}
+	}
+
+	repos := make([]logRepository, 0, len(cfg.Repositories))
+	for _, repo := range cfg.Repositories {
+		entry := logRepository{Name: repo.Name, URL: repo.URL, Commits: commits[repo.Name]}
+		if entry.Commits == nil {
+			entry.Commits = []git.LogEntry{}
+		}
+		if err, ok := errs[repo.Name]; ok {
+			entry.Error = err.Error()
+		}
+		repos = append(repos, entry)
+	}
+
+	data, err := json.MarshalIndent(repos, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: failed to encode commits: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
+
+func GetLogCmd() *cobra.Command {
+	return logCmd
+}
@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// logLevel is the level of the --log-file log and the per-repository run logs
+var logLevel = log.LevelInfo
+
+// SetupLogging applies the global --log-file and --log-level flags
+// Called once before any command runs
+func SetupLogging(logFile, level string) error {
+	parsed, err := log.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	logLevel = parsed
+
+	if logFile == "" {
+		return nil
+	}
+
+	path, err := config.ExpandPath(logFile)
+	if err != nil {
+		return err
+	}
+	logger, err := log.Open(path, logLevel)
+	if err != nil {
+		return err
+	}
+	log.SetDefault(logger)
+	log.Infof("run: %s", strings.Join(os.Args, " "))
+	return nil
+}
+
+// withRunLog wraps the task so that every repository gets its own log file
+// under <config dir>/logs/<start time>-<operation>/. Returns the task unchanged
+// and an empty directory if the run log cannot be created.
+func withRunLog(cmd *cobra.Command, mgr *repository.Manager, task repository.TaskFunc) (repository.TaskFunc, string) {
+	operation := operationName(cmd)
+
+	run, err := log.StartRun(mgr.StatePath(log.DirName), operation, logLevel, log.DefaultKeepRuns)
+	if err != nil {
+		log.Warnf("run logs disabled: %v", err)
+		return task, ""
+	}
+	log.Infof("%s: %d repositories, logs in %s", operation, mgr.RepositoryCount(), run.Dir())
+
+	wrapped := func(repo config.Repository) (repository.Result, error) {
+		logger, err := run.Repo(repo.Name)
+		if err != nil {
+			log.Warnf("%s: %v", repo.Name, err)
+			return task.Run(repo), nil
+		}
+		defer logger.Close()
+
+		logger.Infof("%s started in %s", operation, mgr.GetRepositoryPath(repo))
+		logger.Debugf("url: %s", repo.URL)
+
+		result := task.Run(repo)
+
+		switch {
+		case !result.Success:
+			logger.Errorf("%s failed after %.2fs: %v", operation, result.Duration.Seconds(), result.Error)
+			if result.Message != "" {
+				logger.Errorf("output:\n%s", result.Message)
+			}
+			log.Errorf("%s: %s failed: %v", operation, repo.Name, result.Error)
+		case result.IsSkipped():
+			logger.Infof("%s skipped: %s", operation, result.Message)
+		default:
+			logger.Infof("%s succeeded in %.2fs", operation, result.Duration.Seconds())
+			if details := result.DetailsString(); details != "" {
+				logger.Infof("details: %s", details)
+			}
+			if result.Message != "" {
+				logger.Debugf("output:\n%s", result.Message)
+			}
+		}
+		return result, nil
+	}
+	return wrapped, run.Dir()
+}
+
+// reportRunLogs points to the run logs when repositories failed
+func reportRunLogs(dir string, summary *repository.Summary) {
+	log.Infof("finished: %d succeeded, %d failed, %d skipped in %.2fs",
+		summary.SuccessCount, summary.FailedCount, summary.SkippedCount, summary.TotalDuration.Seconds())
+
+	if dir != "" && summary.HasFailures() {
+		fmt.Fprintf(os.Stderr, "Logs: %s\n", dir)
+	}
+}
@@ -0,0 +1,155 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/metrics"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Metrics 플래그 변수
+var (
+	metricsEndpoint string // 전송 대상 URL (기본: config metrics.endpoint)
+	metricsKeep     bool   // 전송 후 로컬 집계 유지
+)
+
+var metricsCmd = &cobra.Command{
+	Use:   "metrics",
+	Short: "Show or export opt-in usage metrics",
+	Long: `Usage metrics are collected only when enabled in the config:
+
+  config:
+    metrics:
+      enabled: true
+      endpoint: https://metrics.example.com/multi-git   # optional
+
+Each batch command run records the command name, repository count,
+failure count, and duration in a local file next to the config.
+Repository names, URLs, and paths are never recorded.`,
+}
+
+var metricsShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show locally aggregated usage metrics",
+	Args:  cobra.NoArgs,
+	Run:   runMetricsShow,
+}
+
+var metricsExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Send aggregated metrics to the team endpoint",
+	Long: `Send the locally aggregated metrics as JSON to the configured endpoint.
+The local metrics are reset after a successful export unless --keep is given.`,
+	Args: cobra.NoArgs,
+	Run:  runMetricsExport,
+}
+
+var metricsResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Delete all locally aggregated metrics",
+	Args:  cobra.NoArgs,
+	Run:   runMetricsReset,
+}
+
+func init() {
+	metricsExportCmd.Flags().StringVar(&metricsEndpoint, "endpoint", "",
+		"Endpoint URL (default: config metrics.endpoint)")
+	metricsExportCmd.Flags().BoolVar(&metricsKeep, "keep", false,
+		"Keep local metrics after a successful export")
+
+	metricsCmd.AddCommand(metricsShowCmd)
+	metricsCmd.AddCommand(metricsExportCmd)
+	metricsCmd.AddCommand(metricsResetCmd)
+}
+
+// loadMetricsStore loads the config and its metrics store. Exits on error.
+func loadMetricsStore(cmd *cobra.Command) (*config.Config, *metrics.Store) {
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+
+	cfg, err := config.LoadAndValidateProfile(configPath, configProfile(cmd))
+	if err != nil {
+		exitOnConfigError(cmd, configPath, err)
+	}
+
+	mgr := repository.NewManager(cfg)
+	store, err := metrics.Load(mgr.StatePath(metrics.FileName))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	return cfg, store
+}
+
+func runMetricsShow(cmd *cobra.Command, args []string) {
+	cfg, store := loadMetricsStore(cmd)
+
+	if !cfg.Metrics.Enabled {
+		fmt.Println("Metrics collection is disabled")
+		fmt.Println("  hint: set 'config.metrics.enabled: true' to opt in")
+	}
+
+	if len(store.Commands) == 0 {
+		fmt.Println("No metrics recorded yet")
+		return
+	}
+
+	fmt.Printf("Usage since %s\n\n", store.Since.Format("2006-01-02 15:04"))
+	fmt.Printf("  %-20s %6s %8s %8s %10s %10s\n", "COMMAND", "RUNS", "FAILED", "REPOS", "AVG", "TOTAL")
+	for _, name := range store.CommandNames() {
+		stats := store.Commands[name]
+		fmt.Printf("  %-20s %6d %8d %8d %9.1fs %9.1fs\n",
+			name, stats.Runs, stats.FailedRuns, stats.Repositories, stats.AvgSeconds(), stats.TotalSeconds)
+	}
+}
+
+func runMetricsExport(cmd *cobra.Command, args []string) {
+	cfg, store := loadMetricsStore(cmd)
+
+	endpoint := metricsEndpoint
+	if endpoint == "" {
+		endpoint = cfg.Metrics.Endpoint
+	}
+	if endpoint == "" {
+		fmt.Fprintf(os.Stderr, "Error: no metrics endpoint configured\n")
+		fmt.Fprintf(os.Stderr, "  hint: set 'config.metrics.endpoint' or use '--endpoint'\n")
+		os.Exit(1)
+	}
+
+	if len(store.Commands) == 0 {
+		fmt.Println("No metrics recorded yet, nothing to export")
+		return
+	}
+
+	if err := store.Export(endpoint, buildInfo.Version); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	if !metricsKeep {
+		store.Reset()
+		if err := store.Save(); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	fmt.Printf("✓ Metrics exported to %s\n", endpoint)
+}
+
+func runMetricsReset(cmd *cobra.Command, args []string) {
+	_, store := loadMetricsStore(cmd)
+
+	store.Reset()
+	if err := store.Save(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("✓ Metrics reset")
+}
+
+func GetMetricsCmd() *cobra.Command {
+	return metricsCmd
+}
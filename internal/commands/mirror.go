@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Mirror 플래그 변수
+var (
+	mirrorDest     string // 미러 디렉토리
+	mirrorParallel int    // 병렬 처리 수
+)
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Back up all repositories as bare mirrors",
+	Long: `Create or update a bare mirror (like 'git clone --mirror') of every
+configured repository in a backup directory, straight from the remotes.
+
+Missing mirrors are cloned as <repo-name>.git, existing ones are fetched with
+pruning, so every branch, tag, and other reference matches the remote. Restore
+a repository with 'git clone <dest>/<repo-name>.git'. To keep mirrors up to
+date continuously, use 'multi-git mirror-sync'; for single-file backups of the
+local clones, use 'multi-git bundle'.
+
+Examples:
+  # Back up every repository
+  multi-git mirror --dest /backups/git
+
+  # Only the backend repositories, 8 at a time
+  multi-git mirror --dest /backups/git -g backend -p 8`,
+	Args: cobra.NoArgs,
+	Run:  runMirror,
+}
+
+func init() {
+	mirrorCmd.Flags().StringVar(&mirrorDest, "dest", "",
+		"Directory of the mirrors (required)")
+	mirrorCmd.Flags().IntVarP(&mirrorParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	_ = mirrorCmd.MarkFlagRequired("dest")
+}
+
+func runMirror(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	dest, err := filepath.Abs(mirrorDest)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid --dest: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 결정
+	workers := mirrorParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 미러 디렉토리도 클론과 같은 권한 사용
+	dirs, err := git.NewDirPermissions(uint32(cfg.DirMode), cfg.Group)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 5. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Mirroring repositories to %s", dest))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, mirrorTask(cfg, mgr, dest, dirs))
+
+	// 6. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// mirrorTask returns the task that keeps <dir>/<repo-name>.git a bare mirror of
+// the repository: it is cloned if missing, otherwise fetched with pruning
+func mirrorTask(cfg *config.Config, mgr *repository.Manager, dir string, dirs *git.DirPermissions) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		mirrorPath := filepath.Join(dir, repo.Name+".git")
+		auth := credentials.GitAuth(cfg, repo)
+
+		if !git.DirectoryExists(mirrorPath) {
+			err := git.CloneMirror(repo.URL, mirrorPath, &git.CloneOptions{
+				Auth:    auth,
+				Context: mgr.TaskContext(repo.Name),
+				Dirs:    dirs,
+			})
+			result.Duration = time.Since(startTime)
+			if err != nil {
+				result.Success = false
+				result.Error = enhanceFetchError(err)
+				return result, nil
+			}
+			result.Success = true
+			result.Message = "mirrored"
+			return result, nil
+		}
+
+		client := git.NewClient(mirrorPath)
+		client.SetAuth(auth)
+		updated, err := client.FetchMirror()
+		result.Duration = time.Since(startTime)
+		if err != nil {
+			result.Success = false
+			result.Error = enhanceFetchError(err)
+			return result, nil
+		}
+
+		result.Success = true
+		if updated {
+			result.Message = "updated"
+		}
+		return result, nil
+	}
+}
+
+func GetMirrorCmd() *cobra.Command {
+	return mirrorCmd
+}
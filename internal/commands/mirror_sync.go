@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// MirrorSync 플래그 변수
+var (
+	mirrorSyncInterval time.Duration // 동기화 간격
+	mirrorSyncDir      string        // 미러 디렉토리
+	mirrorSyncParallel int           // 병렬 처리 수
+	mirrorSyncOnce     bool          // 한 번만 동기화 후 종료
+)
+
+var mirrorSyncCmd = &cobra.Command{
+	Use:   "mirror-sync",
+	Short: "Maintain bare mirrors of all repositories",
+	Long: `Keep a bare mirror (like 'git clone --mirror') of every configured
+repository in a directory, e.g. as an on-prem read cache of a GitHub
+organization served with 'git daemon' or a web server.
+
+Missing mirrors are cloned, existing ones are fetched with pruning: all
+branches, tags, and other references of the remote are copied, references
+that moved are overwritten, and those deleted on the remote are removed.
+The mirrors are named <repo-name>.git in --dir (default: mirrors/ next to
+the config file) and are independent of the working clones in base_dir.
+
+Runs until interrupted (Ctrl+C or SIGTERM); use --once to sync a single time.
+
+Examples:
+  # Sync every 10 minutes (default)
+  multi-git mirror-sync
+
+  # Mirror the backend repositories into /srv/git every 5 minutes
+  multi-git mirror-sync -g backend --dir /srv/git --interval 5m
+
+  # Single pass, e.g. from cron
+  multi-git mirror-sync --once`,
+	Args: cobra.NoArgs,
+	Run:  runMirrorSync,
+}
+
+func init() {
+	mirrorSyncCmd.Flags().DurationVar(&mirrorSyncInterval, "interval", 10*time.Minute,
+		"Time between syncs")
+	mirrorSyncCmd.Flags().StringVar(&mirrorSyncDir, "dir", "",
+		"Directory of the mirrors (default: mirrors/ next to the config file)")
+	mirrorSyncCmd.Flags().IntVarP(&mirrorSyncParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	mirrorSyncCmd.Flags().BoolVar(&mirrorSyncOnce, "once", false,
+		"Sync once and exit")
+}
+
+func runMirrorSync(cmd *cobra.Command, args []string) {
+	// 1. 동기화 간격 확인
+	if mirrorSyncInterval < minWatchInterval {
+		fmt.Fprintf(os.Stderr, "Error: --interval must be at least %s\n", minWatchInterval)
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+	mgr.SetRepoTimeout(cfg.RepoTimeout)
+
+	workers := mirrorSyncParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+	mgr.Config().ParallelWorkers = workers
+
+	dir := mirrorSyncDir
+	if dir == "" {
+		dir = mgr.StatePath("mirrors")
+	}
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid --dir: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 미러 디렉토리도 클론과 같은 권한 사용
+	dirs, err := git.NewDirPermissions(uint32(cfg.DirMode), cfg.Group)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. 동기화 Task 정의 (없으면 미러 클론, 있으면 fetch --prune)
+	syncTask := mirrorTask(cfg, mgr, dir, dirs)
+
+	// 4. 종료 시그널까지 반복
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !mirrorSyncOnce {
+		fmt.Printf("Mirroring %d repositories into %s every %s\n", mgr.RepositoryCount(), dir, mirrorSyncInterval)
+	}
+	log.Infof("mirror-sync: mirroring %d repositories into %s every %s", mgr.RepositoryCount(), dir, mirrorSyncInterval)
+
+	failed := false
+	for {
+		summary := mgr.Execute(ctx, syncTask, nil)
+		failed = printPollRound("mirror-sync", summary)
+
+		if mirrorSyncOnce {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			fmt.Println("Stopped mirroring")
+			return
+		case <-time.After(mirrorSyncInterval):
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func GetMirrorSyncCmd() *cobra.Command {
+	return mirrorSyncCmd
+}
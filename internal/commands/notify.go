@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// notifyDesktop shows a desktop notification with the counts of the run if
+// --notify-desktop is set and the run took at least --notify-after
+// Failing to notify never fails the command.
+func notifyDesktop(cmd *cobra.Command, summary *repository.Summary) {
+	if enabled, _ := cmd.Root().PersistentFlags().GetBool("notify-desktop"); !enabled {
+		return
+	}
+	if after, _ := cmd.Root().PersistentFlags().GetDuration("notify-after"); summary.TotalDuration < after {
+		return
+	}
+
+	title := fmt.Sprintf("multi-git %s finished", operationName(cmd))
+	if summary.HasFailures() {
+		title = fmt.Sprintf("multi-git %s failed", operationName(cmd))
+	}
+	if err := shell.NotifyDesktop(title, desktopSummary(summary)); err != nil {
+		log.Warnf("desktop notification not shown: %v", err)
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// desktopSummary summarizes the run in one line, e.g. "40 succeeded, 2 failed in 12m5s"
+func desktopSummary(summary *repository.Summary) string {
+	parts := []string{fmt.Sprintf("%d succeeded", summary.SuccessCount)}
+	if summary.FailedCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", summary.FailedCount))
+	}
+	if summary.SkippedCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", summary.SkippedCount))
+	}
+	if summary.CancelledCount > 0 {
+		parts = append(parts, fmt.Sprintf("%d cancelled", summary.CancelledCount))
+	}
+	return fmt.Sprintf("%s in %s", strings.Join(parts, ", "), summary.TotalDuration.Round(time.Second))
+}
@@ -0,0 +1,249 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// Open 플래그 변수
+var (
+	openDirty  bool   // 로컬 변경사항이 있는 저장소만
+	openBranch string // 특정 브랜치에 있는 저장소만
+	openFailed bool   // 마지막 실행에서 실패한 저장소만
+	openEditor string // 사용할 에디터 (기본: $VISUAL, $EDITOR, code)
+	openWeb    bool   // 에디터 대신 브라우저에서 웹 페이지 열기
+	openAll    bool   // 저장소 이름 없이 모든 저장소 열기
+	openDryRun bool   // 열지 않고 대상 목록만 출력
+)
+
+var openCmd = &cobra.Command{
+	Use:   "open [repo-name...]",
+	Short: "Open repositories in your editor or browser",
+	Long: `Open repositories in your editor or IDE, or their web pages in the browser.
+Repositories are selected by name or glob and the filters; without names,
+all repositories matching the filters are opened.
+
+--failed selects the repositories that failed (or timed out) in the last batch
+run of any command, as recorded in reports/ next to the config file.
+
+The editor is taken from --editor, $VISUAL, $EDITOR, or 'code' in that order.
+All matching repositories are passed to a single editor invocation.
+
+With --web, the https page of each repository (derived from its URL, also for
+SSH URLs) is opened in the browser from $BROWSER or the system default, one
+tab per repository. Since that may be many tabs, --web without repository
+names or filters requires --all.
+
+Examples:
+  # Open one repository in the editor
+  multi-git open backend-service
+
+  # Open the web pages of the api repositories
+  multi-git open --web "api-*"
+
+  # Open the web page of every repository
+  multi-git open --web --all
+
+  # Open all repositories with uncommitted changes
+  multi-git open --dirty
+
+  # Open the repositories that failed in the last run
+  multi-git open --failed
+
+  # Open all repositories currently on a branch
+  multi-git open --branch feature/login
+
+  # Use a specific editor
+  multi-git open --dirty --editor "code -n"
+
+  # Only list the repositories that would be opened
+  multi-git open --dirty --dry-run`,
+	ValidArgsFunction: completeRepoNames,
+	Run:               runOpen,
+}
+
+func init() {
+	openCmd.Flags().BoolVar(&openDirty, "dirty", false,
+		"Only open repositories with uncommitted changes")
+	openCmd.Flags().StringVarP(&openBranch, "branch", "b", "",
+		"Only open repositories currently on this branch")
+	_ = openCmd.RegisterFlagCompletionFunc("branch", completeBranchNames)
+	openCmd.Flags().BoolVar(&openFailed, "failed", false,
+		"Only open repositories that failed in the last run")
+	openCmd.Flags().StringVarP(&openEditor, "editor", "e", "",
+		"Editor command (default: $VISUAL, $EDITOR, or 'code')")
+	openCmd.Flags().BoolVarP(&openWeb, "web", "w", false,
+		"Open the web pages of the repositories in the browser instead")
+	openCmd.Flags().BoolVarP(&openAll, "all", "a", false,
+		"Open all repositories matching the filters (required for --web without names)")
+	openCmd.Flags().BoolVar(&openDryRun, "dry-run", false,
+		"List matching repositories without opening them")
+}
+
+func runOpen(cmd *cobra.Command, args []string) {
+	// 1. 플래그 조합 확인
+	filtered := openDirty || openBranch != ""
+	if openAll && len(args) > 0 {
+		fmt.Fprintf(os.Stderr, "Error: --all cannot be combined with repository names\n")
+		os.Exit(1)
+	}
+	if openWeb && openEditor != "" {
+		fmt.Fprintf(os.Stderr, "Error: --editor cannot be used with --web\n")
+		os.Exit(1)
+	}
+	if openWeb && len(args) == 0 && !filtered && !openFailed && !openAll {
+		fmt.Fprintf(os.Stderr, "Error: --web opens a browser tab per repository; name the repositories or use --all\n")
+		fmt.Fprintf(os.Stderr, "  hint: e.g. 'multi-git open --web backend-service' or 'multi-git open --web -g backend --all'\n")
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+
+	repos := mgr.Repositories()
+	if len(args) > 0 {
+		named, err := config.FilterByNames(repos, args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "  hint: run 'multi-git path --list' to see repository names\n")
+			os.Exit(1)
+		}
+		repos = named
+	}
+
+	// --failed: 마지막 실행 보고서에서 실패한 저장소만
+	if openFailed {
+		report, err := loadLastRunReport(mgr)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+		if report == nil {
+			fmt.Fprintf(os.Stderr, "Error: no recorded run to take the failed repositories from\n")
+			fmt.Fprintf(os.Stderr, "  hint: run a batch command (e.g. 'multi-git pull') first\n")
+			os.Exit(1)
+		}
+		fmt.Printf("Last run: %s at %s\n", report.Command, report.FinishedAt.Local().Format("2006-01-02 15:04:05"))
+		failed := make(map[string]bool)
+		for _, name := range report.failedRepositories() {
+			failed[name] = true
+		}
+		var matched []config.Repository
+		for _, repo := range repos {
+			if failed[repo.Name] {
+				matched = append(matched, repo)
+			}
+		}
+		repos = matched
+	}
+
+	// 4. 필터에 맞는 저장소 수집 (웹 페이지는 필터가 없으면 클론 없이도 열 수 있음)
+	var targets []string
+	for _, repo := range repos {
+		if !openWeb || filtered {
+			if !mgr.IsGitRepository(repo) {
+				if len(args) > 0 {
+					reporter.PrintWarning(fmt.Sprintf("%s: not cloned", repo.Name))
+				}
+				continue
+			}
+			matched, err := matchesOpenFilter(git.NewClient(mgr.GetRepositoryPath(repo)))
+			if err != nil {
+				reporter.PrintWarning(fmt.Sprintf("%s: %v", repo.Name, err))
+				continue
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		if !openWeb {
+			targets = append(targets, mgr.GetRepositoryPath(repo))
+			continue
+		}
+		web := repo.WebURL()
+		if web == "" {
+			reporter.PrintWarning(fmt.Sprintf("%s: no web page for URL %s", repo.Name, repo.URL))
+			continue
+		}
+		targets = append(targets, web)
+	}
+
+	if len(targets) == 0 {
+		fmt.Println("No matching repositories.")
+		return
+	}
+
+	// 5. 대상 출력 후 브라우저 또는 에디터 실행
+	if openWeb {
+		openWebPages(reporter, targets)
+		return
+	}
+	editor := shell.ResolveEditor(openEditor)
+	reporter.PrintHeader(fmt.Sprintf("Opening %d repositories with '%s'", len(targets), editor), targets...)
+	if openDryRun {
+		return
+	}
+
+	if err := shell.OpenInEditor(editor, targets); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		fmt.Fprintf(os.Stderr, "  hint: set $EDITOR or use '--editor' to choose another editor\n")
+		os.Exit(1)
+	}
+}
+
+// openWebPages opens each URL in the browser
+func openWebPages(reporter *repository.Reporter, urls []string) {
+	browser := shell.ResolveBrowser()
+	reporter.PrintHeader(fmt.Sprintf("Opening %d web pages with '%s'", len(urls), browser), urls...)
+	if openDryRun {
+		return
+	}
+
+	for _, url := range urls {
+		if err := shell.OpenInBrowser(browser, url); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			fmt.Fprintf(os.Stderr, "  hint: set $BROWSER to choose another browser\n")
+			os.Exit(1)
+		}
+	}
+}
+
+// matchesOpenFilter reports whether the repository satisfies all open filters
+func matchesOpenFilter(client *git.Client) (bool, error) {
+	if openBranch != "" {
+		currentBranch, err := client.GetCurrentBranch()
+		if err != nil {
+			return false, err
+		}
+		if currentBranch != openBranch {
+			return false, nil
+		}
+	}
+
+	if openDirty {
+		hasChanges, err := client.HasLocalChanges()
+		if err != nil {
+			return false, err
+		}
+		if !hasChanges {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func GetOpenCmd() *cobra.Command {
+	return openCmd
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Path 플래그 변수
+var (
+	pathList bool // 저장소 이름 목록 출력
+)
+
+var pathCmd = &cobra.Command{
+	Use:   "path [repo-name]",
+	Short: "Print the absolute path of a repository",
+	Long: `Print the absolute local path of a configured repository.
+Useful for changing into a repository directory from the shell.
+
+Examples:
+  # Change into a repository directory
+  cd $(multi-git path backend-service)
+
+  # List all configured repository names
+  multi-git path --list`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeRepoNames,
+	Run:               runPath,
+}
+
+var shellInitCmd = &cobra.Command{
+	Use:   "shell-init [bash|zsh]",
+	Short: "Print shell integration (mgcd function with completion)",
+	Long: `Print a shell function 'mgcd' that changes into a repository directory,
+along with completion over configured repository names.
+
+Examples:
+  # bash (~/.bashrc)
+  eval "$(multi-git shell-init bash)"
+
+  # zsh (~/.zshrc)
+  eval "$(multi-git shell-init zsh)"
+
+  # Then
+  mgcd backend-service`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"bash", "zsh"},
+	Run:       runShellInit,
+}
+
+func init() {
+	pathCmd.Flags().BoolVarP(&pathList, "list", "l", false,
+		"List all repository names")
+}
+
+func runPath(cmd *cobra.Command, args []string) {
+	// 1. 설정 파일 로드
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+
+	// 2. 목록 모드
+	if pathList {
+		for _, name := range mgr.RepositoryNames() {
+			fmt.Println(name)
+		}
+		return
+	}
+
+	if len(args) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: repository name is required\n")
+		fmt.Fprintf(os.Stderr, "  hint: use '--list' to see configured repositories\n")
+		os.Exit(1)
+	}
+
+	// 3. 저장소 조회
+	repo, ok := mgr.FindRepository(args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: repository '%s' not found in config\n", args[0])
+		fmt.Fprintf(os.Stderr, "  hint: use '--list' to see configured repositories\n")
+		os.Exit(1)
+	}
+
+	fmt.Println(mgr.GetRepositoryPath(repo))
+}
+
+func runShellInit(cmd *cobra.Command, args []string) {
+	switch args[0] {
+	case "bash":
+		fmt.Print(bashShellInit)
+	case "zsh":
+		fmt.Print(zshShellInit)
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unsupported shell '%s' (supported: bash, zsh)\n", args[0])
+		os.Exit(1)
+	}
+}
+
+// completeRepoNames provides shell completion over configured repository names
+func completeRepoNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	cfg := completionConfig(cmd)
+	if cfg == nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var names []string
+	for _, repo := range cfg.Repositories {
+		if strings.HasPrefix(repo.Name, toComplete) {
+			names = append(names, repo.Name)
+		}
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+const bashShellInit = `mgcd() {
+  local dir
+  dir="$(multi-git path "$@")" || return
+  cd "$dir" || return
+}
+
+_mgcd_complete() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  COMPREPLY=($(compgen -W "$(multi-git path --list 2>/dev/null)" -- "$cur"))
+}
+complete -F _mgcd_complete mgcd
+`
+
+const zshShellInit = `mgcd() {
+  local dir
+  dir="$(multi-git path "$@")" || return
+  cd "$dir" || return
+}
+
+_mgcd_complete() {
+  compadd -- ${(f)"$(multi-git path --list 2>/dev/null)"}
+}
+compdef _mgcd_complete mgcd
+`
+
+func GetPathCmd() *cobra.Command {
+	return pathCmd
+}
+
+func GetShellInitCmd() *cobra.Command {
+	return shellInitCmd
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// resolvePin returns the commit a repository's pin names
+// If the clone does not have it and fetch is set, branches and tags are fetched
+// from the remote first (a tag created after the clone, or a newer commit).
+func resolvePin(client *git.Client, repo config.Repository, remoteName string, fetch bool) (*object.Commit, error) {
+	commit, err := client.GetCommitAtRevision(repo.Pin)
+	if err == nil || !errors.Is(err, git.ErrRevisionNotFound) || !fetch {
+		return commit, err
+	}
+
+	if err := client.FetchWithOptions(&git.FetchOptions{Remote: remoteName, Tags: true}); err != nil {
+		return nil, fmt.Errorf("pin '%s' not in the clone and fetch failed: %w", repo.Pin, err)
+	}
+	commit, err = client.GetCommitAtRevision(repo.Pin)
+	if errors.Is(err, git.ErrRevisionNotFound) {
+		return nil, fmt.Errorf("pin '%s' not found in the clone or on '%s'\n  hint: check the pin in the config; a commit must be reachable from a branch or tag, and shallow clones (--depth) may not contain it", repo.Pin, remoteName)
+	}
+	return commit, err
+}
+
+// describePin names the pinned revision, e.g. "v1.4.2 (3f2c9e1)" or "3f2c9e1"
+func describePin(pin string, commit *object.Commit) string {
+	short := commit.Hash.String()[:7]
+	if strings.HasPrefix(commit.Hash.String(), pin) {
+		return short
+	}
+	return fmt.Sprintf("%s (%s)", pin, short)
+}
+
+// checkoutPin checks out the pinned revision as a detached HEAD
+// Returns a short description of what happened.
+func checkoutPin(client *git.Client, repo config.Repository, remoteName string) (string, error) {
+	commit, err := resolvePin(client, repo, remoteName, true)
+	if err != nil {
+		return "", err
+	}
+	drift, err := pinDrift(client, repo, commit)
+	if err != nil {
+		return "", err
+	}
+	if drift == "" {
+		return "pinned at " + describePin(repo.Pin, commit), nil
+	}
+	if err := client.CheckoutCommit(commit.Hash.String(), false); err != nil {
+		return "", fmt.Errorf("failed to check out pin '%s': %w", repo.Pin, err)
+	}
+	return "checked out pin " + describePin(repo.Pin, commit), nil
+}
+
+// pinDrift describes how the checkout differs from the pinned commit (HEAD on a
+// branch or at another commit), or returns "" if HEAD is detached at the pin
+func pinDrift(client *git.Client, repo config.Repository, pinned *object.Commit) (string, error) {
+	head, err := client.GetCommitAtRevision("HEAD")
+	if err != nil {
+		return "", err
+	}
+	branch, err := client.GetCurrentBranch()
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case head.Hash != pinned.Hash && branch != "":
+		return fmt.Sprintf("on branch '%s' at %s instead of pin %s", branch, head.Hash.String()[:7], describePin(repo.Pin, pinned)), nil
+	case head.Hash != pinned.Hash:
+		return fmt.Sprintf("at %s instead of pin %s", head.Hash.String()[:7], describePin(repo.Pin, pinned)), nil
+	case branch != "":
+		return fmt.Sprintf("on branch '%s' instead of a detached pin %s", branch, describePin(repo.Pin, pinned)), nil
+	}
+	return "", nil
+}
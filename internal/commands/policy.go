@@ -0,0 +1,229 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/policy"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Policy 플래그 변수
+var (
+	policyDryRun         bool // 시뮬레이션 모드
+	policyAllowProtected bool // 보호 경로 수정 허용
+	policyParallel       int  // 병렬 처리 수
+)
+
+var policyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Manage fleet-wide file policies",
+	Long: `Manage files that must be kept consistent across all repositories,
+such as .gitignore fragments, .editorconfig, or CODEOWNERS templates.
+
+Managed files are declared in the 'policy' section of the config file:
+
+  policy:
+    files:
+      - path: .gitignore
+        source: policy/gitignore   # relative to the config file
+      - path: .editorconfig
+        source: policy/editorconfig
+        mode: replace
+      - path: CODEOWNERS
+        content: "* @org/platform"
+
+In 'block' mode (default), the policy content is kept between
+'# BEGIN multi-git policy' and '# END multi-git policy' markers and the
+rest of the file is left untouched. In 'replace' mode, the whole file is
+managed.`,
+}
+
+var policySyncCmd = &cobra.Command{
+	Use:   "sync-files",
+	Short: "Write policy files into all repositories",
+	Long: `Write the policy files into all repositories. The operation is idempotent:
+files that already match the policy are not modified.
+
+Examples:
+  # Sync policy files
+  multi-git policy sync-files
+
+  # Show which files would change
+  multi-git policy sync-files --dry-run`,
+	Run: runPolicySync,
+}
+
+var policyCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Detect drift from the file policy",
+	Long: `Check every repository for files that differ from the policy.
+Exits with code 1 if any repository has drifted.
+
+Examples:
+  multi-git policy check`,
+	Run: runPolicyCheck,
+}
+
+func init() {
+	policySyncCmd.Flags().BoolVar(&policyDryRun, "dry-run", false,
+		"Show which files would change without writing them")
+	policySyncCmd.Flags().BoolVar(&policyAllowProtected, "allow-protected", false,
+		"Allow writing policy files into protected paths")
+	policyCmd.PersistentFlags().IntVarP(&policyParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	policyCmd.AddCommand(policySyncCmd)
+	policyCmd.AddCommand(policyCheckCmd)
+}
+
+func runPolicySync(cmd *cobra.Command, args []string) {
+	cfg, mgr, reporter := preparePolicy(cmd)
+
+	headerMsg := fmt.Sprintf("Syncing %d policy files", len(cfg.Policy.Files))
+	if policyDryRun {
+		headerMsg += " (dry-run)"
+	}
+	reporter.PrintHeader(headerMsg)
+
+	syncTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.RepositoryExists(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not found: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// 보호 경로에 해당하는 정책 파일은 명시적 허용 없이는 쓰지 않음
+		if !policyAllowProtected {
+			protected := cfg.ProtectedPathsFor(repo)
+			for _, file := range cfg.Policy.Files {
+				if guard.MatchAny(protected, file.Path) {
+					result.Success = false
+					result.Error = fmt.Errorf("policy file '%s' is in a protected path\n  hint: use '--allow-protected' to write it anyway", file.Path)
+					result.Duration = time.Since(startTime)
+					return result, nil
+				}
+			}
+		}
+
+		changed, err := policy.Sync(repoPath, cfg.Policy.Files, policyDryRun)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		result.Success = true
+		if len(changed) == 0 {
+			result.Skip("already in sync")
+			return result, nil
+		}
+
+		if policyDryRun {
+			result.Message = fmt.Sprintf("would update: %s", strings.Join(changed, ", "))
+		} else {
+			result.Message = fmt.Sprintf("updated: %s", strings.Join(changed, ", "))
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	summary := executePolicy(cmd, mgr, reporter, syncTask)
+	reporter.PrintFullReport(summary)
+
+	exitOnFailures(cmd, summary)
+}
+
+func runPolicyCheck(cmd *cobra.Command, args []string) {
+	cfg, mgr, reporter := preparePolicy(cmd)
+
+	reporter.PrintHeader(fmt.Sprintf("Checking %d policy files", len(cfg.Policy.Files)))
+
+	checkTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.RepositoryExists(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not found: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		statuses, err := policy.Check(repoPath, cfg.Policy.Files)
+		result.Duration = time.Since(startTime)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			return result, nil
+		}
+
+		var drifted []string
+		for _, status := range statuses {
+			if status.Missing {
+				drifted = append(drifted, status.Path+" (missing)")
+			} else if status.Drifted {
+				drifted = append(drifted, status.Path)
+			}
+		}
+
+		if len(drifted) > 0 {
+			result.Success = false
+			result.Error = fmt.Errorf("drift detected: %s\n  hint: run 'multi-git policy sync-files' to fix", strings.Join(drifted, ", "))
+			return result, nil
+		}
+
+		result.Success = true
+		result.Message = "in sync"
+		return result, nil
+	}
+
+	summary := executePolicy(cmd, mgr, reporter, checkTask)
+	reporter.PrintFullReport(summary)
+
+	exitOnFailures(cmd, summary)
+}
+
+// preparePolicy loads the config and ensures at least one policy file is defined
+func preparePolicy(cmd *cobra.Command) (*config.Config, *repository.Manager, *repository.Reporter) {
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+	cfg := loadConfig(cmd)
+
+	if len(cfg.Policy.Files) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no policy files defined\n")
+		fmt.Fprintf(os.Stderr, "  hint: add a 'policy.files' section to your config\n")
+		os.Exit(1)
+	}
+
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	return cfg, mgr, reporter
+}
+
+// executePolicy runs the policy task with the configured parallelism
+func executePolicy(cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter, task repository.TaskFunc) *repository.Summary {
+	workers := policyParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	return executeTasks(context.Background(), cmd, mgr, reporter, workers, task)
+}
+
+func GetPolicyCmd() *cobra.Command {
+	return policyCmd
+}
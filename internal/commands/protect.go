@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+)
+
+// protectedBranchTargets returns "repo (branch)" for each repository whose branch
+// (after resolving @default) matches protected_branches. Repositories whose branch
+// cannot be resolved are left to the task to report.
+func protectedBranchTargets(cfg *config.Config, branchName string) []string {
+	var targets []string
+	for _, repo := range cfg.Repositories {
+		branch, err := repo.ResolveBranch(branchName)
+		if err != nil {
+			continue
+		}
+		if cfg.IsProtectedBranch(repo, branch) {
+			targets = append(targets, fmt.Sprintf("%s (%s)", repo.Name, branch))
+		}
+	}
+	return targets
+}
+
+// protectedManifestTargets returns the repositories in which the branch a checkout
+// manifest maps them to matches protected_branches
+func protectedManifestTargets(cfg *config.Config, manifest map[string]string) []string {
+	var targets []string
+	for _, repo := range cfg.Repositories {
+		ref, ok := manifest[repo.Name]
+		if !ok {
+			continue
+		}
+		branch, err := repo.ResolveBranch(ref)
+		if err != nil {
+			continue
+		}
+		if cfg.IsProtectedBranch(repo, branch) {
+			targets = append(targets, fmt.Sprintf("%s (%s)", repo.Name, branch))
+		}
+	}
+	return targets
+}
+
+// protectedTagTargets returns the repositories in which the tag matches protected_tags
+func protectedTagTargets(cfg *config.Config, tag string) []string {
+	var targets []string
+	for _, repo := range cfg.Repositories {
+		if cfg.IsProtectedTag(repo, tag) {
+			targets = append(targets, repo.Name)
+		}
+	}
+	return targets
+}
+
+// exitOnProtected refuses the whole operation before any repository is touched
+// if it targets protected branches or tags (e.g. "force push protected branches")
+func exitOnProtected(action, field string, targets []string) {
+	if len(targets) == 0 {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Error: refusing to %s in %s: %s\n", action, plural(len(targets), "repository"), strings.Join(targets, ", "))
+	fmt.Fprintf(os.Stderr, "  hint: they match '%s' in the config; use '--override-protection' if this is intended\n", field)
+	os.Exit(1)
+}
@@ -3,14 +3,12 @@ package commands
 import (
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/alexgim961101/multi-git/internal/config"
 	"github.com/alexgim961101/multi-git/internal/git"
 	"github.com/alexgim961101/multi-git/internal/repository"
-	"github.com/schollz/progressbar/v3"
 	"github.com/spf13/cobra"
 )
 
@@ -19,6 +17,9 @@ var (
 	pullRemote   string // 원격 이름
 	pullForce    bool   // 강제 풀
 	pullParallel int    // 병렬 처리 수
+	pullResolve  bool   // 충돌한 저장소를 대화형으로 해결
+	pullFailFast bool   // 실패 시 중단
+	pullStream   bool   // 진행 상황을 저장소 접두사와 함께 실시간 출력
 )
 
 var pullCmd = &cobra.Command{
@@ -35,7 +36,13 @@ Examples:
   multi-git pull --remote upstream
 
   # Force pull (discard local changes)
-  multi-git pull --force`,
+  multi-git pull --force
+
+  # Open a shell in each repository that could not be pulled, then retry it
+  multi-git pull --resolve
+
+  # Follow the fetch progress of every repository live
+  multi-git pull --stream`,
 	Run: runPull,
 }
 
@@ -44,26 +51,28 @@ func init() {
 		"Remote name to pull from")
 	pullCmd.Flags().BoolVarP(&pullForce, "force", "f", false,
 		"Force pull (discard local changes)")
+	pullCmd.Flags().BoolVar(&pullResolve, "resolve", false,
+		"Interactively resolve repositories that failed with conflicts or local changes, then retry them")
 	pullCmd.Flags().IntVarP(&pullParallel, "parallel", "p", 0,
 		"Number of parallel operations (0 = use config value)")
+	pullCmd.Flags().BoolVar(&pullFailFast, "fail-fast", false,
+		"Stop on first failure")
+	pullCmd.Flags().BoolVar(&pullStream, "stream", false,
+		"Print pull progress as it happens, each line prefixed with [repo-name]")
 }
 
 func runPull(cmd *cobra.Command, args []string) {
 	// 1. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
 	// 2. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
-		os.Exit(1)
-	}
+	cfg := loadConfig(cmd)
 
 	// 3. Manager와 Reporter 생성
 	mgr := repository.NewManager(cfg)
 	reporter := repository.NewReporter()
 	reporter.SetVerbose(verbose)
+	reporter.SetStream(pullStream)
 
 	// 4. 병렬 수 결정
 	workers := pullParallel
@@ -72,7 +81,7 @@ func runPull(cmd *cobra.Command, args []string) {
 	}
 
 	// 5. Pull Task 정의
-	pullTask := func(repo config.Repository) repository.Result {
+	pullTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{
 			RepoName: repo.Name,
 		}
@@ -84,30 +93,36 @@ func runPull(cmd *cobra.Command, args []string) {
 			result.Success = false
 			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
 		// Git Client 생성
-		client := git.NewClient(repoPath)
+		client := newGitClient(cfg, repo)
 
 		// Pull 옵션 설정
 		pullOpts := &git.PullOptions{
-			Remote: pullRemote,
-			Force:  pullForce,
+			Remote:   pullRemote,
+			Force:    pullForce,
+			Progress: streamWriter(reporter, repo),
+			Context:  mgr.TaskContext(repo.Name), // 실행 중 개별 취소
 		}
 
 		// Pull 실행
-		err := client.Pull(pullOpts)
+		commits, files, err := client.PullWithStats(pullOpts)
 		result.Duration = time.Since(startTime)
 
 		if err != nil {
 			result.Success = false
 			result.Error = enhancePullError(err)
-			return result
+			return result, nil
 		}
 
 		result.Success = true
-		return result
+		if commits > 0 {
+			result.SetDetail("commits", commits)
+			result.SetDetail("files_changed", files)
+		}
+		return result, nil
 	}
 
 	// 6. 작업 실행
@@ -116,37 +131,24 @@ func runPull(cmd *cobra.Command, args []string) {
 	ctx := context.Background()
 	var summary *repository.Summary
 
-	// Progress Bar 설정
-	bar := progressbar.NewOptions64(
-		int64(len(cfg.Repositories)),
-		progressbar.OptionSetDescription("Pulling..."),
-		progressbar.OptionSetWriter(os.Stderr),
-		progressbar.OptionShowCount(),
-		progressbar.OptionSetWidth(10),
-		progressbar.OptionThrottle(65*time.Millisecond),
-		progressbar.OptionSpinnerType(14),
-		progressbar.OptionFullWidth(),
-	)
-
-	onProgress := func() {
-		_ = bar.Add(1)
-	}
-
-	if workers > 1 {
-		// 임시로 ParallelWorkers 설정을 위해 config 수정
-		cfg.ParallelWorkers = workers
-		summary = mgr.ExecuteParallel(ctx, pullTask, onProgress)
-	} else {
-		summary = mgr.ExecuteSequential(ctx, pullTask, onProgress)
-	}
+	summary = executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "pull", "", pullTask))
 
 	// 7. 결과 출력
 	reporter.PrintFullReport(summary)
 
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
+	// 8. 충돌 해결 모드
+	if pullResolve && summary.HasFailures() {
+		if !stdinIsTerminal() {
+			reporter.PrintWarning("--resolve requires a terminal on stdin, skipping")
+		} else if resolved := resolveInteractively(mgr, summary, pullTask); resolved != summary {
+			summary = resolved
+			reporter.PrintHeader("Results after resolution")
+			reporter.PrintFullReport(summary)
+		}
 	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
 }
 
 func GetPullCmd() *cobra.Command {
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/provider"
+)
+
+// pullRequestHost is a hosting service whose API can open pull requests
+type pullRequestHost struct {
+	name     string // 서비스 이름 (메시지용)
+	tokenEnv string // 토큰을 담은 환경 변수
+	baseURL  string // API 주소 (비어있으면 github.com / gitlab.com)
+	fullName string // 저장소 경로 (예: org/api, group/sub/api)
+	gitlab   bool   // GitLab (merge request)
+}
+
+// pullRequestHostOf returns the service hosting the repository: the one configured
+// for its host under config.providers, or else GitLab if the host name contains
+// "gitlab" and GitHub (or GitHub Enterprise) if it contains "github"
+// Returns false for other hosts.
+func pullRequestHostOf(cfg *config.Config, repo config.Repository) (pullRequestHost, bool) {
+	u, err := url.Parse(repo.WebURL())
+	if err != nil || u.Host == "" {
+		return pullRequestHost{}, false
+	}
+	fullName := strings.Trim(u.Path, "/")
+
+	service, ok := cfg.ProviderFor(u.Hostname())
+	if !ok {
+		switch {
+		case strings.Contains(u.Host, "gitlab"):
+			service.Type = config.ProviderGitLab
+		case strings.Contains(u.Host, "github"):
+			service.Type = config.ProviderGitHub
+		}
+	}
+
+	switch service.Type {
+	case config.ProviderGitLab:
+		host := pullRequestHost{name: "GitLab", tokenEnv: "GITLAB_TOKEN", baseURL: service.APIURL, fullName: fullName, gitlab: true}
+		if host.baseURL == "" && u.Host != "gitlab.com" {
+			host.baseURL = "https://" + u.Host
+		}
+		return host, true
+	case config.ProviderGitHub:
+		host := pullRequestHost{name: "GitHub", tokenEnv: "GITHUB_TOKEN", baseURL: service.APIURL, fullName: fullName}
+		if host.baseURL == "" && u.Host != "github.com" {
+			host.baseURL = "https://" + u.Host + "/api/v3"
+		}
+		return host, true
+	}
+	return pullRequestHost{}, false
+}
+
+// pullRequestToken returns the API token for the repository: the HTTPS token of its
+// auth settings (auth.token or auth.token_env), or else the token in the environment
+// variable of its host ($GITHUB_TOKEN, $GITLAB_TOKEN)
+func pullRequestToken(cfg *config.Config, repo config.Repository, host pullRequestHost) string {
+	if auth := credentials.GitAuth(cfg, repo); auth != nil && auth.Password != "" {
+		return auth.Password
+	}
+	return os.Getenv(host.tokenEnv)
+}
+
+// checkPullRequestTokens verifies before any repository runs that pull requests can
+// be opened for all of them, so branches are not pushed only to fail afterwards
+func checkPullRequestTokens(cfg *config.Config, repos []config.Repository) error {
+	for _, repo := range repos {
+		host, ok := pullRequestHostOf(cfg, repo)
+		if !ok {
+			return fmt.Errorf("cannot open pull requests for %s: %s is not hosted on GitHub or GitLab\n  hint: set the type of its host under config.providers, or use '--push' and open the pull request by hand",
+				repo.Name, repo.URL)
+		}
+		if pullRequestToken(cfg, repo, host) == "" {
+			return fmt.Errorf("opening %s pull requests needs a token (%s)\n  hint: set the repository's auth.token_env or $%s to a token that can create pull requests",
+				host.name, repo.Name, host.tokenEnv)
+		}
+	}
+	return nil
+}
+
+// openPullRequest opens a pull request (GitLab: merge request) for the repository
+// through the API of its host, with the token from pullRequestToken
+// Returns the web URL of the pull request.
+func openPullRequest(ctx context.Context, cfg *config.Config, repo config.Repository, pr provider.PullRequest) (string, error) {
+	host, ok := pullRequestHostOf(cfg, repo)
+	if !ok {
+		return "", fmt.Errorf("%s is not hosted on GitHub or GitLab", repo.URL)
+	}
+	opts := provider.Options{BaseURL: host.baseURL, Token: pullRequestToken(cfg, repo, host)}
+	if host.gitlab {
+		return provider.CreateGitLabMergeRequest(ctx, host.fullName, pr, opts)
+	}
+	return provider.CreateGitHubPullRequest(ctx, host.fullName, pr, opts)
+}
+
+// pullRequestURL returns the web link to open a pull request from head into base
+// Returns "" if the repository URL has no recognizable web page
+func pullRequestURL(cfg *config.Config, repo config.Repository, base, head string) string {
+	web := repo.WebURL()
+	if web == "" {
+		return ""
+	}
+
+	host, _ := pullRequestHostOf(cfg, repo)
+	switch {
+	case host.gitlab:
+		return fmt.Sprintf("%s/-/merge_requests/new?merge_request[source_branch]=%s&merge_request[target_branch]=%s",
+			web, url.QueryEscape(head), url.QueryEscape(base))
+	case strings.Contains(web, "bitbucket"):
+		return fmt.Sprintf("%s/pull-requests/new?source=%s&dest=%s", web, url.QueryEscape(head), url.QueryEscape(base))
+	default:
+		// GitHub 및 호환 서비스
+		return fmt.Sprintf("%s/compare/%s...%s?expand=1", web, base, head)
+	}
+}
@@ -0,0 +1,147 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+)
+
+func TestPullRequestHostOf(t *testing.T) {
+	cfg := &config.Config{
+		Providers: map[string]config.ProviderConfig{
+			"git.corp.example":  {Type: config.ProviderGitLab},
+			"code.corp.example": {Type: config.ProviderGitHub, APIURL: "https://api.code.corp.example"},
+			"Hub.Corp.Example":  {Type: config.ProviderGitHub},
+			"gitlab.corp.io":    {Type: config.ProviderGitHub},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		url         string
+		wantOK      bool
+		wantGitLab  bool
+		wantBaseURL string
+		wantName    string
+	}{
+		{name: "github.com", url: "https://github.com/org/api.git", wantOK: true, wantName: "org/api"},
+		{name: "github.com over ssh", url: "git@github.com:org/api.git", wantOK: true, wantName: "org/api"},
+		{name: "gitlab.com", url: "https://gitlab.com/group/api.git", wantOK: true, wantGitLab: true, wantName: "group/api"},
+		{name: "enterprise recognized by name", url: "https://github.corp.example/org/api.git", wantOK: true,
+			wantBaseURL: "https://github.corp.example/api/v3", wantName: "org/api"},
+		{name: "self-hosted GitLab recognized by name", url: "git@gitlab.example.com:group/api.git", wantOK: true, wantGitLab: true,
+			wantBaseURL: "https://gitlab.example.com", wantName: "group/api"},
+		{name: "configured GitLab", url: "git@git.corp.example:group/api.git", wantOK: true, wantGitLab: true,
+			wantBaseURL: "https://git.corp.example", wantName: "group/api"},
+		{name: "configured GitHub with API URL", url: "https://code.corp.example/org/api.git", wantOK: true,
+			wantBaseURL: "https://api.code.corp.example", wantName: "org/api"},
+		{name: "configured host in another case", url: "https://hub.corp.example/org/api.git", wantOK: true,
+			wantBaseURL: "https://hub.corp.example/api/v3", wantName: "org/api"},
+		{name: "configuration overrides the host name", url: "https://gitlab.corp.io/org/api.git", wantOK: true,
+			wantBaseURL: "https://gitlab.corp.io/api/v3", wantName: "org/api"},
+		{name: "unknown host", url: "https://git.other.example/org/api.git"},
+		{name: "no owner path", url: "https://github.com/api.git"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, ok := pullRequestHostOf(cfg, config.Repository{Name: "api", URL: tt.url})
+			if ok != tt.wantOK {
+				t.Fatalf("pullRequestHostOf(%s) ok = %v, want %v", tt.url, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if host.gitlab != tt.wantGitLab {
+				t.Errorf("gitlab = %v, want %v", host.gitlab, tt.wantGitLab)
+			}
+			if host.baseURL != tt.wantBaseURL {
+				t.Errorf("baseURL = %q, want %q", host.baseURL, tt.wantBaseURL)
+			}
+			if host.fullName != tt.wantName {
+				t.Errorf("fullName = %q, want %q", host.fullName, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestPullRequestToken(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "from-github-env")
+	t.Setenv("GITLAB_TOKEN", "")
+	t.Setenv("CORP_TOKEN", "from-repo-env")
+
+	tests := []struct {
+		name   string
+		global config.AuthConfig
+		repo   config.Repository
+		want   string
+	}{
+		{
+			name: "host environment variable",
+			repo: config.Repository{Name: "api", URL: "https://github.com/org/api.git"},
+			want: "from-github-env",
+		},
+		{
+			name: "repository token",
+			repo: config.Repository{Name: "api", URL: "https://github.com/org/api.git", Auth: &config.AuthConfig{Token: "from-repo"}},
+			want: "from-repo",
+		},
+		{
+			name: "repository token_env",
+			repo: config.Repository{Name: "api", URL: "https://gitlab.com/group/api.git", Auth: &config.AuthConfig{TokenEnv: "CORP_TOKEN"}},
+			want: "from-repo-env",
+		},
+		{
+			name:   "global token_env",
+			global: config.AuthConfig{TokenEnv: "CORP_TOKEN"},
+			repo:   config.Repository{Name: "api", URL: "https://github.com/org/api.git"},
+			want:   "from-repo-env",
+		},
+		{
+			name: "SSH-only auth falls back to the host environment variable",
+			repo: config.Repository{Name: "api", URL: "git@github.com:org/api.git", Auth: &config.AuthConfig{SSHKey: "~/.ssh/id_ed25519"}},
+			want: "from-github-env",
+		},
+		{
+			name: "no token",
+			repo: config.Repository{Name: "api", URL: "https://gitlab.com/group/api.git"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Auth: tt.global}
+			host, ok := pullRequestHostOf(cfg, tt.repo)
+			if !ok {
+				t.Fatalf("pullRequestHostOf(%s) failed", tt.repo.URL)
+			}
+			if got := pullRequestToken(cfg, tt.repo, host); got != tt.want {
+				t.Errorf("pullRequestToken() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckPullRequestTokens(t *testing.T) {
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("CORP_TOKEN", "secret")
+
+	cfg := &config.Config{
+		Providers: map[string]config.ProviderConfig{"git.corp.example": {Type: config.ProviderGitLab}},
+	}
+	configured := config.Repository{Name: "api", URL: "https://git.corp.example/group/api.git", Auth: &config.AuthConfig{TokenEnv: "CORP_TOKEN"}}
+	if err := checkPullRequestTokens(cfg, []config.Repository{configured}); err != nil {
+		t.Errorf("unexpected error for a token in the repository auth: %v", err)
+	}
+
+	missing := config.Repository{Name: "web", URL: "https://github.com/org/web.git"}
+	if err := checkPullRequestTokens(cfg, []config.Repository{configured, missing}); err == nil || !strings.Contains(err.Error(), "GITHUB_TOKEN") {
+		t.Errorf("expected a missing GITHUB_TOKEN error, got %v", err)
+	}
+
+	unknown := config.Repository{Name: "tool", URL: "https://git.other.example/org/tool.git"}
+	if err := checkPullRequestTokens(cfg, []config.Repository{unknown}); err == nil || !strings.Contains(err.Error(), "config.providers") {
+		t.Errorf("expected an unsupported host error, got %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -16,23 +17,40 @@ import (
 
 // Push 플래그 변수
 var (
-	pushBranch   string // 브랜치 이름 (필수)
-	pushForce    bool   // 강제 푸시 (필수)
-	pushRemote   string // 원격 이름
-	pushDryRun   bool   // 시뮬레이션 모드
-	pushYes      bool   // 확인 스킵
-	pushParallel int    // 병렬 처리 수
+	pushBranch      string // 브랜치 이름 (없으면 저장소별 현재 브랜치)
+	pushForce       bool   // 강제 푸시 (--branch 필요)
+	pushRemote      string // 원격 이름
+	pushSetUpstream bool   // 푸시 후 원격 브랜치를 upstream으로 설정
+	pushDryRun      bool   // 시뮬레이션 모드
+	pushYes         bool   // 확인 스킵
+	pushParallel    int    // 병렬 처리 수
+	pushFailFast    bool   // 실패 시 중단
+	pushOverride    bool   // 보호 브랜치 강제 푸시 허용
 )
 
 var pushCmd = &cobra.Command{
 	Use:   "push",
-	Short: "Force push branch to remote repositories",
-	Long: `Force push a branch to remote repositories.
-This command requires --force flag and --branch flag for safety.
+	Short: "Push branches to remote repositories",
+	Long: `Push a branch to the remote of every repository.
+
+Without --branch, each repository pushes its current branch. A push is rejected
+if the remote branch has commits the local branch does not have; pull first
+and push again.
+
+With --force, the remote branch is overwritten. Force pushing requires --branch
+and asks for confirmation. Force pushes to remote branches matching
+'protected_branches' in the config are refused in every repository unless
+--override-protection is given.
 
 Branch format supports "local:remote" syntax to push local branch to different remote branch name.
 
 Examples:
+  # Push the current branch of every repository
+  multi-git push
+
+  # Push a new branch and track it
+  multi-git push -b feature/login --set-upstream
+
   # Force push a branch (with confirmation prompt)
   multi-git push --branch release/v1.0.0 --force
 
@@ -46,44 +64,49 @@ Examples:
   multi-git push -b release/v1.0.0 -f --dry-run
 
   # Push to different remote
-  multi-git push -b release/v1.0.0 -f -r upstream`,
+  multi-git push -b release/v1.0.0 -f -r upstream
+
+  # Force push a branch listed in protected_branches (e.g. main)
+  multi-git push -b main -f --override-protection`,
 	Run: runPush,
 }
 
 func init() {
-	// 필수 플래그
 	pushCmd.Flags().StringVarP(&pushBranch, "branch", "b", "",
-		"Branch to push (required). Use 'local:remote' format to push local branch to different remote branch name")
+		"Branch to push (default: current branch). Use 'local:remote' format to push local branch to different remote branch name")
+	_ = pushCmd.RegisterFlagCompletionFunc("branch", completeBranchNames)
 	pushCmd.Flags().BoolVarP(&pushForce, "force", "f", false,
-		"Force push (required, safety measure)")
-
-	// 선택 플래그
+		"Force push, overwriting the remote branch (requires --branch)")
 	pushCmd.Flags().StringVarP(&pushRemote, "remote", "r", "origin",
 		"Remote name")
+	pushCmd.Flags().BoolVarP(&pushSetUpstream, "set-upstream", "u", false,
+		"Track the pushed remote branch (like 'git push -u')")
 	pushCmd.Flags().BoolVar(&pushDryRun, "dry-run", false,
 		"Simulate push without actually pushing")
 	pushCmd.Flags().BoolVarP(&pushYes, "yes", "y", false,
 		"Skip confirmation prompt")
 	pushCmd.Flags().IntVar(&pushParallel, "parallel", 0,
 		"Number of parallel operations (0 = use config value)")
-
-	// 필수 플래그 설정
-	pushCmd.MarkFlagRequired("branch")
-	pushCmd.MarkFlagRequired("force")
+	pushCmd.Flags().BoolVar(&pushFailFast, "fail-fast", false,
+		"Stop on first failure")
+	pushCmd.Flags().BoolVar(&pushOverride, "override-protection", false,
+		"Allow force pushing branches listed in protected_branches")
 }
 
 func runPush(cmd *cobra.Command, args []string) {
 	// 1. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
-	// 2. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
+	// 안전장치: 강제 푸시는 브랜치를 명시해야 함
+	if pushForce && pushBranch == "" {
+		fmt.Fprintf(os.Stderr, "Error: --force requires --branch\n")
+		fmt.Fprintf(os.Stderr, "  hint: name the branch to overwrite, e.g. 'multi-git push -b release/v1.0.0 --force'\n")
 		os.Exit(1)
 	}
 
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
 	// 3. Manager와 Reporter 생성
 	mgr := repository.NewManager(cfg)
 	reporter := repository.NewReporter()
@@ -95,11 +118,16 @@ func runPush(cmd *cobra.Command, args []string) {
 		workers = mgr.ParallelWorkers()
 	}
 
-	// 5. 브랜치 이름 파싱 (local:remote 형식 지원)
+	// 5. 브랜치 이름 파싱 (local:remote 형식 지원, 비어있으면 저장소별 현재 브랜치)
 	localBranch, remoteBranch := parseBranchSpec(pushBranch)
 
-	// 6. 안전장치: 확인 프롬프트 (--yes가 아니고, --dry-run이 아닐 때)
-	if !pushYes && !pushDryRun {
+	// 보호 브랜치 확인 (어느 저장소도 푸시하기 전에 거부)
+	if pushForce && !pushOverride {
+		exitOnProtected("force push protected branches", "protected_branches", protectedBranchTargets(cfg, remoteBranch))
+	}
+
+	// 6. 안전장치: 강제 푸시 확인 프롬프트 (--yes가 아니고, --dry-run이 아닐 때)
+	if pushForce && !pushYes && !pushDryRun {
 		if !confirmForcePush(mgr.RepositoryCount(), localBranch, remoteBranch) {
 			fmt.Println("Cancelled.")
 			os.Exit(0)
@@ -107,7 +135,13 @@ func runPush(cmd *cobra.Command, args []string) {
 	}
 
 	// 7. 헤더 출력
-	headerMsg := fmt.Sprintf("Force pushing branch '%s'", localBranch)
+	headerMsg := "Pushing current branches"
+	switch {
+	case pushForce:
+		headerMsg = fmt.Sprintf("Force pushing branch '%s'", localBranch)
+	case localBranch != "":
+		headerMsg = fmt.Sprintf("Pushing branch '%s'", localBranch)
+	}
 	if remoteBranch != localBranch {
 		headerMsg += fmt.Sprintf(" -> '%s'", remoteBranch)
 	}
@@ -117,8 +151,11 @@ func runPush(cmd *cobra.Command, args []string) {
 	}
 	reporter.PrintHeader(headerMsg)
 
+	// 강제 푸시 전에 undo용 스냅샷 기록 준비
+	undoPoint := newAutoSnapshot(cmd, mgr)
+
 	// 8. Push Task 정의
-	pushTask := func(repo config.Repository) repository.Result {
+	pushTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{RepoName: repo.Name}
 		startTime := time.Now()
 		repoPath := mgr.GetRepositoryPath(repo)
@@ -128,10 +165,38 @@ func runPush(cmd *cobra.Command, args []string) {
 			result.Success = false
 			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
+		}
+
+		// @default 등 저장소별 브랜치 이름 해석
+		localBranch, err := repo.ResolveBranch(localBranch)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		remoteBranch, err := repo.ResolveBranch(remoteBranch)
+		if err != nil {
+			result.Success = false
+			result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+			result.Duration = time.Since(startTime)
+			return result, nil
 		}
 
-		client := git.NewClient(repoPath)
+		client := newGitClient(cfg, repo)
+
+		// 브랜치를 지정하지 않으면 현재 브랜치
+		if localBranch == "" {
+			current, err := client.GetCurrentBranch()
+			if err != nil || current == "" {
+				result.Success = false
+				result.Error = fmt.Errorf("HEAD is detached, no current branch to push\n  hint: check out a branch or use --branch")
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			localBranch, remoteBranch = current, current
+		}
 
 		// Step 2: 로컬 브랜치 존재 확인
 		exists, err := client.BranchExists(localBranch)
@@ -139,28 +204,36 @@ func runPush(cmd *cobra.Command, args []string) {
 			result.Success = false
 			result.Error = fmt.Errorf("failed to check branch: %w", err)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 		if !exists {
 			result.Success = false
 			result.Error = fmt.Errorf("branch '%s' does not exist\n  hint: check branch name or create it first", localBranch)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
-		// Step 3: 브랜치 체크아웃 (필요시)
-		currentBranch, _ := client.GetCurrentBranch()
-		if currentBranch != localBranch {
-			checkoutOpts := &git.CheckoutOptions{Branch: localBranch}
-			if err := client.Checkout(checkoutOpts); err != nil {
-				result.Success = false
-				result.Error = fmt.Errorf("failed to checkout branch '%s': %w", localBranch, err)
-				result.Duration = time.Since(startTime)
-				return result
+		// Step 3~4: 체크아웃 (강제 푸시, 필요시) 후 푸시, 단계별로 기록
+		steps := repository.NewSteps(&result)
+		if pushForce && !pushDryRun {
+			// 덮어쓸 원격 브랜치 끝과 현재 상태 기록 (undo용)
+			if err := steps.Run("snapshot", func() error {
+				return undoPoint.record(client, repo.Name, []repository.SnapshotRef{{Remote: pushRemote, Ref: "refs/heads/" + remoteBranch}})
+			}); err != nil {
+				return result, fmt.Errorf("failed to record undo snapshot: %w", err)
+			}
+		}
+		if pushForce {
+			currentBranch, _ := client.GetCurrentBranch()
+			if currentBranch == localBranch {
+				steps.Skip("checkout", "already on "+localBranch)
+			} else if err := steps.Run("checkout", func() error {
+				return client.Checkout(&git.CheckoutOptions{Branch: localBranch})
+			}); err != nil {
+				return result, fmt.Errorf("failed to checkout branch '%s': %w", localBranch, err)
 			}
 		}
 
-		// Step 4: 푸시 실행
 		pushOpts := &git.PushOptions{
 			Branch:       localBranch,
 			RemoteBranch: remoteBranch,
@@ -168,49 +241,53 @@ func runPush(cmd *cobra.Command, args []string) {
 			Force:        pushForce,
 			DryRun:       pushDryRun,
 		}
-		if err := client.Push(pushOpts); err != nil {
-			result.Success = false
-			result.Error = enhancePushError(err)
-			result.Duration = time.Since(startTime)
-			return result
+		if err := steps.Run("push", func() error { return client.Push(pushOpts) }); err != nil {
+			return result, enhancePushError(err, remoteBranch)
 		}
 
-		if pushDryRun {
-			if remoteBranch != localBranch {
-				result.Message = fmt.Sprintf("would be force pushed '%s' -> '%s' (dry-run)", localBranch, remoteBranch)
-			} else {
-				result.Message = "would be force pushed (dry-run)"
+		// Step 5: upstream 설정 (--set-upstream)
+		if pushSetUpstream && !pushDryRun {
+			if err := steps.Run("set-upstream", func() error {
+				return client.SetUpstreamTo(localBranch, pushRemote, remoteBranch)
+			}); err != nil {
+				return result, fmt.Errorf("pushed but %w", err)
 			}
+		}
+
+		verb := "pushed"
+		if pushForce {
+			verb = "force pushed"
+		}
+		target := fmt.Sprintf("'%s'", localBranch)
+		if remoteBranch != localBranch {
+			target = fmt.Sprintf("'%s' -> '%s'", localBranch, remoteBranch)
+		}
+		if pushDryRun {
+			result.Message = fmt.Sprintf("would be %s %s (dry-run)", verb, target)
 		} else {
-			if remoteBranch != localBranch {
-				result.Message = fmt.Sprintf("force pushed '%s' -> '%s' successfully", localBranch, remoteBranch)
-			} else {
-				result.Message = "force pushed successfully"
+			result.Message = fmt.Sprintf("%s %s successfully", verb, target)
+			if pushSetUpstream {
+				result.Message += fmt.Sprintf(", tracking %s/%s", pushRemote, remoteBranch)
 			}
 		}
 
 		result.Success = true
 		result.Duration = time.Since(startTime)
-		return result
+		return result, nil
 	}
 
 	// 9. 실행
 	ctx := context.Background()
 	var summary *repository.Summary
 
-	if workers > 1 {
-		summary = mgr.ExecuteParallel(ctx, pushTask, nil)
-	} else {
-		summary = mgr.ExecuteSequential(ctx, pushTask, nil)
-	}
+	summary = executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "push", localBranch, pushTask))
 
 	// 10. 결과 출력
 	reporter.PrintFullReport(summary)
+	undoPoint.printUndoHint()
 
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
-	}
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
 }
 
 // parseBranchSpec parses branch specification in format "local:remote" or "branch"
@@ -251,11 +328,16 @@ func confirmForcePush(repoCount int, localBranch, remoteBranch string) bool {
 }
 
 // enhancePushError enhances error messages with helpful hints
-func enhancePushError(err error) error {
+func enhancePushError(err error, remoteBranch string) error {
 	if err == nil {
 		return nil
 	}
 
+	// 원격에 새 커밋이 있어 거부됨 (강제 푸시가 아닐 때)
+	if errors.Is(err, git.ErrPushRejected) {
+		return fmt.Errorf("%w\n  hint: pull first ('multi-git pull') and push again, or use --force to overwrite the remote commits on '%s'", err, remoteBranch)
+	}
+
 	errMsg := err.Error()
 
 	// 인증 오류
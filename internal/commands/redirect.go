@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+)
+
+// movedRepositories collects repositories whose configured URL is redirected
+// by the hosting service (renamed or transferred). Safe for concurrent use.
+type movedRepositories struct {
+	mu   sync.Mutex
+	urls map[string]string // 저장소 이름 -> 새 URL
+}
+
+// check detects a redirect for the repository URL and records it
+// Returns the new URL, or "" if the repository has not moved or the check failed
+func (m *movedRepositories) check(cfg *config.Config, repo config.Repository) string {
+	newURL, err := git.DetectRedirect(repo.URL, credentials.GitAuth(cfg, repo))
+	if err != nil || newURL == "" {
+		return ""
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.urls == nil {
+		m.urls = make(map[string]string)
+	}
+	m.urls[repo.Name] = newURL
+	return newURL
+}
+
+// report prints the moved repositories and, if update is set, rewrites the
+// config file and the matching remote URLs of the local clones
+func (m *movedRepositories) report(reporter *repository.Reporter, mgr *repository.Manager, update bool) error {
+	if len(m.urls) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(m.urls))
+	for name := range m.urls {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		repo, _ := mgr.FindRepository(name)
+		lines = append(lines, fmt.Sprintf("  %s: %s -> %s", name, repo.URL, m.urls[name]))
+	}
+	reporter.PrintWarning(fmt.Sprintf("%d repositories have moved:\n%s", len(names), strings.Join(lines, "\n")))
+
+	if !update {
+		fmt.Println("  hint: run again with '--update-config' to use the new URLs")
+		return nil
+	}
+
+	// 1. 설정 파일 갱신
+	configPath := mgr.Config().ConfigPath
+	if configPath == "" {
+		return fmt.Errorf("--update-config needs a config file (not available with --discover)")
+	}
+	if err := config.UpdateRepositoryURLs(configPath, mgr.Config().Profile, m.urls); err != nil {
+		return fmt.Errorf("failed to update config: %w", err)
+	}
+	fmt.Printf("✓ Updated %d repository URLs in %s\n", len(names), configPath)
+
+	// 2. 로컬 클론의 원격 URL 갱신 (이전 URL을 쓰는 원격만)
+	for _, name := range names {
+		repo, _ := mgr.FindRepository(name)
+		if !mgr.IsGitRepository(repo) {
+			continue
+		}
+		remotes, err := newGitClient(mgr.Config(), repo).ReplaceRemoteURL(repo.URL, m.urls[name])
+		if err != nil {
+			reporter.PrintWarning(fmt.Sprintf("%s: %v", name, err))
+			continue
+		}
+		if len(remotes) > 0 {
+			fmt.Printf("✓ %s: updated remote %s\n", name, strings.Join(remotes, ", "))
+		}
+	}
+	return nil
+}
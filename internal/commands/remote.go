@@ -0,0 +1,321 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Remote 플래그 변수
+var (
+	remoteDryRun   bool // 시뮬레이션 모드
+	remoteParallel int  // 병렬 처리 수
+	remoteFailFast bool // 실패 시 중단
+)
+
+var remoteCmd = &cobra.Command{
+	Use:   "remote",
+	Short: "Manage the remotes of all repositories",
+	Long: `List, add, and update git remotes across all repositories.
+
+Additional remotes (e.g. upstream for a fork-based workflow) can be defined in
+the config with 'remotes': in the config section as URL templates for every
+repository, or per repository. clone adds them to new clones; 'remote add'
+adds them to existing ones.
+
+Examples:
+  # Show every repository's remotes and where they differ from the config
+  multi-git remote list
+
+  # Add the configured upstream remote to existing clones
+  multi-git remote add upstream
+
+  # Add a remote with a URL template
+  multi-git remote add upstream "git@github.com:upstream-org/{{.Name}}.git"
+
+  # Move the upstream remote to a new host
+  multi-git remote set-url upstream "https://git.example.com/upstream/{{.Name}}.git"`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var remoteListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the remotes of every repository",
+	Long: `List the remotes of every repository with their URL. Remotes defined in the
+config but missing in the clone, or with a different URL, are marked.`,
+	Args: cobra.NoArgs,
+	Run:  runRemoteList,
+}
+
+var remoteAddCmd = &cobra.Command{
+	Use:   "add <name> [<url>]",
+	Short: "Add a remote to every repository",
+	Long: `Add a remote to every repository. The URL may use the template tokens of
+path templates ({{.Name}}, {{.Owner}}, {{.Host}}, {{.Group}}). Without a URL,
+the URL configured for the remote in the config is used, and repositories that
+do not configure it are skipped.
+
+Repositories that already have the remote with the same URL are skipped; with
+a different URL they fail (use 'remote set-url').`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runRemoteChange(cmd, args, false)
+	},
+}
+
+var remoteSetURLCmd = &cobra.Command{
+	Use:   "set-url <name> [<url>]",
+	Short: "Change the URL of a remote in every repository",
+	Long: `Change the URL of a remote in every repository that has it. The URL may use
+the template tokens of path templates; without a URL, the URL configured for
+the remote in the config is used. Repositories without the remote fail (use
+'remote add').`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		runRemoteChange(cmd, args, true)
+	},
+}
+
+func init() {
+	for _, cmd := range []*cobra.Command{remoteAddCmd, remoteSetURLCmd} {
+		cmd.Flags().BoolVar(&remoteDryRun, "dry-run", false,
+			"Show what would change without changing anything")
+		cmd.Flags().IntVarP(&remoteParallel, "parallel", "p", 0,
+			"Number of parallel operations (0 = use config value)")
+		cmd.Flags().BoolVar(&remoteFailFast, "fail-fast", false,
+			"Stop on first failure")
+	}
+
+	remoteCmd.AddCommand(remoteListCmd)
+	remoteCmd.AddCommand(remoteAddCmd)
+	remoteCmd.AddCommand(remoteSetURLCmd)
+}
+
+func runRemoteList(cmd *cobra.Command, args []string) {
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+
+	// 저장소별 원격 행 (저장소, 원격, URL, 비고)
+	var rows [][4]string
+	for _, repo := range cfg.Repositories {
+		if !mgr.IsGitRepository(repo) {
+			rows = append(rows, [4]string{repo.Name, "-", "-", "not cloned"})
+			continue
+		}
+		configured, err := cfg.RemotesFor(repo)
+		if err != nil {
+			rows = append(rows, [4]string{repo.Name, "-", "-", err.Error()})
+			continue
+		}
+		remotes, err := git.NewClient(mgr.GetRepositoryPath(repo)).ListRemotes()
+		if err != nil {
+			rows = append(rows, [4]string{repo.Name, "-", "-", err.Error()})
+			continue
+		}
+
+		present := make(map[string]bool)
+		for _, remote := range remotes {
+			present[remote.Name] = true
+			url := strings.Join(remote.URLs, ", ")
+			note := ""
+			if want, ok := configured[remote.Name]; ok && (len(remote.URLs) != 1 || remote.URLs[0] != want) {
+				note = "config: " + want
+			}
+			rows = append(rows, [4]string{repo.Name, remote.Name, url, note})
+		}
+		for _, name := range sortedKeys(configured) {
+			if !present[name] {
+				rows = append(rows, [4]string{repo.Name, name, configured[name], "missing"})
+			}
+		}
+	}
+
+	// 열 너비 계산 (같은 저장소의 두 번째 행부터 이름 생략)
+	widths := [3]int{len("REPOSITORY"), len("REMOTE"), len("URL")}
+	for _, row := range rows {
+		for i := range widths {
+			widths[i] = max(widths[i], len(row[i]))
+		}
+	}
+	fmt.Printf("%-*s  %-*s  %-*s  %s\n", widths[0], "REPOSITORY", widths[1], "REMOTE", widths[2], "URL", "NOTE")
+	for i, row := range rows {
+		name := row[0]
+		if i > 0 && rows[i-1][0] == name {
+			name = ""
+		}
+		fmt.Printf("%-*s  %-*s  %-*s  %s\n", widths[0], name, widths[1], row[1], widths[2], row[2], row[3])
+	}
+}
+
+// runRemoteChange adds a remote (setURL = false) or changes its URL in every repository
+func runRemoteChange(cmd *cobra.Command, args []string, setURL bool) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 원격 이름 검증
+	name := args[0]
+	urlTemplate := ""
+	if len(args) == 2 {
+		urlTemplate = args[1]
+	}
+	if !config.IsValidRemoteName(name) {
+		fmt.Fprintf(os.Stderr, "Error: invalid remote name '%s'\n", name)
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 병렬 수 결정
+	workers := remoteParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 6. Remote Task 정의
+	remoteTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		// URL 결정 (인자 템플릿, 없으면 설정의 원격)
+		url := ""
+		if urlTemplate != "" {
+			rendered, err := config.RenderURL(urlTemplate, repo)
+			if err != nil {
+				return fail(err), nil
+			}
+			url = rendered
+		} else {
+			configured, err := cfg.RemotesFor(repo)
+			if err != nil {
+				return fail(err), nil
+			}
+			if url = configured[name]; url == "" {
+				return skip(fmt.Sprintf("remote '%s' not configured", name)), nil
+			}
+		}
+
+		client := git.NewClient(repoPath)
+		current, err := client.GetRemoteURL(name)
+		exists := err == nil
+		switch {
+		case exists && current == url:
+			return skip(fmt.Sprintf("already %s", url)), nil
+		case exists && !setURL:
+			return fail(fmt.Errorf("remote '%s' already exists with URL %s\n  hint: use 'multi-git remote set-url %s' to change it", name, current, name)), nil
+		case !exists && setURL:
+			return fail(fmt.Errorf("remote '%s' not found\n  hint: use 'multi-git remote add %s' to add it", name, name)), nil
+		}
+
+		if remoteDryRun {
+			result.Success = true
+			if setURL {
+				result.Message = fmt.Sprintf("would change %s -> %s", current, url)
+			} else {
+				result.Message = "would add " + url
+			}
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		if setURL {
+			err = client.SetRemoteURL(name, url)
+			result.Message = fmt.Sprintf("changed %s -> %s", current, url)
+		} else {
+			err = client.AddRemote(name, url)
+			result.Message = "added " + url
+		}
+		if err != nil {
+			return fail(err), nil
+		}
+		result.Success = true
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	header := fmt.Sprintf("Adding remote '%s'", name)
+	if setURL {
+		header = fmt.Sprintf("Setting URL of remote '%s'", name)
+	}
+	if remoteDryRun {
+		header += " (dry-run)"
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, remoteTask)
+
+	// 8. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// addConfiguredRemotes adds the remotes configured for the repository that the clone does not have
+// Returns the names of the added remotes, sorted
+func addConfiguredRemotes(cfg *config.Config, repo config.Repository, repoPath string) ([]string, error) {
+	remotes, err := cfg.RemotesFor(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	client := git.NewClient(repoPath)
+	var added []string
+	for _, name := range sortedKeys(remotes) {
+		if client.HasRemote(name) {
+			continue
+		}
+		if err := client.AddRemote(name, remotes[name]); err != nil {
+			return added, err
+		}
+		added = append(added, name)
+	}
+	return added, nil
+}
+
+// sortedKeys returns the keys of the map in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func GetRemoteCmd() *cobra.Command {
+	return remoteCmd
+}
@@ -0,0 +1,241 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// runReport is the machine-readable report of a run written with --report
+type runReport struct {
+	Command         string                `json:"command" yaml:"command"`
+	Profile         string                `json:"profile,omitempty" yaml:"profile,omitempty"`
+	FinishedAt      time.Time             `json:"finished_at" yaml:"finished_at"`
+	DurationSeconds float64               `json:"duration_seconds" yaml:"duration_seconds"`
+	ExitCode        int                   `json:"exit_code" yaml:"exit_code"`
+	Error           string                `json:"error,omitempty" yaml:"error,omitempty"` // 저장소 작업 전 실패 (예: 설정 오류)
+	Summary         *runReportSummary     `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Repositories    []runReportRepository `json:"repositories" yaml:"repositories"`
+}
+
+// runReportSummary counts the results of a run and gives their duration percentiles
+type runReportSummary struct {
+	Total      int     `json:"total" yaml:"total"`
+	Succeeded  int     `json:"succeeded" yaml:"succeeded"`
+	Failed     int     `json:"failed" yaml:"failed"`
+	Transient  int     `json:"transient" yaml:"transient"` // 실패 중 일시적 실패
+	TimedOut   int     `json:"timed_out" yaml:"timed_out"` // 실패 중 제한 시간 초과
+	Skipped    int     `json:"skipped" yaml:"skipped"`
+	Cancelled  int     `json:"cancelled" yaml:"cancelled"`
+	P50Seconds float64 `json:"p50_seconds" yaml:"p50_seconds"` // 실행된 저장소 소요 시간의 중앙값 (스킵, 취소 제외)
+	P95Seconds float64 `json:"p95_seconds" yaml:"p95_seconds"` // 실행된 저장소 소요 시간의 95번째 백분위
+}
+
+// runReportRepository is the result of one repository in the report
+type runReportRepository struct {
+	Name            string          `json:"name" yaml:"name"`
+	Status          string          `json:"status" yaml:"status"` // success, failed, skipped, cancelled, timed_out
+	DurationSeconds float64         `json:"duration_seconds" yaml:"duration_seconds"`
+	Message         string          `json:"message,omitempty" yaml:"message,omitempty"`
+	Error           string          `json:"error,omitempty" yaml:"error,omitempty"`
+	ErrorType       string          `json:"error_type,omitempty" yaml:"error_type,omitempty"` // 예: AUTH_FAILED, TIMEOUT, NETWORK_ERROR, CANCELLED
+	Details         map[string]any  `json:"details,omitempty" yaml:"details,omitempty"`
+	FailedStep      string          `json:"failed_step,omitempty" yaml:"failed_step,omitempty"` // 실패한 단계 (예: push)
+	Steps           []runReportStep `json:"steps,omitempty" yaml:"steps,omitempty"`
+}
+
+// runReportStep is the outcome of one step of a multi-step task in the run report
+type runReportStep struct {
+	Name            string  `json:"name" yaml:"name"`
+	Status          string  `json:"status" yaml:"status"` // succeeded, failed, skipped
+	DurationSeconds float64 `json:"duration_seconds" yaml:"duration_seconds"`
+	Message         string  `json:"message,omitempty" yaml:"message,omitempty"`
+	Error           string  `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// reportDirName is the directory next to the config file that holds the report of
+// the last run of each operation (e.g. for 'multi-git open --failed')
+const reportDirName = "reports"
+
+// writeRunReport writes the report of the run to the --report file, if set
+// The format follows the file extension (.yaml/.yml, otherwise JSON). Failing to
+// write the report is reported but does not change the exit code.
+func writeRunReport(cmd *cobra.Command, summary *repository.Summary, exitCode int, runErr error) {
+	path, _ := cmd.Root().PersistentFlags().GetString("report")
+	if path == "" {
+		return
+	}
+
+	report := newRunReport(cmd, summary, exitCode, runErr)
+	var data []byte
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(&report)
+	default:
+		data, err = json.MarshalIndent(&report, "", "  ")
+		data = append(data, '\n')
+	}
+	if err == nil {
+		err = os.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: report not written to %s: %v\n", path, err)
+	}
+}
+
+// saveLastRunReport writes the report of the run to <config dir>/reports/<operation>.json,
+// replacing the report of the previous run of the operation. Dry-runs are not recorded.
+func saveLastRunReport(cmd *cobra.Command, mgr *repository.Manager, summary *repository.Summary) {
+	if flag := cmd.Flags().Lookup("dry-run"); flag != nil && flag.Value.String() == "true" {
+		return
+	}
+
+	budget, _ := cmd.Root().PersistentFlags().GetInt("error-budget")
+	report := newRunReport(cmd, summary, summary.ExitCode(budget), nil)
+	data, err := json.MarshalIndent(&report, "", "  ")
+	if err != nil {
+		log.Warnf("run report not saved: %v", err)
+		return
+	}
+	data = append(data, '\n')
+
+	name := strings.NewReplacer(" ", "-", "/", "_", "\\", "_").Replace(report.Command)
+	path := mgr.StatePath(filepath.Join(reportDirName, name+".json"))
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Warnf("run report not saved: %v", err)
+		return
+	}
+	// 임시 파일에 쓴 후 교체 (저장 중 중단되어도 이전 보고서 유지)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err == nil {
+		err = os.Rename(tmpPath, path)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		log.Warnf("run report not saved: %v", err)
+	}
+}
+
+// loadLastRunReport returns the most recent report saved by saveLastRunReport, of
+// any operation. Returns nil without an error if no run has been recorded.
+func loadLastRunReport(mgr *repository.Manager) (*runReport, error) {
+	reports, err := mgr.ListStateArtifacts(reportDirName, func(entry fs.DirEntry) bool {
+		return !entry.IsDir() && filepath.Ext(entry.Name()) == ".json"
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list run reports: %w", err)
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+
+	// 가장 최근에 수정된 보고서 (목록은 오래된 순)
+	path := reports[len(reports)-1].Path
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read run report: %w", err)
+	}
+	var report runReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse run report %s: %w", path, err)
+	}
+	return &report, nil
+}
+
+// newRunReport builds the report of a run; summary is nil if the run failed before
+// any repository ran (runErr)
+func newRunReport(cmd *cobra.Command, summary *repository.Summary, exitCode int, runErr error) runReport {
+	report := runReport{
+		Command:      operationName(cmd),
+		Profile:      configProfile(cmd),
+		FinishedAt:   time.Now().UTC(),
+		ExitCode:     exitCode,
+		Repositories: []runReportRepository{},
+	}
+	if runErr != nil {
+		report.Error = runErr.Error()
+	}
+	if summary != nil {
+		report.DurationSeconds = summary.TotalDuration.Seconds()
+		report.Summary = &runReportSummary{
+			Total:      summary.TotalCount,
+			Succeeded:  summary.SuccessCount,
+			Failed:     summary.FailedCount,
+			Transient:  summary.TransientCount,
+			TimedOut:   summary.TimedOutCount,
+			Skipped:    summary.SkippedCount,
+			Cancelled:  summary.CancelledCount,
+			P50Seconds: summary.P50Duration.Seconds(),
+			P95Seconds: summary.P95Duration.Seconds(),
+		}
+		for _, result := range summary.Results {
+			report.Repositories = append(report.Repositories, newRunReportRepository(result))
+		}
+	}
+	return report
+}
+
+// failedRepositories returns the names of the repositories that failed in the run
+// (including those that timed out), in report order
+func (r *runReport) failedRepositories() []string {
+	var names []string
+	for _, repo := range r.Repositories {
+		if repo.Status == string(repository.StatusFailed) || repo.Status == string(repository.StatusTimedOut) {
+			names = append(names, repo.Name)
+		}
+	}
+	return names
+}
+
+// newRunReportRepository converts a task result into its report entry
+func newRunReportRepository(result repository.Result) runReportRepository {
+	entry := runReportRepository{
+		Name:            result.RepoName,
+		Status:          string(result.Status),
+		DurationSeconds: result.Duration.Seconds(),
+		Message:         result.Message,
+		Details:         result.Details,
+	}
+	if result.Error != nil {
+		entry.Error = result.Error.Error()
+		entry.ErrorType = reportErrorType(result)
+	}
+	if step := result.FailedStep(); step != nil {
+		entry.FailedStep = step.Name
+	}
+	for _, step := range result.Steps {
+		reportStep := runReportStep{Name: step.Name, Status: step.Status, DurationSeconds: step.Duration.Seconds(), Message: step.Message}
+		if step.Error != nil {
+			reportStep.Error = step.Error.Error()
+		}
+		entry.Steps = append(entry.Steps, reportStep)
+	}
+	return entry
+}
+
+// reportErrorType classifies the error of a result: the type of a RepoError,
+// otherwise CANCELLED, NETWORK_ERROR (transient), or OPERATION_FAILED
+func reportErrorType(result repository.Result) string {
+	var repoErr *repository.RepoError
+	switch {
+	case result.IsCancelled():
+		return "CANCELLED"
+	case errors.As(result.Error, &repoErr):
+		return string(repoErr.Type)
+	case result.IsTransientFailure():
+		return string(repository.ErrNetworkError)
+	default:
+		return string(repository.ErrOperationFailed)
+	}
+}
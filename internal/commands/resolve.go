@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+)
+
+// needsResolution returns true if a failure can be fixed by working in the repository
+// (diverged branches, aborted rebases, uncommitted changes in the way)
+func needsResolution(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	errMsg := err.Error()
+	for _, marker := range []string{
+		"non-fast-forward",
+		"diverged",
+		"conflict",
+		"failed to rebase",
+		"local changes",
+		"uncommitted changes",
+		"stash",
+	} {
+		if strings.Contains(errMsg, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveInteractively lets the user fix repositories that failed with conflicts
+// For each selected repository a shell is opened in it; when the shell exits the
+// task is retried. Returns a summary with retried results replacing the originals.
+func resolveInteractively(mgr *repository.Manager, summary *repository.Summary, task repository.TaskFunc) *repository.Summary {
+	if !stdinIsTerminal() {
+		return summary
+	}
+
+	// 해결이 필요한 저장소 수집 (설정 순서 유지)
+	var pending []string
+	for _, result := range summary.Results {
+		if !result.Success && needsResolution(result.Error) {
+			pending = append(pending, result.RepoName)
+		}
+	}
+	if len(pending) == 0 {
+		return summary
+	}
+
+	results := make(map[string]repository.Result, len(summary.Results))
+	for _, result := range summary.Results {
+		results[result.RepoName] = result
+	}
+
+	startTime := time.Now()
+	reader := bufio.NewReader(os.Stdin)
+
+	for len(pending) > 0 {
+		fmt.Println()
+		fmt.Printf("⚠️  %d repositories need manual resolution:\n", len(pending))
+		for i, name := range pending {
+			fmt.Printf("  %2d) %s - %s\n", i+1, name, firstLine(results[name].Error))
+		}
+		fmt.Print("Number to open a shell in, 'r' to retry all, or 'q' to finish: ")
+
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			break
+		}
+		input = strings.TrimSpace(strings.ToLower(input))
+
+		var targets []string
+		switch input {
+		case "q", "":
+			pending = nil
+			continue
+		case "r":
+			targets = pending
+		default:
+			n, err := strconv.Atoi(input)
+			if err != nil || n < 1 || n > len(pending) {
+				fmt.Printf("  invalid choice: %s\n", input)
+				continue
+			}
+			name := pending[n-1]
+			repo, _ := mgr.FindRepository(name)
+
+			fmt.Printf("Opening %s in %s (exit the shell to resume)\n", shell.InteractiveShell(), mgr.GetRepositoryPath(repo))
+			if err := shell.OpenShell(mgr.GetRepositoryPath(repo)); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				continue
+			}
+			targets = []string{name}
+		}
+
+		// 재시도 후 해결된 저장소는 목록에서 제거
+		var remaining []string
+		retried := make(map[string]bool, len(targets))
+		for _, name := range targets {
+			repo, _ := mgr.FindRepository(name)
+			result := task.Run(repo)
+			results[name] = result
+			retried[name] = true
+
+			fmt.Printf("  %s\n", result.String())
+		}
+		for _, name := range pending {
+			result := results[name]
+			if retried[name] && (result.Success || !needsResolution(result.Error)) {
+				continue
+			}
+			remaining = append(remaining, name)
+		}
+		pending = remaining
+	}
+
+	// 원래 순서대로 결과 재구성
+	merged := make([]repository.Result, 0, len(summary.Results))
+	for _, result := range summary.Results {
+		merged = append(merged, results[result.RepoName])
+	}
+	return repository.NewSummary(merged, summary.TotalDuration+time.Since(startTime))
+}
+
+// firstLine returns the first line of an error message (hints are omitted)
+func firstLine(err error) string {
+	if err == nil {
+		return ""
+	}
+	line, _, _ := strings.Cut(err.Error(), "\n")
+	return line
+}
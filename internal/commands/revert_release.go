@@ -0,0 +1,279 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/provider"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Revert-release 플래그 변수
+var (
+	revertTag      string // 되돌릴 릴리스 태그 (필수)
+	revertSince    string // 이전 릴리스 태그 (기본: 자동 탐색)
+	revertFrom     string // revert 브랜치 시작 지점 (기본: 현재 브랜치)
+	revertBranch   string // 생성할 브랜치 이름 (기본: revert-<tag>)
+	revertRemote   string // 원격 이름
+	revertPush     bool   // 브랜치 푸시
+	revertOpenPR   bool   // PR 생성 (--push 포함)
+	revertDryRun   bool   // 시뮬레이션 모드
+	revertParallel int    // 병렬 처리 수
+	revertFailFast bool   // 실패 시 중단
+)
+
+var revertReleaseCmd = &cobra.Command{
+	Use:   "revert-release",
+	Short: "Revert the commits of a release on a new branch in every repository",
+	Long: `Emergency rollback of a release across all repositories.
+
+For each repository that has the tag, the commits introduced between the
+previous tag and the release tag are reverted (newest first, one revert
+commit each) on a new branch. The branch starts at the current branch, or
+at --from. Repositories without the tag are skipped.
+
+The previous tag is the nearest tag in the history of the release tag;
+use --since to choose it explicitly. Merge commits are not reverted
+themselves, only the commits they brought in.
+
+Reverting uses the git binary, since go-git does not support revert. On a
+conflict the revert is aborted, the new branch is deleted, and the
+repository is reported as failed.
+
+With --open-pr, a pull request (GitLab: merge request) from the revert branch
+into the starting branch is opened through the GitHub or GitLab API, using
+the token in $GITHUB_TOKEN or $GITLAB_TOKEN.
+
+Examples:
+  # Preview what would be reverted
+  multi-git revert-release --tag v1.3.0 --dry-run
+
+  # Revert v1.3.0 on top of each repository's default branch and push
+  multi-git revert-release --tag v1.3.0 --from @default --push
+
+  # Push and open a pull request per repository
+  multi-git revert-release --tag v1.3.0 --from @default --open-pr`,
+	Args: cobra.NoArgs,
+	Run:  runRevertRelease,
+}
+
+func init() {
+	revertReleaseCmd.Flags().StringVarP(&revertTag, "tag", "t", "",
+		"Release tag to revert (required)")
+	revertReleaseCmd.Flags().StringVar(&revertSince, "since", "",
+		"Previous release tag (default: nearest earlier tag)")
+	revertReleaseCmd.Flags().StringVar(&revertFrom, "from", "",
+		"Branch to start the revert branch from (default: current branch, '@default' = default_branch)")
+	_ = revertReleaseCmd.RegisterFlagCompletionFunc("from", completeBranchOrDefault)
+	revertReleaseCmd.Flags().StringVarP(&revertBranch, "branch", "b", "",
+		"Name of the revert branch (default: revert-<tag>)")
+	revertReleaseCmd.Flags().StringVarP(&revertRemote, "remote", "r", "",
+		"Remote to push to (default: config default_remote)")
+	revertReleaseCmd.Flags().BoolVar(&revertPush, "push", false,
+		"Push the revert branch to the remote")
+	revertReleaseCmd.Flags().BoolVar(&revertOpenPR, "open-pr", false,
+		"Push the revert branch and open a pull request ($GITHUB_TOKEN / $GITLAB_TOKEN)")
+	revertReleaseCmd.Flags().BoolVar(&revertDryRun, "dry-run", false,
+		"Show what would be reverted without changing anything")
+	revertReleaseCmd.Flags().IntVarP(&revertParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	revertReleaseCmd.Flags().BoolVar(&revertFailFast, "fail-fast", false,
+		"Stop on first failure")
+
+	revertReleaseCmd.MarkFlagRequired("tag")
+}
+
+func runRevertRelease(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	branchName := revertBranch
+	if branchName == "" {
+		branchName = "revert-" + revertTag
+	}
+	push := revertPush || revertOpenPR
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// --open-pr: 푸시하기 전에 모든 저장소의 PR을 열 수 있는지 확인
+	if revertOpenPR && !revertDryRun {
+		if err := checkPullRequestTokens(cfg, mgr.Repositories()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 4. 병렬 수 및 원격 결정
+	workers := revertParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	remoteName := revertRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 5. Revert Task 정의
+	revertTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+
+		// Step 1: 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// Step 2: 릴리스 태그 확인 (없는 저장소는 릴리스에 포함되지 않음)
+		exists, err := client.TagExists(revertTag)
+		if err != nil {
+			return fail(fmt.Errorf("failed to check tag: %w", err)), nil
+		}
+		if !exists {
+			return skip(fmt.Sprintf("tag '%s' not found", revertTag)), nil
+		}
+
+		// Step 3: 되돌릴 커밋 범위 결정
+		since := revertSince
+		if since == "" {
+			since, err = client.PreviousTag(revertTag)
+			if err != nil {
+				return fail(fmt.Errorf("%w\n  hint: use '--since' to set the previous release", err)), nil
+			}
+		}
+		commits, err := client.ReleaseCommits(since, revertTag)
+		if err != nil {
+			return fail(err), nil
+		}
+		if len(commits) == 0 {
+			return skip(fmt.Sprintf("no commits between %s and %s", since, revertTag)), nil
+		}
+
+		// Step 4: 시작 브랜치 결정
+		currentBranch, err := client.GetCurrentBranch()
+		if err != nil {
+			return fail(fmt.Errorf("failed to get current branch: %w", err)), nil
+		}
+		base := currentBranch
+		if revertFrom != "" {
+			base, err = repo.ResolveBranch(revertFrom)
+			if err != nil {
+				return fail(fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)), nil
+			}
+		}
+		if base == "" {
+			return fail(fmt.Errorf("repository is in detached HEAD state\n  hint: use '--from' to choose the branch to start from")), nil
+		}
+
+		summary := fmt.Sprintf("%d commits (%s..%s) on '%s' from '%s'", len(commits), since, revertTag, branchName, base)
+		if revertDryRun {
+			result.Success = true
+			result.Message = "would revert " + summary
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// Step 5: 작업 전 검사
+		hasChanges, err := client.HasLocalChanges()
+		if err != nil {
+			return fail(fmt.Errorf("failed to check local changes: %w", err)), nil
+		}
+		if hasChanges {
+			return fail(fmt.Errorf("repository has uncommitted changes\n  hint: commit or stash them first")), nil
+		}
+		if exists, _ := client.BranchExists(branchName); exists {
+			return fail(fmt.Errorf("branch '%s' already exists\n  hint: delete it or use '--branch' to choose another name", branchName)), nil
+		}
+
+		// Step 6: revert 브랜치 생성 및 체크아웃
+		if err := client.CreateBranch(branchName, base); err != nil {
+			return fail(err), nil
+		}
+		if err := client.Checkout(&git.CheckoutOptions{Branch: branchName}); err != nil {
+			_ = client.DeleteBranch(branchName)
+			return fail(enhanceCheckoutError(err, branchName)), nil
+		}
+
+		// Step 7: 커밋 되돌리기 (실패 시 원래 브랜치로 복귀하고 브랜치 삭제)
+		if err := client.RevertCommits(commits); err != nil {
+			if currentBranch != "" {
+				_ = client.Checkout(&git.CheckoutOptions{Branch: currentBranch, Force: true})
+				_ = client.DeleteBranch(branchName)
+			}
+			return fail(err), nil
+		}
+		result.Message = "reverted " + summary
+
+		// Step 8: 푸시 및 PR 생성
+		if push {
+			if err := client.Push(&git.PushOptions{Branch: branchName, Remote: remoteName}); err != nil {
+				return fail(fmt.Errorf("reverted locally on '%s' but push failed: %w", branchName, err)), nil
+			}
+			_ = client.SetUpstream(branchName, remoteName)
+			result.Message += ", pushed"
+		}
+		if revertOpenPR {
+			link, err := openPullRequest(mgr.TaskContext(repo.Name), cfg, repo, provider.PullRequest{
+				Title: fmt.Sprintf("Revert release %s", revertTag),
+				Body: fmt.Sprintf("Reverts the %s of release %s (%s..%s).",
+					plural(len(commits), "commit"), revertTag, since, revertTag),
+				Head: branchName,
+				Base: base,
+			})
+			if err != nil {
+				return fail(fmt.Errorf("pushed '%s' but could not open a pull request: %w\n  hint: open it at %s",
+					branchName, err, pullRequestURL(cfg, repo, base, branchName))), nil
+			}
+			result.Message += "\n    pull request: " + link
+			result.SetDetail("pull_request", link)
+		}
+
+		result.Success = true
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	header := fmt.Sprintf("Reverting release '%s'", revertTag)
+	if revertDryRun {
+		header += " (dry-run)"
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, revertTask)
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+func GetRevertReleaseCmd() *cobra.Command {
+	return revertReleaseCmd
+}
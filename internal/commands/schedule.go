@@ -0,0 +1,347 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/schedule"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// Schedule 플래그 변수
+var (
+	scheduleShell        string // 알림 명령어에 사용할 셸
+	scheduleHistoryLimit int    // 출력할 실행 기록 수
+	scheduleNoNotify     bool   // 실패 알림 생략
+)
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Run multi-git commands on cron schedules",
+	Long: `Run multi-git commands on cron schedules, turning multi-git into a
+lightweight fleet maintenance agent.
+
+Scheduled operations are declared in the 'schedule' section of the config
+file. Each command is a multi-git subcommand with its flags; it runs with
+the same --config and --profile as the scheduler:
+
+  schedule:
+    nightly:
+      cron: "0 2 * * *"
+      command: fetch --prune
+    weekly-gc:
+      cron: "@weekly"
+      command: exec "git gc --auto" -p 2
+      timeout: 1h
+
+  config:
+    notify:
+      webhook: https://hooks.slack.com/services/...   # {"text": ...} is posted on failure
+      command: mail -s "multi-git: $MULTI_GIT_SCHEDULE_NAME failed" ops@example.com
+
+Cron expressions have five fields (minute hour day month weekday) and
+support lists, ranges, steps, and names, or one of @hourly, @daily,
+@weekly, @monthly, @yearly. Times are local.
+
+Commands run without a terminal, so they cannot prompt; use flags such as
+--yes where a command asks for confirmation. Every run is recorded in a
+history next to the config file, and failed runs are reported to the
+'config.notify' targets.`,
+}
+
+var scheduleListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List scheduled operations with their next and last runs",
+	Args:  cobra.NoArgs,
+	Run:   runScheduleList,
+}
+
+var scheduleRunCmd = &cobra.Command{
+	Use:   "run <name>",
+	Short: "Run a scheduled operation now",
+	Long: `Run a scheduled operation once, right away, and record it in the history.
+Exits with code 1 if the operation fails.
+
+Examples:
+  multi-git schedule run nightly`,
+	Args: cobra.ExactArgs(1),
+	Run:  runScheduleRun,
+}
+
+var scheduleDaemonCmd = &cobra.Command{
+	Use:   "daemon",
+	Short: "Run scheduled operations until stopped",
+	Long: `Run in the foreground and execute each scheduled operation when it is due,
+until interrupted (Ctrl+C or SIGTERM).
+
+Operations run one at a time. An operation that becomes due while another
+one is running starts when that one ends; missed runs are not repeated.
+The config file is read once at start, so restart the daemon after
+changing the schedule.
+
+Examples:
+  # Run the schedule and log to a file
+  multi-git schedule daemon --log-file ~/.multi-git/schedule.log`,
+	Args: cobra.NoArgs,
+	Run:  runScheduleDaemon,
+}
+
+var scheduleHistoryCmd = &cobra.Command{
+	Use:   "history [name]",
+	Short: "Show recent runs of scheduled operations",
+	Args:  cobra.MaximumNArgs(1),
+	Run:   runScheduleHistory,
+}
+
+func init() {
+	scheduleCmd.PersistentFlags().StringVarP(&scheduleShell, "shell", "s", shell.DefaultShell(),
+		"Shell to use for the notification command")
+	scheduleRunCmd.Flags().BoolVar(&scheduleNoNotify, "no-notify", false,
+		"Do not send failure notifications")
+	scheduleHistoryCmd.Flags().IntVarP(&scheduleHistoryLimit, "limit", "n", 20,
+		"Number of runs to show (0 = all)")
+
+	scheduleCmd.AddCommand(scheduleListCmd)
+	scheduleCmd.AddCommand(scheduleRunCmd)
+	scheduleCmd.AddCommand(scheduleDaemonCmd)
+	scheduleCmd.AddCommand(scheduleHistoryCmd)
+}
+
+// loadSchedule loads the config, its scheduled operations, and their run history. Exits on error.
+func loadSchedule(cmd *cobra.Command) (*config.Config, []schedule.Entry, *schedule.History) {
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+
+	cfg, err := config.LoadAndValidateProfile(configPath, configProfile(cmd))
+	if err != nil {
+		exitOnConfigError(cmd, configPath, err)
+	}
+
+	entries, err := schedule.Entries(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	mgr := repository.NewManager(cfg)
+	history, err := schedule.LoadHistory(mgr.StatePath(schedule.HistoryFileName))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	return cfg, entries, history
+}
+
+// newScheduleRunner creates a runner that re-runs this executable with the
+// config and profile of the current invocation. Exits on error.
+func newScheduleRunner(cmd *cobra.Command, cfg *config.Config) *schedule.Runner {
+	executable, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: cannot locate the multi-git executable: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 알림 명령어가 있으면 실행할 셸 확인
+	if cfg.Notify.Command != "" {
+		if err := shell.Validate(scheduleShell); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --shell: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 출력은 기록용으로 수집되므로 진행 표시줄은 항상 끔
+	args := []string{"--config", cfg.ConfigPath, "--no-progress"}
+	if cfg.Profile != "" {
+		args = append(args, "--profile", cfg.Profile)
+	}
+	for _, overlay := range cfg.Overlays {
+		args = append(args, "--overlay", overlay)
+	}
+	flags := cmd.Root().PersistentFlags()
+	if logFile, _ := flags.GetString("log-file"); logFile != "" {
+		args = append(args, "--log-file", logFile)
+	}
+	if flags.Changed("log-level") {
+		logLevel, _ := flags.GetString("log-level")
+		args = append(args, "--log-level", logLevel)
+	}
+
+	return &schedule.Runner{
+		Executable: executable,
+		GlobalArgs: args,
+		Notify:     cfg.Notify,
+		Shell:      scheduleShell,
+	}
+}
+
+// recordScheduleRun saves the run in the history and notifies about failures
+// Failing to record or notify is reported but never stops the scheduler
+func recordScheduleRun(runner *schedule.Runner, history *schedule.History, run schedule.Run, notify bool) {
+	history.Record(run, schedule.DefaultKeepRuns)
+	if err := history.Save(); err != nil {
+		log.Warnf("schedule history not recorded: %v", err)
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	if run.Success() || run.Transient() || !notify || runner.Notify.IsEmpty() {
+		return
+	}
+	if err := runner.NotifyFailure(run); err != nil {
+		log.Warnf("schedule: notification for %s failed: %v", run.Name, err)
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+}
+
+// runStatus returns the status symbol of a run
+func runStatus(run schedule.Run) string {
+	switch {
+	case run.Success():
+		return "✓"
+	case run.Transient():
+		return "⚠"
+	default:
+		return "✗"
+	}
+}
+
+func runScheduleList(cmd *cobra.Command, args []string) {
+	_, entries, history := loadSchedule(cmd)
+	if len(entries) == 0 {
+		fmt.Println("No scheduled operations configured")
+		fmt.Println("  hint: add a 'schedule' section to your config (see 'multi-git schedule --help')")
+		return
+	}
+
+	now := time.Now()
+	fmt.Printf("%-16s %-16s %-17s %-24s %s\n", "NAME", "CRON", "NEXT RUN", "LAST RUN", "COMMAND")
+	for _, entry := range entries {
+		next := "never"
+		if t := entry.Schedule.Next(now); !t.IsZero() {
+			next = t.Format("2006-01-02 15:04")
+		}
+
+		last := "-"
+		if run, ok := history.Last(entry.Name); ok {
+			last = fmt.Sprintf("%s %s", runStatus(run), run.StartedAt.Format("2006-01-02 15:04"))
+		}
+
+		fmt.Printf("%-16s %-16s %-17s %-24s %s\n", entry.Name, entry.Config.Cron, next, last, entry.Config.Command)
+	}
+}
+
+func runScheduleRun(cmd *cobra.Command, args []string) {
+	cfg, _, history := loadSchedule(cmd)
+
+	name := args[0]
+	entry, ok := cfg.Schedule[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: scheduled operation '%s' not found\n", name)
+		if names := cfg.ScheduleNames(); len(names) > 0 {
+			fmt.Fprintf(os.Stderr, "  hint: available: %s\n", strings.Join(names, ", "))
+		}
+		os.Exit(1)
+	}
+
+	runner := newScheduleRunner(cmd, cfg)
+	runner.Output = os.Stdout
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Printf("Running '%s': multi-git %s\n\n", name, entry.Command)
+	run := runner.Run(ctx, name, entry)
+	recordScheduleRun(runner, history, run, !scheduleNoNotify)
+
+	fmt.Println()
+	if run.Transient() {
+		fmt.Fprintf(os.Stderr, "⚠ '%s' had transient failures: %s (%.1fs)\n", name, run.Error, run.Seconds)
+		os.Exit(repository.ExitTransient)
+	}
+	if !run.Success() {
+		fmt.Fprintf(os.Stderr, "✗ '%s' failed: %s (%.1fs)\n", name, run.Error, run.Seconds)
+		os.Exit(1)
+	}
+	fmt.Printf("✓ '%s' completed in %.1fs\n", name, run.Seconds)
+}
+
+func runScheduleDaemon(cmd *cobra.Command, args []string) {
+	cfg, entries, history := loadSchedule(cmd)
+	if len(entries) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no scheduled operations configured\n")
+		fmt.Fprintf(os.Stderr, "  hint: add a 'schedule' section to your config (see 'multi-git schedule --help')\n")
+		os.Exit(1)
+	}
+
+	runner := newScheduleRunner(cmd, cfg)
+	if cfg.Notify.IsEmpty() {
+		fmt.Fprintf(os.Stderr, "Warning: no 'config.notify' target configured, failures will only be recorded in the history\n")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	now := time.Now()
+	fmt.Printf("Scheduling %d operation(s)\n", len(entries))
+	for _, entry := range entries {
+		next := "never"
+		if t := entry.Schedule.Next(now); !t.IsZero() {
+			next = t.Format("2006-01-02 15:04")
+		}
+		fmt.Printf("  %-16s next run %s: %s\n", entry.Name, next, entry.Config.Command)
+	}
+	log.Infof("schedule: daemon started with %d operation(s)", len(entries))
+
+	err := runner.Daemon(ctx, entries, func(run schedule.Run) {
+		fmt.Printf("%s %s %s (%.1fs)", run.StartedAt.Format("2006-01-02 15:04:05"), runStatus(run), run.Name, run.Seconds)
+		if !run.Success() {
+			fmt.Printf(": %s", run.Error)
+		}
+		fmt.Println()
+		recordScheduleRun(runner, history, run, true)
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	log.Infof("schedule: daemon stopped")
+	fmt.Println("Scheduler stopped")
+}
+
+func runScheduleHistory(cmd *cobra.Command, args []string) {
+	_, _, history := loadSchedule(cmd)
+
+	name := ""
+	if len(args) > 0 {
+		name = args[0]
+	}
+	runs := history.Recent(name, scheduleHistoryLimit)
+	if len(runs) == 0 {
+		fmt.Println("No scheduled runs recorded yet")
+		return
+	}
+
+	fmt.Printf("%-19s %-16s %-8s %9s  %s\n", "STARTED", "NAME", "STATUS", "DURATION", "COMMAND")
+	for _, run := range runs {
+		status := "ok"
+		if !run.Success() {
+			status = fmt.Sprintf("exit %d", run.ExitCode)
+		}
+		fmt.Printf("%-19s %-16s %-8s %8.1fs  %s\n",
+			run.StartedAt.Format("2006-01-02 15:04:05"), run.Name, status, run.Seconds, run.Command)
+		if !run.Success() && run.Error != "" {
+			fmt.Printf("    %s\n", run.Error)
+		}
+	}
+}
+
+func GetScheduleCmd() *cobra.Command {
+	return scheduleCmd
+}
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/shell"
+)
+
+// selectRepositories lets the user check the repositories to operate on in a
+// checkbox list on the terminal
+// Returns nil if the user cancelled or checked nothing.
+func selectRepositories(repos []config.Repository) ([]config.Repository, error) {
+	if !stdinIsTerminal() {
+		return nil, fmt.Errorf("--interactive requires a terminal on stdin")
+	}
+
+	// 그룹과 함께 표시 (stdout은 결과 출력용으로 남겨둠)
+	items := make([]string, len(repos))
+	for i, repo := range repos {
+		items[i] = repo.Name
+		if len(repo.Groups) > 0 {
+			items[i] += fmt.Sprintf(" [%s]", strings.Join(repo.Groups, ", "))
+		}
+	}
+
+	indexes, err := shell.Checklist("Select repositories", items)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]config.Repository, 0, len(indexes))
+	for _, i := range indexes {
+		selected = append(selected, repos[i])
+	}
+	return selected, nil
+}
+
+// stdinIsTerminal returns true if stdin is an interactive terminal
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
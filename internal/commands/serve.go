@@ -0,0 +1,208 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/server"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// ServeTokenEnv is the environment variable read when --token is not set
+const ServeTokenEnv = "MULTI_GIT_API_TOKEN"
+
+// SlackSecretEnv is the environment variable read when --slack-signing-secret is not set
+const SlackSecretEnv = "MULTI_GIT_SLACK_SIGNING_SECRET"
+
+// WebhookSecretEnv is the environment variable read when --webhook-secret is not set
+const WebhookSecretEnv = "MULTI_GIT_WEBHOOK_SECRET"
+
+// Serve 플래그 변수
+var (
+	serveListen        string        // 수신 주소
+	serveToken         string        // API 토큰
+	serveAllowExec     []string      // exec 허용 명령어 목록
+	serveShell         string        // exec에 사용할 셸
+	serveExecTimeout   time.Duration // exec 명령어 제한 시간
+	serveParallel      int           // 병렬 처리 수
+	serveSlackSecret   string        // Slack 서명 비밀
+	serveSlackTeam     string        // Slack 워크스페이스 ID
+	serveSlackUsers    []string      // Slack 명령 허용 사용자 ID
+	serveWebhookSecret string        // push 웹훅 비밀
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve fleet operations over an HTTP API",
+	Long: `Run multi-git as a daemon exposing fleet operations over a REST API,
+so dashboards and chatbots can drive multi-repository operations without
+shelling out.
+
+Every request must carry the token as 'Authorization: Bearer <token>'. The
+token is taken from --token or the ` + ServeTokenEnv + ` environment variable.
+
+Endpoints (JSON, under /api/v1):
+  GET  /repositories   configured repositories (?group=..&repo=..)
+  GET  /status         branch, local changes, and HEAD of each repository
+  POST /pull           pull the current branch            {"groups": [..], "repositories": [..]}
+  POST /tag            create or delete a tag             {"name", "branch", "message", "push", "force", "delete"}
+  POST /exec           run an allow-listed command        {"command"}
+
+Operations that change repositories (pull, tag, exec) run one at a time;
+a request made while one is running gets 409 Conflict. exec only runs
+commands listed with --allow-exec, compared exactly, and never allows
+changes to protected paths.
+
+Slack slash commands (POST /slack/command) are enabled with the app's signing
+secret (--slack-signing-secret or ` + SlackSecretEnv + `). Only commands from
+the workspace given with --slack-team (its ID, T…) by users whose Slack user IDs
+(U…) are listed with --slack-allow-user are run. Results are posted back to the channel:
+  /multigit status backend
+  /multigit pull api-*
+  /multigit tag v1.4.0 --push
+  /multigit exec make lint
+
+GitHub and GitLab push webhooks (POST /webhooks/push) are enabled with a
+webhook secret (--webhook-secret or ` + WebhookSecretEnv + `). The repository
+a push is about is fetched in the background, keeping local mirrors warm
+without polling (see also 'multi-git watch-remotes'). GitHub deliveries are
+verified with X-Hub-Signature-256, GitLab deliveries with X-Gitlab-Token.
+
+The server needs an API token, a Slack signing secret, a webhook secret,
+or any combination of them.
+
+Examples:
+  # Serve on port 8080 with status, pull, and tag
+  MULTI_GIT_API_TOKEN=s3cret multi-git serve --listen :8080
+
+  # Also allow two commands over exec
+  multi-git serve --token s3cret --allow-exec "git status --short" --allow-exec "make lint"
+
+  # Query it
+  curl -H "Authorization: Bearer s3cret" localhost:8080/api/v1/status?group=backend
+
+  # Slack commands for two users of one workspace
+  MULTI_GIT_SLACK_SIGNING_SECRET=... multi-git serve --listen :8080 --slack-team T0123ABCD --slack-allow-user U012AB3CD --slack-allow-user U045EF6GH
+
+  # Fetch on push webhooks only
+  MULTI_GIT_WEBHOOK_SECRET=... multi-git serve --listen :8080`,
+	Args: cobra.NoArgs,
+	Run:  runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveListen, "listen", "127.0.0.1:8080",
+		"Address to listen on (e.g. ':8080' for all interfaces)")
+	serveCmd.Flags().StringVar(&serveToken, "token", "",
+		"API token clients must send as a bearer token (default: $"+ServeTokenEnv+")")
+	serveCmd.Flags().StringArrayVar(&serveAllowExec, "allow-exec", nil,
+		"Command that may be run through the exec endpoint (repeatable; exec is disabled without it)")
+	serveCmd.Flags().StringVarP(&serveShell, "shell", "s", shell.DefaultShell(),
+		"Shell to use for exec commands (cmd, powershell, and pwsh are also supported)")
+	serveCmd.Flags().DurationVar(&serveExecTimeout, "exec-timeout", shell.DefaultTimeout,
+		"Time limit for exec commands in each repository")
+	serveCmd.Flags().IntVarP(&serveParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	serveCmd.Flags().StringVar(&serveSlackSecret, "slack-signing-secret", "",
+		"Slack app signing secret; enables /slack/command (default: $"+SlackSecretEnv+")")
+	serveCmd.Flags().StringVar(&serveSlackTeam, "slack-team", "",
+		"Slack workspace ID (T…) slash commands must come from (required with Slack)")
+	serveCmd.Flags().StringArrayVar(&serveSlackUsers, "slack-allow-user", nil,
+		"Slack user ID (U…) allowed to run slash commands (repeatable)")
+	serveCmd.Flags().StringVar(&serveWebhookSecret, "webhook-secret", "",
+		"GitHub/GitLab push webhook secret; enables /webhooks/push (default: $"+WebhookSecretEnv+")")
+}
+
+func runServe(cmd *cobra.Command, args []string) {
+	// 1. 토큰 확인 (플래그 > 환경 변수)
+	token := serveToken
+	if token == "" {
+		token = os.Getenv(ServeTokenEnv)
+	}
+
+	slackSecret := serveSlackSecret
+	if slackSecret == "" {
+		slackSecret = os.Getenv(SlackSecretEnv)
+	}
+	if slackSecret != "" && len(serveSlackUsers) == 0 {
+		fmt.Fprintf(os.Stderr, "Warning: no --slack-allow-user given, every Slack command will be rejected\n")
+	}
+
+	webhookSecret := serveWebhookSecret
+	if webhookSecret == "" {
+		webhookSecret = os.Getenv(WebhookSecretEnv)
+	}
+
+	// exec가 허용된 경우에만 셸 확인
+	if len(serveAllowExec) > 0 {
+		if err := shell.Validate(serveShell); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --shell: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 2. 설정 파일 로드 (--group, --repos로 서버가 다루는 저장소 제한 가능)
+	cfg := loadConfig(cmd)
+
+	// 3. 서버 생성
+	srv, err := server.New(cfg, server.Options{
+		Token:           token,
+		AllowedCommands: serveAllowExec,
+		Shell:           serveShell,
+		CommandTimeout:  serveExecTimeout,
+		Workers:         serveParallel,
+
+		SlackSigningSecret: slackSecret,
+		SlackTeam:          serveSlackTeam,
+		SlackUsers:         serveSlackUsers,
+
+		WebhookSecret: webhookSecret,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		if errors.Is(err, server.ErrNoToken) {
+			fmt.Fprintf(os.Stderr, "  hint: use '--token' or set %s (or configure Slack with '--slack-signing-secret', webhooks with '--webhook-secret')\n", ServeTokenEnv)
+		}
+		if errors.Is(err, server.ErrNoSlackTeam) {
+			fmt.Fprintf(os.Stderr, "  hint: pass the workspace ID (T…) with '--slack-team'; it is shown in the workspace URL of the Slack web app\n")
+		}
+		os.Exit(1)
+	}
+
+	// 4. 종료 시그널까지 실행
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Printf("Serving %d repositories on %s\n", len(cfg.Repositories), serveListen)
+	if token != "" {
+		fmt.Printf("REST API on http://%s%s\n", serveListen, server.APIPrefix)
+	}
+	if len(serveAllowExec) > 0 {
+		fmt.Printf("exec allowed for %d command(s)\n", len(serveAllowExec))
+	}
+	if slackSecret != "" {
+		fmt.Printf("Slack commands on http://%s%s for %d user(s)\n", serveListen, server.SlackPath, len(serveSlackUsers))
+	}
+	if webhookSecret != "" {
+		fmt.Printf("Push webhooks on http://%s%s\n", serveListen, server.WebhookPath)
+	}
+	log.Infof("serve: listening on %s", serveListen)
+
+	if err := srv.ListenAndServe(ctx, serveListen); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("Server stopped")
+}
+
+func GetServeCmd() *cobra.Command {
+	return serveCmd
+}
@@ -0,0 +1,480 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Snapshot 플래그 변수
+var (
+	snapshotForce         bool // create: 기존 스냅샷 덮어쓰기, restore: 로컬 변경사항 무시
+	snapshotResetBranches bool // 옮겨진 브랜치를 스냅샷 커밋으로 되돌림
+	snapshotOverride      bool // 보호 브랜치 되돌리기 허용
+	snapshotFetch         bool // 없는 커밋은 fetch 후 복원
+	snapshotDryRun        bool // 시뮬레이션 모드
+	snapshotParallel      int  // 병렬 처리 수
+	snapshotFailFast      bool // 실패 시 중단
+)
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Save and restore the commit of every repository",
+	Long: `Record the branch and commit every repository is at in a named snapshot, and
+check out exactly those commits later: for reproducible builds across
+repositories, or to roll back after a bad sync.
+
+Snapshots are lockfiles stored under <config dir>/snapshots/<name>.json.
+Uncommitted changes are not part of a snapshot.
+
+Examples:
+  # Record the workspace before syncing
+  multi-git snapshot create before-sync
+  multi-git sync
+
+  # Roll back: check out the recorded commits and move the branches back
+  multi-git snapshot restore before-sync --reset-branches
+
+  # Show the saved snapshots
+  multi-git snapshot list`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var snapshotCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Record the branch and commit of every repository",
+	Long: `Record the current branch and HEAD commit of every repository in a snapshot.
+Repositories that are not cloned fail and are left out of the snapshot.
+An existing snapshot with the same name is only replaced with --force.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runSnapshotCreate,
+}
+
+var snapshotRestoreCmd = &cobra.Command{
+	Use:   "restore <name>",
+	Short: "Check out the commits recorded in a snapshot",
+	Long: `Check out the commit recorded for every repository in the snapshot.
+
+A repository whose branch still points at the recorded commit is checked out on
+that branch. If the branch has moved since, the commit is checked out as a
+detached HEAD; with --reset-branches the branch is moved back to the recorded
+commit instead (commits after it stay in the reflog). Repositories not in the
+snapshot are skipped.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runSnapshotRestore,
+}
+
+var snapshotListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List saved snapshots",
+	Args:  cobra.NoArgs,
+	Run:   runSnapshotList,
+}
+
+func init() {
+	snapshotCmd.PersistentFlags().IntVarP(&snapshotParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	snapshotCreateCmd.Flags().BoolVarP(&snapshotForce, "force", "f", false,
+		"Replace an existing snapshot with the same name")
+	snapshotRestoreCmd.Flags().BoolVarP(&snapshotForce, "force", "f", false,
+		"Restore even with local changes, discarding them")
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotResetBranches, "reset-branches", false,
+		"Move branches that have moved since the snapshot back to the recorded commit")
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotOverride, "override-protection", false,
+		"Allow --reset-branches on branches listed in protected_branches")
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotFetch, "fetch", false,
+		"Fetch from origin if a recorded commit is not in the clone")
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotDryRun, "dry-run", false,
+		"Show what each repository would check out without changing anything")
+	snapshotRestoreCmd.Flags().BoolVar(&snapshotFailFast, "fail-fast", false,
+		"Stop on first failure")
+
+	snapshotCmd.AddCommand(snapshotCreateCmd)
+	snapshotCmd.AddCommand(snapshotRestoreCmd)
+	snapshotCmd.AddCommand(snapshotListCmd)
+}
+
+func runSnapshotCreate(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 스냅샷 이름 검증
+	name := args[0]
+	if err := repository.ValidateSnapshotName(name); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 기존 스냅샷은 --force 또는 --resume(중단된 create 이어하기)일 때만 교체
+	path := mgr.SnapshotPath(name)
+	resume, _ := cmd.Root().PersistentFlags().GetBool("resume")
+	if _, err := os.Stat(path); err == nil && !snapshotForce && !resume {
+		fmt.Fprintf(os.Stderr, "Error: snapshot '%s' already exists\n", name)
+		fmt.Fprintf(os.Stderr, "  hint: use '--force' to replace it\n")
+		os.Exit(1)
+	}
+
+	// 5. 병렬 수 결정
+	workers := snapshotParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 6. Create Task 정의 (기록은 결과의 Details로 전달: --resume 시 체크포인트에서 복원됨)
+	createTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+
+		if !mgr.IsGitRepository(repo) {
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", mgr.GetRepositoryPath(repo))
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		client := newGitClient(cfg, repo)
+		commit, err := client.GetCommitAtRevision("HEAD")
+		if err != nil {
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		branch, err := client.GetCurrentBranch()
+		if err != nil {
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		result.SetDetail("commit", commit.Hash.String())
+		if branch != "" {
+			result.SetDetail("branch", branch)
+		}
+
+		short := commit.Hash.String()[:7]
+		result.Success = true
+		result.Message = short + " (detached)"
+		if branch != "" {
+			result.Message = fmt.Sprintf("%s @ %s", branch, short)
+		}
+		if hasChanges, err := client.HasLocalChanges(); err == nil && hasChanges {
+			result.Message += ", uncommitted changes not recorded"
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Creating snapshot: %s", name))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, createTask)
+
+	// 8. 결과 출력 (실패한 저장소가 있어도 기록된 저장소로 저장)
+	reporter.PrintFullReport(summary)
+	snapshot := repository.NewSnapshot(name)
+	for _, result := range summary.Results {
+		commit, ok := result.Details["commit"].(string)
+		if !result.Success || !ok {
+			continue
+		}
+		branch, _ := result.Details["branch"].(string)
+		snapshot.Repositories[result.RepoName] = repository.SnapshotEntry{Branch: branch, Commit: commit}
+	}
+	if len(snapshot.Repositories) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no repository recorded, snapshot '%s' not saved\n", name)
+		os.Exit(1)
+	}
+	if err := snapshot.Save(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\nSnapshot '%s' saved to %s (%s)\n", name, path, plural(len(snapshot.Repositories), "repository"))
+	if summary.FailedCount > 0 {
+		reporter.PrintWarning(fmt.Sprintf("the snapshot does not include %s that failed", plural(summary.FailedCount, "repository")))
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+func runSnapshotRestore(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 스냅샷 이름 검증
+	name := args[0]
+	if err := repository.ValidateSnapshotName(name); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 스냅샷 로드
+	path := mgr.SnapshotPath(name)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: snapshot '%s' not found\n", name)
+		fmt.Fprintf(os.Stderr, "  hint: run 'multi-git snapshot list' to see the saved snapshots\n")
+		os.Exit(1)
+	}
+	snapshot, err := repository.LoadSnapshot(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 보호 브랜치 확인 (--reset-branches일 때, 어느 저장소도 변경하기 전에 거부)
+	if snapshotResetBranches && !snapshotOverride {
+		branches := make(map[string]string)
+		for repoName, entry := range snapshot.Repositories {
+			if entry.Branch != "" {
+				branches[repoName] = entry.Branch
+			}
+		}
+		exitOnProtected("reset protected branches", "protected_branches", protectedManifestTargets(cfg, branches))
+	}
+
+	// 6. 병렬 수 결정
+	workers := snapshotParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// --reset-branches 전에 undo용 스냅샷 기록 준비
+	undoPoint := newAutoSnapshot(cmd, mgr)
+
+	// 7. Restore Task 정의
+	restoreTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+
+		entry, ok := snapshot.Repositories[repo.Name]
+		if !ok {
+			return skip("skipped: not in snapshot"), nil
+		}
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", mgr.GetRepositoryPath(repo))), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// 브랜치를 옮기기 전에 현재 상태 기록 (undo용)
+		if snapshotResetBranches && !snapshotDryRun && entry.Branch != "" {
+			steps := repository.NewSteps(&result)
+			if err := steps.Run("snapshot", func() error {
+				return undoPoint.record(client, repo.Name, []repository.SnapshotRef{{Ref: "refs/heads/" + entry.Branch}})
+			}); err != nil {
+				return fail(fmt.Errorf("failed to record undo snapshot: %w", err)), nil
+			}
+		}
+
+		message, skipped, err := restoreSnapshotEntry(client, entry, snapshotRestoreOptions{
+			fetch:         snapshotFetch,
+			resetBranches: snapshotResetBranches,
+			force:         snapshotForce,
+			dryRun:        snapshotDryRun,
+		})
+		if err != nil {
+			return fail(err), nil
+		}
+		if skipped {
+			return skip(message), nil
+		}
+		result.Success = true
+		result.Message = message
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 8. 작업 실행
+	header := fmt.Sprintf("Restoring snapshot: %s (%s)", name, snapshot.CreatedAt.Format("2006-01-02 15:04"))
+	if snapshotDryRun {
+		header += " (dry-run)"
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, restoreTask)
+
+	// 9. 결과 출력
+	reporter.PrintFullReport(summary)
+	undoPoint.printUndoHint()
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// snapshotRestoreOptions controls how restoreSnapshotEntry checks out a recorded entry
+type snapshotRestoreOptions struct {
+	fetch         bool // 기록된 커밋이 없으면 origin에서 fetch
+	resetBranches bool // 옮겨진 브랜치를 기록된 커밋으로 되돌림
+	force         bool // 로컬 변경사항 폐기
+	dryRun        bool // 시뮬레이션 모드
+}
+
+// restoreSnapshotEntry checks out the recorded commit, on the recorded branch if it
+// still points there (or with resetBranches), otherwise as a detached HEAD
+// Returns the result message, or skipped = true if the repository is already there.
+func restoreSnapshotEntry(client *git.Client, entry repository.SnapshotEntry, opts snapshotRestoreOptions) (string, bool, error) {
+	short := entry.Commit
+	if len(short) > 7 {
+		short = short[:7]
+	}
+
+	// 1. 기록된 커밋 확인 (없으면 --fetch로 가져옴)
+	if _, err := client.GetCommitAtRevision(entry.Commit); err != nil {
+		if !opts.fetch {
+			return "", false, fmt.Errorf("commit %s not found in the clone\n  hint: use '--fetch' to fetch it from origin", short)
+		}
+		if err := client.Fetch("origin"); err != nil {
+			return "", false, fmt.Errorf("commit %s not found, fetch failed: %w", short, err)
+		}
+		if _, err := client.GetCommitAtRevision(entry.Commit); err != nil {
+			return "", false, fmt.Errorf("commit %s not found in the clone or on origin", short)
+		}
+	}
+
+	// 2. 복원 방법 결정
+	head, err := client.GetCommitAtRevision("HEAD")
+	if err != nil {
+		return "", false, err
+	}
+	current, err := client.GetCurrentBranch()
+	if err != nil {
+		return "", false, err
+	}
+	if head.Hash.String() == entry.Commit && current == entry.Branch {
+		if entry.Branch == "" {
+			return fmt.Sprintf("already at %s", short), true, nil
+		}
+		return fmt.Sprintf("already at %s @ %s", entry.Branch, short), true, nil
+	}
+
+	detach := entry.Branch == ""
+	reset := false
+	moved := ""
+	if !detach {
+		tip, err := client.GetCommitOnBranch(entry.Branch)
+		switch {
+		case err == nil && tip.Hash.String() == entry.Commit:
+		case opts.resetBranches:
+			reset = true
+			if err == nil {
+				moved = tip.Hash.String()[:7]
+			}
+		default:
+			// 브랜치가 옮겨졌거나 삭제됨: 커밋만 복원
+			detach = true
+			moved = "deleted"
+			if err == nil {
+				moved = "now at " + tip.Hash.String()[:7]
+			}
+		}
+	}
+
+	// 3. 로컬 변경사항 확인
+	hasChanges, err := client.HasLocalChanges()
+	if err != nil {
+		return "", false, fmt.Errorf("failed to check local changes: %w", err)
+	}
+	if hasChanges && !opts.force {
+		return "", false, fmt.Errorf("local changes would be overwritten by restore\n  hint: commit or stash them ('multi-git stash'), or use --force to discard")
+	}
+
+	// 4. 체크아웃 (또는 dry-run 메시지)
+	var message string
+	switch {
+	case detach && entry.Branch != "":
+		message = fmt.Sprintf("detached at %s (%s %s; use --reset-branches to move it back)", short, entry.Branch, moved)
+	case detach:
+		message = fmt.Sprintf("detached at %s", short)
+	case reset && moved != "":
+		message = fmt.Sprintf("%s reset to %s (was %s)", entry.Branch, short, moved)
+	case reset:
+		message = fmt.Sprintf("%s recreated at %s", entry.Branch, short)
+	default:
+		message = fmt.Sprintf("%s @ %s", entry.Branch, short)
+	}
+	if hasChanges {
+		message += ", local changes discarded"
+	}
+	if opts.dryRun {
+		return "would restore " + message, false, nil
+	}
+
+	if detach {
+		err = client.CheckoutCommit(entry.Commit, opts.force)
+	} else if reset {
+		err = client.CheckoutBranchAt(entry.Branch, entry.Commit, opts.force)
+	} else {
+		err = client.Checkout(&git.CheckoutOptions{Branch: entry.Branch, Force: opts.force})
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return message, false, nil
+}
+
+func runSnapshotList(cmd *cobra.Command, args []string) {
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+
+	snapshots, err := repository.ListSnapshots(mgr.StatePath(repository.SnapshotDirName))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	if len(snapshots) == 0 {
+		fmt.Println("No snapshots.")
+		fmt.Println("  hint: run 'multi-git snapshot create <name>' to record the workspace")
+		return
+	}
+
+	width := len("NAME")
+	for _, snapshot := range snapshots {
+		width = max(width, len(snapshot.Name))
+	}
+	fmt.Printf("%-*s  %-16s  %-12s  %s\n", width, "NAME", "CREATED", "REPOSITORIES", "OPERATION")
+	for _, snapshot := range snapshots {
+		line := fmt.Sprintf("%-*s  %-16s  %-12d  %s", width, snapshot.Name, snapshot.CreatedAt.Format("2006-01-02 15:04"), len(snapshot.Repositories), snapshot.Operation)
+		fmt.Println(strings.TrimRight(line, " "))
+	}
+	fmt.Printf("\nSnapshots are stored in %s\n", filepath.Join(cfg.ConfigDir, repository.SnapshotDirName))
+}
+
+func GetSnapshotCmd() *cobra.Command {
+	return snapshotCmd
+}
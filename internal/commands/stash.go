@@ -0,0 +1,240 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// stashMessagePrefix marks stashes created by 'multi-git stash' so pop never
+// restores a stash made by hand or by another tool
+const stashMessagePrefix = "multi-git stash"
+
+// Stash 플래그 변수
+var (
+	stashMessage  string // stash 메시지 (pop에서는 선택 기준)
+	stashParallel int    // 병렬 처리 수
+	stashAll      bool   // list에서 모든 stash 표시
+)
+
+var stashCmd = &cobra.Command{
+	Use:   "stash [push|pop|list]",
+	Short: "Save and restore local changes across all repositories",
+	Long: `Stash uncommitted changes (including untracked files) in every repository
+and restore them later, e.g. around a forced checkout or pull.
+
+'multi-git stash' without a subcommand is 'multi-git stash push'.
+Repositories without local changes are skipped, and pop only restores
+stashes created by multi-git, so stashes made by hand are never touched.
+
+Examples:
+  # Stash, force a checkout, and restore
+  multi-git stash -m "before release"
+  multi-git checkout release/1.4 --force
+  multi-git stash pop
+
+  # Restore a specific stash
+  multi-git stash pop -m "before release"
+
+  # Show multi-git stashes in every repository
+  multi-git stash list`,
+	Args: cobra.NoArgs,
+	Run:  runStashPush,
+}
+
+var stashPushCmd = &cobra.Command{
+	Use:   "push",
+	Short: "Stash local changes in every repository",
+	Args:  cobra.NoArgs,
+	Run:   runStashPush,
+}
+
+var stashPopCmd = &cobra.Command{
+	Use:   "pop",
+	Short: "Restore the most recent multi-git stash in every repository",
+	Long: `Restore the most recent stash created by 'multi-git stash' in every repository.
+With --message, the most recent stash with that message is restored instead.
+
+If restoring conflicts with local changes, the stash is kept and the
+repository is reported as failed.`,
+	Args: cobra.NoArgs,
+	Run:  runStashPop,
+}
+
+var stashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List stashes in every repository",
+	Args:  cobra.NoArgs,
+	Run:   runStashList,
+}
+
+func init() {
+	stashCmd.PersistentFlags().StringVarP(&stashMessage, "message", "m", "",
+		"Stash message (pop: restore the most recent stash with this message)")
+	stashCmd.PersistentFlags().IntVarP(&stashParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	stashListCmd.Flags().BoolVar(&stashAll, "all", false,
+		"Also list stashes not created by multi-git")
+
+	stashCmd.AddCommand(stashPushCmd)
+	stashCmd.AddCommand(stashPopCmd)
+	stashCmd.AddCommand(stashListCmd)
+}
+
+// stashFullMessage returns the stash message stored for --message
+func stashFullMessage(message string) string {
+	if message = strings.TrimSpace(message); message == "" {
+		return stashMessagePrefix
+	}
+	return stashMessagePrefix + ": " + message
+}
+
+// runStashTask runs a stash task across all repositories and exits with 1 on failures
+// The task returns the outcome message, or skipped = true if the repository had nothing to do
+func runStashTask(cmd *cobra.Command, header string, task func(client *git.Client) (message string, skipped bool, err error)) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 결정
+	workers := stashParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 5. 저장소 확인 후 Task 실행
+	stashTask := func(repo config.Repository) (repository.Result, error) {
+		startTime := time.Now()
+		if !mgr.IsGitRepository(repo) {
+			return repository.Result{
+				RepoName: repo.Name,
+				Error:    fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", mgr.GetRepositoryPath(repo)),
+				Duration: time.Since(startTime),
+			}, nil
+		}
+
+		message, skipped, err := task(newGitClient(cfg, repo))
+		result := repository.Result{RepoName: repo.Name, Success: err == nil, Error: err, Message: message}
+		if skipped {
+			result.Skip(message)
+		} else {
+			result.Duration = time.Since(startTime)
+		}
+		return result, nil
+	}
+
+	reporter.PrintHeader(header)
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, stashTask)
+
+	// 6. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+func runStashPush(cmd *cobra.Command, args []string) {
+	message := stashFullMessage(stashMessage)
+
+	runStashTask(cmd, "Stashing local changes", func(client *git.Client) (string, bool, error) {
+		stashed, err := client.Stash(message)
+		if err != nil {
+			return "", false, err
+		}
+		if !stashed {
+			// 변경사항이 없으면 스킵
+			return "nothing to stash", true, nil
+		}
+
+		if branch, _ := client.GetCurrentBranch(); branch != "" {
+			return fmt.Sprintf("stashed on %s", branch), false, nil
+		}
+		return "stashed", false, nil
+	})
+}
+
+func runStashPop(cmd *cobra.Command, args []string) {
+	// --message 없이는 multi-git이 만든 가장 최근 stash
+	match := stashMessagePrefix
+	if strings.TrimSpace(stashMessage) != "" {
+		match = stashFullMessage(stashMessage)
+	}
+
+	runStashTask(cmd, "Restoring stashed changes", func(client *git.Client) (string, bool, error) {
+		entry, err := client.FindStash(match)
+		if errors.Is(err, git.ErrNoStash) {
+			return "no multi-git stash", true, nil
+		}
+		if err != nil {
+			return "", false, err
+		}
+
+		if err := client.StashPopRef(entry.Ref); err != nil {
+			return "", false, fmt.Errorf("%w\n  hint: the stash is kept as %s; resolve the conflicts or commit local changes and retry", err, entry.Ref)
+		}
+		return fmt.Sprintf("restored '%s'", entry.Message), false, nil
+	})
+}
+
+func runStashList(cmd *cobra.Command, args []string) {
+	// 1. 설정 파일 로드
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+
+	// 2. 저장소별 stash 출력
+	found := 0
+	for _, repo := range mgr.Repositories() {
+		if !mgr.IsGitRepository(repo) {
+			continue
+		}
+
+		entries, err := newGitClient(cfg, repo).ListStashes()
+		if err != nil {
+			reporter.PrintWarning(fmt.Sprintf("%s: %v", repo.Name, err))
+			continue
+		}
+
+		var shown []git.StashEntry
+		for _, entry := range entries {
+			if stashAll || strings.HasPrefix(entry.Message, stashMessagePrefix) {
+				shown = append(shown, entry)
+			}
+		}
+		if len(shown) == 0 {
+			continue
+		}
+
+		found += len(shown)
+		fmt.Printf("%s:\n", repo.Name)
+		for _, entry := range shown {
+			fmt.Printf("  %-10s %-20s %s\n", entry.Ref, entry.Branch, entry.Message)
+		}
+	}
+
+	if found == 0 {
+		if stashAll {
+			fmt.Println("No stashes.")
+		} else {
+			fmt.Println("No multi-git stashes.")
+		}
+	}
+}
+
+func GetStashCmd() *cobra.Command {
+	return stashCmd
+}
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// stateFilter selects repositories by their live git state (--only-* flags)
+type stateFilter struct {
+	dirty    bool   // --only-dirty: 커밋되지 않은 변경이 있음
+	clean    bool   // --only-clean: 커밋되지 않은 변경이 없음
+	onBranch string // --only-on-branch: 현재 브랜치 ('@default' 가능)
+	behind   bool   // --only-behind: 현재 브랜치가 업스트림보다 뒤처짐 (마지막 fetch 기준)
+}
+
+// stateFilterFromFlags reads the --only-* flags
+func stateFilterFromFlags(cmd *cobra.Command) (stateFilter, error) {
+	flags := cmd.Root().PersistentFlags()
+	var filter stateFilter
+	filter.dirty, _ = flags.GetBool("only-dirty")
+	filter.clean, _ = flags.GetBool("only-clean")
+	filter.onBranch, _ = flags.GetString("only-on-branch")
+	filter.behind, _ = flags.GetBool("only-behind")
+
+	if filter.dirty && filter.clean {
+		return filter, fmt.Errorf("--only-dirty and --only-clean cannot be used together")
+	}
+	return filter, nil
+}
+
+// active returns true if any --only-* flag is set
+func (f stateFilter) active() bool {
+	return f.dirty || f.clean || f.onBranch != "" || f.behind
+}
+
+// String returns the flags of the filter, e.g. "--only-dirty --only-on-branch main"
+func (f stateFilter) String() string {
+	var flags []string
+	if f.dirty {
+		flags = append(flags, "--only-dirty")
+	}
+	if f.clean {
+		flags = append(flags, "--only-clean")
+	}
+	if f.onBranch != "" {
+		flags = append(flags, "--only-on-branch "+f.onBranch)
+	}
+	if f.behind {
+		flags = append(flags, "--only-behind")
+	}
+	return strings.Join(flags, " ")
+}
+
+// matches checks the live state of a repository against the filter
+// Repositories that are not cloned, or on a detached HEAD, match no filter.
+func (f stateFilter) matches(cfg *config.Config, repo config.Repository) (bool, error) {
+	client := newGitClient(cfg, repo)
+	if !client.IsRepository() {
+		return false, nil
+	}
+
+	branch, err := client.GetCurrentBranch()
+	if err != nil {
+		return false, err
+	}
+	if f.onBranch != "" || f.behind {
+		if branch == "" {
+			return false, nil
+		}
+	}
+	if f.onBranch != "" {
+		want, err := repo.ResolveBranch(f.onBranch)
+		if err != nil {
+			return false, err
+		}
+		if branch != want {
+			return false, nil
+		}
+	}
+
+	if f.dirty || f.clean {
+		dirty, err := client.HasLocalChanges()
+		if err != nil {
+			return false, err
+		}
+		if dirty != f.dirty {
+			return false, nil
+		}
+	}
+
+	if f.behind {
+		behind, err := behindUpstream(client, branch, cfg.DefaultRemote)
+		if err != nil {
+			return false, err
+		}
+		if behind == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// behindUpstream counts the commits on the upstream of the branch that the branch
+// does not have, as of the last fetch. Without a configured upstream, the branch of
+// the same name on the default remote is used; 0 if that does not exist either.
+func behindUpstream(client *git.Client, branch, defaultRemote string) (int, error) {
+	upstream, err := client.UpstreamRef(branch)
+	if err != nil {
+		return 0, err
+	}
+	if upstream == "" {
+		upstream = "refs/remotes/" + defaultRemote + "/" + branch
+	}
+	exists, err := client.RefExists(upstream)
+	if err != nil || !exists {
+		return 0, err
+	}
+
+	_, behind, err := client.AheadBehind(upstream, "refs/heads/"+branch)
+	return behind, err
+}
+
+// filterByState returns the repositories whose live state matches the filter,
+// in config order. Repositories are inspected in parallel (parallel_workers);
+// those that cannot be inspected are left out with a warning.
+func filterByState(cfg *config.Config, filter stateFilter) ([]config.Repository, []string) {
+	workers := repository.NewManager(cfg).ParallelWorkers()
+
+	matched := make([]bool, len(cfg.Repositories))
+	errs := make([]error, len(cfg.Repositories))
+	sem := make(chan struct{}, workers)
+	var wg sync.WaitGroup
+	for i, repo := range cfg.Repositories {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			matched[i], errs[i] = filter.matches(cfg, repo)
+		}()
+	}
+	wg.Wait()
+
+	var repos []config.Repository
+	var warnings []string
+	for i, repo := range cfg.Repositories {
+		if errs[i] != nil {
+			warnings = append(warnings, fmt.Sprintf("'%s' skipped by %s: %v", repo.Name, filter, strings.SplitN(errs[i].Error(), "\n", 2)[0]))
+			continue
+		}
+		if matched[i] {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, warnings
+}
@@ -0,0 +1,286 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Sync 플래그 변수
+var (
+	syncRemote     string // 원격 이름
+	syncRebase     bool   // fast-forward 대신 rebase
+	syncStashLocal bool   // 로컬 변경사항 stash 후 복원
+	syncParallel   int    // 병렬 처리 수
+	syncResolve    bool   // 충돌한 저장소를 대화형으로 해결
+)
+
+var syncCmd = &cobra.Command{
+	Use:   "sync [branch]",
+	Short: "Fetch, checkout, and fast-forward all repositories in one pass",
+	Long: `Bring every repository up to date in one pass: fetch from the remote,
+checkout the branch (creating a tracking branch when it only exists on the
+remote), then fast-forward it to the remote branch.
+
+Without a branch argument, each repository's current branch is synced.
+Use '@default' to sync each repository's configured default_branch.
+Repositories with a 'pin' in the config are not synced to a branch: after
+the fetch, the pinned commit or tag is checked out as a detached HEAD.
+
+--rebase and --stash-local use the git binary, since go-git supports
+neither rebase nor stash.
+
+Examples:
+  # Update the current branch of every repository
+  multi-git sync
+
+  # Switch everything to develop and update it
+  multi-git sync develop
+
+  # Keep local commits on top of the remote and carry uncommitted work along
+  multi-git sync @default --rebase --stash-local
+
+  # Open a shell in each repository that diverged or hit a rebase conflict, then retry it
+  multi-git sync --rebase --resolve`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completeBranchArg,
+	Run:               runSync,
+}
+
+func init() {
+	syncCmd.Flags().StringVarP(&syncRemote, "remote", "r", "",
+		"Remote name to sync with (default: config default_remote)")
+	syncCmd.Flags().BoolVar(&syncRebase, "rebase", false,
+		"Rebase local commits onto the remote branch instead of fast-forwarding")
+	syncCmd.Flags().BoolVar(&syncStashLocal, "stash-local", false,
+		"Stash uncommitted changes before syncing and restore them afterwards")
+	syncCmd.Flags().BoolVar(&syncResolve, "resolve", false,
+		"Interactively resolve repositories that failed with conflicts or local changes, then retry them")
+	syncCmd.Flags().IntVarP(&syncParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runSync(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	var branchName string
+	if len(args) > 0 {
+		branchName = args[0]
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 4. 병렬 수 및 원격 결정
+	workers := syncParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	remoteName := syncRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 5. Sync Task 정의
+	syncTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+
+		// Step 1: 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// Step 2: 대상 브랜치 결정
+		currentBranch, err := client.GetCurrentBranch()
+		if err != nil {
+			return fail(fmt.Errorf("failed to get current branch: %w", err)), nil
+		}
+
+		// pin이 있으면 브랜치 대신 고정 리비전을 체크아웃
+		branch := currentBranch
+		if repo.Pin != "" {
+			branch = ""
+		} else if branchName != "" {
+			branch, err = repo.ResolveBranch(branchName)
+			if err != nil {
+				return fail(fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)), nil
+			}
+		}
+		if branch == "" && repo.Pin == "" {
+			return fail(fmt.Errorf("repository is in detached HEAD state\n  hint: pass a branch name to sync")), nil
+		}
+
+		// Step 3: Fetch (이후 단계는 소요 시간과 함께 기록)
+		steps := repository.NewSteps(&result)
+		if err := steps.Run("fetch", func() error {
+			return client.FetchWithOptions(&git.FetchOptions{Remote: remoteName})
+		}); err != nil {
+			return fail(enhanceFetchError(err)), nil
+		}
+
+		// Step 4: 로컬 변경사항 처리
+		stashed := false
+		if syncStashLocal {
+			if err := steps.Run("stash", func() error {
+				stashed, err = client.Stash("multi-git sync")
+				return err
+			}); err != nil {
+				return fail(err), nil
+			}
+		} else {
+			hasChanges, err := client.HasLocalChanges()
+			if err != nil {
+				return fail(fmt.Errorf("failed to check local changes: %w", err)), nil
+			}
+			if hasChanges {
+				return fail(fmt.Errorf("repository has uncommitted changes\n  hint: commit them or use '--stash-local'")), nil
+			}
+		}
+
+		// Step 5~6: 체크아웃 및 업데이트 (stash는 실패 시에도 복원)
+		var message string
+		syncErr := steps.Run("update", func() error {
+			var err error
+			if repo.Pin != "" {
+				message, err = checkoutPin(client, repo, remoteName)
+			} else {
+				message, err = syncBranch(client, remoteName, branch, currentBranch)
+			}
+			return err
+		})
+
+		if stashed {
+			if err := steps.Run("stash-pop", client.StashPop); err != nil {
+				if syncErr == nil {
+					syncErr = fmt.Errorf("%w\n  hint: your changes are kept in 'git stash list'", err)
+				} else {
+					syncErr = fmt.Errorf("%w\n  also: %v (changes are kept in 'git stash list')", syncErr, err)
+				}
+			} else {
+				message += ", local changes restored"
+			}
+		}
+
+		if syncErr != nil {
+			return fail(syncErr), nil
+		}
+
+		result.Success = true
+		result.Message = message
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	header := fmt.Sprintf("Syncing with %s", remoteName)
+	if branchName != "" {
+		header = fmt.Sprintf("Syncing branch '%s' with %s", branchName, remoteName)
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, withHooks(cmd, mgr, "sync", branchName, syncTask))
+
+	// 7. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 8. 충돌 해결 모드
+	if syncResolve && summary.HasFailures() {
+		if !stdinIsTerminal() {
+			reporter.PrintWarning("--resolve requires a terminal on stdin, skipping")
+		} else if resolved := resolveInteractively(mgr, summary, syncTask); resolved != summary {
+			summary = resolved
+			reporter.PrintHeader("Results after resolution")
+			reporter.PrintFullReport(summary)
+		}
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// syncBranch checks out the branch if needed and updates it from the remote branch
+// Returns a short description of what happened
+func syncBranch(client *git.Client, remoteName, branch, currentBranch string) (string, error) {
+	var steps []string
+
+	// 체크아웃 (로컬에 없으면 원격 브랜치를 추적하는 브랜치 생성)
+	if branch != currentBranch {
+		exists, err := client.BranchExists(branch)
+		if err != nil {
+			return "", fmt.Errorf("failed to check branch: %w", err)
+		}
+		if !exists {
+			if !client.HasRemoteTrackingBranch(remoteName, branch) {
+				return "", fmt.Errorf("branch '%s' not found locally or on '%s'", branch, remoteName)
+			}
+			if err := client.CreateBranch(branch, fmt.Sprintf("%s/%s", remoteName, branch)); err != nil {
+				return "", err
+			}
+			if err := client.SetUpstream(branch, remoteName); err != nil {
+				return "", err
+			}
+			steps = append(steps, fmt.Sprintf("created '%s' tracking %s", branch, remoteName))
+		}
+
+		if err := client.Checkout(&git.CheckoutOptions{Branch: branch}); err != nil {
+			return "", enhanceCheckoutError(err, branch)
+		}
+		if exists {
+			steps = append(steps, fmt.Sprintf("checked out '%s'", branch))
+		}
+	}
+
+	// 원격 브랜치가 없으면 업데이트할 것이 없음
+	if !client.HasRemoteTrackingBranch(remoteName, branch) {
+		steps = append(steps, fmt.Sprintf("no '%s/%s' to update from", remoteName, branch))
+		return strings.Join(steps, ", "), nil
+	}
+
+	// 업데이트
+	if syncRebase {
+		if err := client.Rebase(remoteName, branch); err != nil {
+			return "", err
+		}
+		steps = append(steps, "rebased")
+	} else {
+		moved, err := client.FastForward(remoteName, branch)
+		if err != nil {
+			return "", fmt.Errorf("%w\n  hint: use '--rebase' to replay local commits", err)
+		}
+		if moved {
+			steps = append(steps, "fast-forwarded")
+		} else {
+			steps = append(steps, "up to date")
+		}
+	}
+
+	return strings.Join(steps, ", "), nil
+}
+
+func GetSyncCmd() *cobra.Command {
+	return syncCmd
+}
@@ -2,14 +2,19 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/alexgim961101/multi-git/internal/config"
 	"github.com/alexgim961101/multi-git/internal/git"
 	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/spf13/cobra"
 )
 
@@ -17,26 +22,57 @@ import (
 var (
 	tagName     string // 태그 이름 (필수)
 	tagBranch   string // 브랜치 이름 (생성 시 필수)
+	tagCurrent  bool   // 각 저장소의 현재 브랜치에 태그 생성
+	tagRef      string // 태그할 커밋 또는 기존 태그 (체크아웃 없이)
 	tagMessage  string // 태그 메시지 (annotated tag)
 	tagPush     bool   // 원격에 푸시
 	tagForce    bool   // 강제 덮어쓰기
 	tagDelete   bool   // 삭제 모드
 	tagParallel int    // 병렬 처리 수
+	tagFailFast bool   // 실패 시 중단
+	tagDryRun   bool   // 시뮬레이션 모드
+	tagList     bool   // 목록 모드
+	tagPattern  string // 목록 모드 태그 이름 패턴 (glob)
+	tagContains string // 목록 모드: 이 커밋을 포함하는 태그만
+	tagOverride bool   // 보호 태그 삭제 허용
+	tagTagger   string // annotated tag의 tagger 덮어쓰기 ("Name <email>")
 )
 
 var tagCmd = &cobra.Command{
 	Use:   "tag",
 	Short: "Manage tags across multiple repositories",
-	Long: `Create, push, or delete tags across multiple repositories.
+	Long: `Create, push, delete, or list tags across multiple repositories.
 Tags can be created on a specific branch and pushed to remote.
 
+List mode prints each repository's tags with the commit they point to and
+reports tags that are missing in some repositories.
+
+Annotated tags are signed by commit_committer (or commit_author) of the config,
+or by --tagger; without either, the tagger is 'multi-git <multi-git@local>'.
+
+Deleting tags matching 'protected_tags' in the config is refused unless
+--override-protection is given.
+
 Examples:
   # Create a tag on a branch
   multi-git tag --branch release/v1.0.0 --name v1.0.0
 
+  # Create a tag on each repository's configured default_branch
+  multi-git tag --branch @default --name v1.0.0
+
+  # Tag whatever branch each repository currently has checked out
+  multi-git tag --current-branch --name snapshot-2024-06-01
+
+  # Tag the exact commit a CI build used, or re-tag an existing release
+  multi-git tag --ref 3f2c9e1 --name v1.0.1 --repos billing
+  multi-git tag --ref v1.0.0-rc.3 --name v1.0.0 --push
+
   # Create an annotated tag with message
   multi-git tag -b release/v1.0.0 -n v1.0.0 -m "Release version 1.0.0"
 
+  # Create an annotated tag as the release bot
+  multi-git tag -b main -n v1.0.0 -m "Release 1.0.0" --tagger "Release Bot <release-bot@example.com>"
+
   # Create and push tag to remote
   multi-git tag -b release/v1.0.0 -n v1.0.0 --push
 
@@ -47,7 +83,16 @@ Examples:
   multi-git tag --name v1.0.0 --delete
 
   # Delete a tag (local + remote)
-  multi-git tag --name v1.0.0 --delete --push`,
+  multi-git tag --name v1.0.0 --delete --push
+
+  # Show which commit each repository would tag
+  multi-git tag -b @default -n v1.0.0 --dry-run
+
+  # List all v1 tags and check they exist everywhere
+  multi-git tag --list --pattern 'v1.*'
+
+  # Which release tags already contain the hotfix branch?
+  multi-git tag --contains hotfix/login`,
 	Run: runTag,
 }
 
@@ -56,11 +101,19 @@ func init() {
 	tagCmd.Flags().StringVarP(&tagName, "name", "n", "",
 		"Tag name (required)")
 	tagCmd.Flags().StringVarP(&tagBranch, "branch", "b", "",
-		"Branch to create tag on (required for creation)")
+		"Branch to create tag on (required for creation, '@default' for each repo's default_branch)")
+	_ = tagCmd.RegisterFlagCompletionFunc("branch", completeBranchOrDefault)
+
+	tagCmd.Flags().BoolVar(&tagCurrent, "current-branch", false,
+		"Tag the branch currently checked out in each repository (records the branch in the annotation)")
+	tagCmd.Flags().StringVar(&tagRef, "ref", "",
+		"Create the tag on this commit or existing tag instead of a branch tip (no checkout)")
 
 	// 선택 플래그
 	tagCmd.Flags().StringVarP(&tagMessage, "message", "m", "",
 		"Tag message (creates annotated tag)")
+	tagCmd.Flags().StringVar(&tagTagger, "tagger", "",
+		"Tagger of annotated tags as 'Name <email>' (overrides commit_committer and commit_author)")
 	tagCmd.Flags().BoolVarP(&tagPush, "push", "p", false,
 		"Push tag to remote")
 	tagCmd.Flags().BoolVarP(&tagForce, "force", "f", false,
@@ -69,28 +122,71 @@ func init() {
 		"Delete tag instead of creating")
 	tagCmd.Flags().IntVar(&tagParallel, "parallel", 0,
 		"Number of parallel operations (0 = use config value)")
-
-	// --name은 항상 필수
-	tagCmd.MarkFlagRequired("name")
+	tagCmd.Flags().BoolVar(&tagFailFast, "fail-fast", false,
+		"Stop on first failure")
+	tagCmd.Flags().BoolVar(&tagDryRun, "dry-run", false,
+		"Show what would be created or deleted without changing anything")
+	tagCmd.Flags().BoolVar(&tagOverride, "override-protection", false,
+		"Allow deleting tags listed in protected_tags")
+
+	// 목록 플래그
+	tagCmd.Flags().BoolVarP(&tagList, "list", "l", false,
+		"List tags and the commits they point to")
+	tagCmd.Flags().StringVar(&tagPattern, "pattern", "",
+		"Only list tags matching this glob (e.g. 'v1.*', implies --list)")
+	tagCmd.Flags().StringVar(&tagContains, "contains", "",
+		"Only list tags containing this commit, branch, or tag (implies --list)")
 }
 
 func runTag(cmd *cobra.Command, args []string) {
 	// 1. 글로벌 플래그 가져오기
-	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
 
-	// 2. 플래그 유효성 검증: --delete가 아닐 때 --branch 필수
-	if !tagDelete && tagBranch == "" {
+	// 2. 플래그 유효성 검증
+	listMode := tagList || tagPattern != "" || tagContains != ""
+	if listMode {
+		if tagName != "" || tagBranch != "" || tagCurrent || tagRef != "" || tagDelete || tagPush || tagForce || tagMessage != "" || tagTagger != "" || tagDryRun {
+			fmt.Fprintf(os.Stderr, "Error: --list cannot be combined with tag creation or deletion flags\n")
+			fmt.Fprintf(os.Stderr, "  hint: use '--pattern' to filter listed tags by name\n")
+			os.Exit(1)
+		}
+		if _, err := path.Match(tagPattern, ""); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --pattern '%s': %v\n", tagPattern, err)
+			os.Exit(1)
+		}
+	} else if tagName == "" {
+		fmt.Fprintf(os.Stderr, "Error: --name flag is required\n")
+		fmt.Fprintf(os.Stderr, "  hint: use '--list' to show existing tags\n")
+		os.Exit(1)
+	}
+
+	// --delete가 아닐 때 --branch, --current-branch, --ref 중 하나 필수
+	targets := 0
+	for _, set := range []bool{tagBranch != "", tagCurrent, tagRef != ""} {
+		if set {
+			targets++
+		}
+	}
+	if targets > 1 {
+		fmt.Fprintf(os.Stderr, "Error: only one of --branch, --current-branch, and --ref can be used\n")
+		os.Exit(1)
+	}
+	if tagDelete && tagRef != "" {
+		fmt.Fprintf(os.Stderr, "Error: --ref cannot be combined with --delete\n")
+		os.Exit(1)
+	}
+	if !listMode && !tagDelete && targets == 0 {
 		fmt.Fprintf(os.Stderr, "Error: --branch flag is required when creating a tag\n")
-		fmt.Fprintf(os.Stderr, "  hint: use '--branch <branch-name>' to specify the branch\n")
+		fmt.Fprintf(os.Stderr, "  hint: use '--branch <branch-name>' to specify the branch, '--current-branch', or '--ref <commit|tag>'\n")
 		os.Exit(1)
 	}
 
 	// 3. 설정 파일 로드
-	cfg, err := config.LoadAndValidate(configPath)
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading config: %v\n", err)
-		os.Exit(1)
+	cfg := loadConfig(cmd)
+
+	// 보호 태그 확인 (삭제 시, 어느 저장소도 변경하기 전에 거부)
+	if tagDelete && !tagOverride {
+		exitOnProtected(fmt.Sprintf("delete protected tag '%s'", tagName), "protected_tags", protectedTagTargets(cfg, tagName))
 	}
 
 	// 4. Manager와 Reporter 생성
@@ -104,33 +200,66 @@ func runTag(cmd *cobra.Command, args []string) {
 		workers = mgr.ParallelWorkers()
 	}
 
-	// 6. 작업 모드에 따라 실행
+	// 6~7. 작업 모드에 따라 실행 및 결과 출력
 	ctx := context.Background()
 	var summary *repository.Summary
 
-	if tagDelete {
+	switch {
+	case listMode:
+		// 목록 모드 (출력 포함)
+		var present *tagPresence
+		summary, present = runTagList(ctx, cmd, mgr, reporter, workers)
+		reporter.PrintFullReportWithOutput(summary)
+		printMissingTags(reporter, summary, present)
+	case tagDelete:
 		// 삭제 모드
-		summary = runTagDelete(ctx, mgr, reporter, workers)
-	} else {
-		// 생성 모드
-		summary = runTagCreate(ctx, mgr, reporter, workers)
+		summary = runTagDelete(ctx, cmd, mgr, reporter, workers)
+		reporter.PrintFullReport(summary)
+	default:
+		// 생성 모드 (--force면 덮어쓰기 전에 undo용 스냅샷 기록)
+		undoPoint := newAutoSnapshot(cmd, mgr)
+		summary = runTagCreate(ctx, cmd, mgr, reporter, workers, undoPoint)
+		reporter.PrintFullReport(summary)
+		undoPoint.printUndoHint()
 	}
 
-	// 7. 결과 출력
-	reporter.PrintFullReport(summary)
-
-	// 실패 시 exit code 1
-	if summary.HasFailures() {
-		os.Exit(1)
-	}
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
 }
 
 // runTagCreate handles tag creation across repositories
-func runTagCreate(ctx context.Context, mgr *repository.Manager, reporter *repository.Reporter, workers int) *repository.Summary {
+// With --force, the tags about to be overwritten are recorded in undoPoint first.
+func runTagCreate(ctx context.Context, cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter, workers int, undoPoint *autoSnapshot) *repository.Summary {
 	// 헤더 출력
-	reporter.PrintHeader(fmt.Sprintf("Creating tag '%s' on branch '%s'", tagName, tagBranch))
+	headerMsg := fmt.Sprintf("Creating tag '%s' on branch '%s'", tagName, tagBranch)
+	if tagCurrent {
+		headerMsg = fmt.Sprintf("Creating tag '%s' on current branches", tagName)
+	}
+	if tagRef != "" {
+		headerMsg = fmt.Sprintf("Creating tag '%s' at '%s'", tagName, tagRef)
+
+		// 일부 저장소에만 태그가 생기지 않도록 --ref가 모든 저장소에 있는지 먼저 확인
+		if missing := reposMissingRevision(mgr, tagRef); len(missing) > 0 {
+			fmt.Fprintf(os.Stderr, "Error: '%s' does not exist in %d of %d repositories: %s\n",
+				tagRef, len(missing), mgr.RepositoryCount(), strings.Join(missing, ", "))
+			fmt.Fprintf(os.Stderr, "  hint: run 'multi-git fetch' first, or select the repositories that have it with '--repos'\n")
+			os.Exit(1)
+		}
+	}
+	if tagDryRun {
+		headerMsg += " (dry-run)"
+	}
+
+	// tagger 결정 (--tagger, 설정의 commit_committer, commit_author 순)
+	_, committer := mgr.Config().CommitIdentity()
+	tagger, err := identityFlag("tagger", tagTagger, committer)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+	reporter.PrintHeader(headerMsg)
 
-	tagCreateTask := func(repo config.Repository) repository.Result {
+	tagCreateTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{RepoName: repo.Name}
 		startTime := time.Now()
 		repoPath := mgr.GetRepositoryPath(repo)
@@ -140,68 +269,137 @@ func runTagCreate(ctx context.Context, mgr *repository.Manager, reporter *reposi
 			result.Success = false
 			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
-		client := git.NewClient(repoPath)
+		client := newGitClient(mgr.Config(), repo)
 
-		// Step 2: 브랜치 체크아웃
-		checkoutOpts := &git.CheckoutOptions{
-			Branch:     tagBranch,
-			FetchFirst: true, // 최신 상태 확보
+		var branch string
+		switch {
+		case tagRef != "":
+			// --ref: 체크아웃하지 않고 지정한 커밋에 태그
+		case tagCurrent:
+			// Step 2: 현재 체크아웃된 브랜치 사용 (체크아웃하지 않음)
+			current, err := client.GetCurrentBranch()
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("failed to get current branch: %w", err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			if current == "" {
+				result.Success = false
+				result.Error = fmt.Errorf("repository is in detached HEAD state\n  hint: checkout a branch or use '--branch'")
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			branch = current
+		default:
+			// @default 등 저장소별 브랜치 이름 해석
+			resolved, err := repo.ResolveBranch(tagBranch)
+			if err != nil {
+				result.Success = false
+				result.Error = fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+			branch = resolved
 		}
-		if err := client.Checkout(checkoutOpts); err != nil {
-			result.Success = false
-			result.Error = enhanceTagError(fmt.Errorf("failed to checkout branch '%s': %w", tagBranch, err))
+
+		// dry-run: 체크아웃하지 않고 태그될 커밋만 보고
+		if tagDryRun {
+			message, err := describeTagCreate(client, branch)
 			result.Duration = time.Since(startTime)
-			return result
+			if err != nil {
+				result.Success = false
+				result.Error = enhanceTagError(err)
+				return result, nil
+			}
+			result.Success = true
+			result.Message = message
+			return result, nil
+		}
+
+		// Step 2~4: 체크아웃, 태그 생성, 푸시 (단계별로 기록)
+		steps := repository.NewSteps(&result)
+		if tagForce {
+			// 덮어쓸 태그와 현재 상태 기록 (undo용)
+			refs := []repository.SnapshotRef{{Ref: "refs/tags/" + tagName}}
+			if tagPush {
+				refs = append(refs, repository.SnapshotRef{Remote: mgr.DefaultRemote(), Ref: "refs/tags/" + tagName})
+			}
+			if err := steps.Run("snapshot", func() error { return undoPoint.record(client, repo.Name, refs) }); err != nil {
+				return result, fmt.Errorf("failed to record undo snapshot: %w", err)
+			}
+		}
+		switch {
+		case tagRef != "":
+			steps.Skip("checkout", "--ref")
+		case tagCurrent:
+			steps.Skip("checkout", "--current-branch")
+		default:
+			checkoutOpts := &git.CheckoutOptions{
+				Branch:     branch,
+				FetchFirst: true, // 최신 상태 확보
+			}
+			if err := steps.Run("checkout", func() error { return client.Checkout(checkoutOpts) }); err != nil {
+				return result, enhanceTagError(fmt.Errorf("failed to checkout branch '%s': %w", branch, err))
+			}
 		}
 
-		// Step 3: 태그 생성
 		tagOpts := &git.TagOptions{
 			Name:      tagName,
 			Message:   tagMessage,
 			Annotated: tagMessage != "",
 			Force:     tagForce,
+			Ref:       tagRef,
+			Tagger:    tagger,
 		}
-		if err := client.CreateTag(tagOpts); err != nil {
-			result.Success = false
-			result.Error = enhanceTagError(err)
-			result.Duration = time.Since(startTime)
-			return result
+		if tagCurrent {
+			// 저장소마다 브랜치가 다르므로 annotation에 브랜치 이름 기록
+			tagOpts.Message = currentBranchTagMessage(tagMessage, branch)
+			tagOpts.Annotated = true
+		}
+		if err := steps.Run("create-tag", func() error { return client.CreateTag(tagOpts) }); err != nil {
+			return result, enhanceTagError(err)
 		}
 
-		// Step 4: 푸시 (옵션)
-		if tagPush {
-			if err := client.PushTag(tagName, mgr.DefaultRemote()); err != nil {
-				result.Success = false
-				result.Error = fmt.Errorf("tag created but push failed: %w", err)
-				result.Duration = time.Since(startTime)
-				return result
-			}
-			result.Message = "tag created and pushed"
-		} else {
+		// 태그가 가리키는 커밋
+		if commit, err := client.GetCommitAtRevision(tagName); err == nil {
+			result.SetDetail("commit", commit.Hash.String())
+		}
+
+		if !tagPush {
+			steps.Skip("push", "--push not set")
 			result.Message = "tag created"
+		} else if err := steps.Run("push", func() error { return client.PushTag(tagName, mgr.DefaultRemote()) }); err != nil {
+			return result, fmt.Errorf("tag created but push failed: %w", err)
+		} else {
+			result.Message = "tag created and pushed"
+		}
+		if tagCurrent {
+			result.Message += fmt.Sprintf(" on '%s'", branch)
 		}
 
 		result.Success = true
 		result.Duration = time.Since(startTime)
-		return result
+		return result, nil
 	}
 
 	// 실행
-	if workers > 1 {
-		return mgr.ExecuteParallel(ctx, tagCreateTask, nil)
-	}
-	return mgr.ExecuteSequential(ctx, tagCreateTask, nil)
+	return executeTasks(ctx, cmd, mgr, reporter, workers, withHooks(cmd, mgr, "tag", tagBranch, tagCreateTask, "MG_TAG="+tagName))
 }
 
 // runTagDelete handles tag deletion across repositories
-func runTagDelete(ctx context.Context, mgr *repository.Manager, reporter *repository.Reporter, workers int) *repository.Summary {
+func runTagDelete(ctx context.Context, cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter, workers int) *repository.Summary {
 	// 헤더 출력
-	reporter.PrintHeader(fmt.Sprintf("Deleting tag '%s'", tagName))
+	headerMsg := fmt.Sprintf("Deleting tag '%s'", tagName)
+	if tagDryRun {
+		headerMsg += " (dry-run)"
+	}
+	reporter.PrintHeader(headerMsg)
 
-	tagDeleteTask := func(repo config.Repository) repository.Result {
+	tagDeleteTask := func(repo config.Repository) (repository.Result, error) {
 		result := repository.Result{RepoName: repo.Name}
 		startTime := time.Now()
 		repoPath := mgr.GetRepositoryPath(repo)
@@ -211,10 +409,10 @@ func runTagDelete(ctx context.Context, mgr *repository.Manager, reporter *reposi
 			result.Success = false
 			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
-		client := git.NewClient(repoPath)
+		client := newGitClient(mgr.Config(), repo)
 
 		// Step 2: 태그 존재 확인
 		exists, err := client.TagExists(tagName)
@@ -222,48 +420,247 @@ func runTagDelete(ctx context.Context, mgr *repository.Manager, reporter *reposi
 			result.Success = false
 			result.Error = fmt.Errorf("failed to check tag: %w", err)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
 		if !exists {
 			// 태그가 없으면 스킵 (이미 삭제된 상태)
+			result.Skip("tag not found (already deleted)")
+			return result, nil
+		}
+
+		// dry-run: 삭제 대상만 보고
+		if tagDryRun {
 			result.Success = true
-			result.Message = "tag not found (already deleted)"
-			result.Duration = 0 // 스킵으로 표시
-			return result
+			if tagPush {
+				result.Message = fmt.Sprintf("would delete tag (local + %s)", mgr.DefaultRemote())
+			} else {
+				result.Message = "would delete tag (local only)"
+			}
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// Step 3~4: 로컬 태그 삭제 후 원격 태그 삭제 (옵션)
+		steps := repository.NewSteps(&result)
+		if err := steps.Run("delete-local", func() error { return client.DeleteTag(tagName) }); err != nil {
+			return result, fmt.Errorf("failed to delete local tag: %w", err)
 		}
+		if !tagPush {
+			steps.Skip("delete-remote", "--push not set")
+			result.Message = "tag deleted (local only)"
+		} else if err := steps.Run("delete-remote", func() error { return client.DeleteRemoteTag(tagName, mgr.DefaultRemote()) }); err != nil {
+			return result, fmt.Errorf("local tag deleted but remote deletion failed: %w", err)
+		} else {
+			result.Message = "tag deleted (local + remote)"
+		}
+
+		result.Success = true
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 실행
+	return executeTasks(ctx, cmd, mgr, reporter, workers, tagDeleteTask)
+}
+
+// describeTagCreate reports the commit the tag would point to without checking out the branch
+// Returns the same errors tag creation would fail with
+func describeTagCreate(client *git.Client, branch string) (string, error) {
+	// --ref는 지정한 커밋, 그 외에는 브랜치 끝
+	var commit *object.Commit
+	var err error
+	var target string
+	if tagRef != "" {
+		commit, err = client.GetCommitAtRevision(tagRef)
+		target = fmt.Sprintf("at '%s'", tagRef)
+	} else {
+		commit, err = client.GetCommitOnBranch(branch)
+		target = fmt.Sprintf("on '%s'", branch)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	exists, err := client.TagExists(tagName)
+	if err != nil {
+		return "", fmt.Errorf("failed to check tag: %w", err)
+	}
+	if exists && !tagForce {
+		return "", fmt.Errorf("tag '%s' already exists (use --force to overwrite)", tagName)
+	}
+
+	kind := "lightweight"
+	if tagMessage != "" || tagCurrent {
+		kind = "annotated"
+	}
+	message := fmt.Sprintf("would create %s tag %s (%s)", kind, target, commit.Hash.String()[:7])
+	if exists {
+		message += ", replacing the existing tag"
+	}
+	if tagPush {
+		message += ", then push it"
+	}
+	return message, nil
+}
+
+// reposMissingRevision returns the cloned repositories in which the revision does not exist
+// Repositories that are not cloned are left to fail in the task
+func reposMissingRevision(mgr *repository.Manager, rev string) []string {
+	var missing []string
+	for _, repo := range mgr.Config().Repositories {
+		if !mgr.IsGitRepository(repo) {
+			continue
+		}
+		if _, err := git.NewClient(mgr.GetRepositoryPath(repo)).GetCommitAtRevision(rev); errors.Is(err, git.ErrRevisionNotFound) {
+			missing = append(missing, repo.Name)
+		}
+	}
+	return missing
+}
+
+// tagPresence records which listed repositories have which tags
+type tagPresence struct {
+	mu     sync.Mutex
+	listed map[string]bool     // 태그 목록을 조회한 저장소
+	repos  map[string][]string // 태그 이름 -> 태그가 있는 저장소
+}
+
+// runTagList lists matching tags in every repository
+// Returns the summary and the tags found per repository
+func runTagList(ctx context.Context, cmd *cobra.Command, mgr *repository.Manager, reporter *repository.Reporter, workers int) (*repository.Summary, *tagPresence) {
+	// 헤더 출력
+	header := "Listing tags"
+	if tagPattern != "" {
+		header += fmt.Sprintf(" matching '%s'", tagPattern)
+	}
+	if tagContains != "" {
+		header += fmt.Sprintf(" containing '%s'", tagContains)
+	}
+	reporter.PrintHeader(header)
+
+	present := &tagPresence{
+		listed: make(map[string]bool),
+		repos:  make(map[string][]string),
+	}
+
+	tagListTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
 
-		// Step 3: 로컬 태그 삭제
-		if err := client.DeleteTag(tagName); err != nil {
+		// Step 1: 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
 			result.Success = false
-			result.Error = fmt.Errorf("failed to delete local tag: %w", err)
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
 			result.Duration = time.Since(startTime)
-			return result
+			return result, nil
 		}
 
-		// Step 4: 원격 태그 삭제 (옵션)
-		if tagPush {
-			if err := client.DeleteRemoteTag(tagName, mgr.DefaultRemote()); err != nil {
-				result.Success = false
-				result.Error = fmt.Errorf("local tag deleted but remote deletion failed: %w", err)
-				result.Duration = time.Since(startTime)
-				return result
+		client := newGitClient(mgr.Config(), repo)
+
+		// Step 2: 태그 조회
+		tags, err := client.ListTagInfo(tagContains)
+		if errors.Is(err, git.ErrRevisionNotFound) {
+			// 다른 저장소의 커밋일 수 있으므로 스킵
+			result.Skip(fmt.Sprintf("'%s' not found", tagContains))
+			return result, nil
+		}
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// Step 3: 패턴 필터 및 출력 구성
+		present.mu.Lock()
+		present.listed[repo.Name] = true
+		present.mu.Unlock()
+
+		var lines []string
+		for _, tag := range tags {
+			if tagPattern != "" {
+				if ok, _ := path.Match(tagPattern, tag.Name); !ok {
+					continue
+				}
 			}
-			result.Message = "tag deleted (local + remote)"
-		} else {
-			result.Message = "tag deleted (local only)"
+			kind := "lightweight"
+			if tag.Annotated {
+				kind = "annotated"
+			}
+			lines = append(lines, fmt.Sprintf("%-30s %s  (%s)", tag.Name, tag.Commit[:7], kind))
+
+			present.mu.Lock()
+			present.repos[tag.Name] = append(present.repos[tag.Name], repo.Name)
+			present.mu.Unlock()
 		}
 
 		result.Success = true
+		if len(lines) == 0 {
+			result.Skip("no matching tags")
+			return result, nil
+		}
+		result.Message = strings.Join(lines, "\n")
 		result.Duration = time.Since(startTime)
-		return result
+		return result, nil
 	}
 
-	// 실행
-	if workers > 1 {
-		return mgr.ExecuteParallel(ctx, tagDeleteTask, nil)
+	summary := executeTasks(ctx, cmd, mgr, reporter, workers, tagListTask)
+	return summary, present
+}
+
+// printMissingTags reports tags that exist in some listed repositories but not in others
+// Repositories that failed or did not have the --contains revision are not counted
+func printMissingTags(reporter *repository.Reporter, summary *repository.Summary, present *tagPresence) {
+	// 설정 순서 유지
+	var listed []string
+	for _, result := range summary.Results {
+		if present.listed[result.RepoName] {
+			listed = append(listed, result.RepoName)
+		}
+	}
+	if len(listed) < 2 || len(present.repos) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(present.repos))
+	for name := range present.repos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var lines []string
+	for _, name := range names {
+		has := make(map[string]bool, len(present.repos[name]))
+		for _, repoName := range present.repos[name] {
+			has[repoName] = true
+		}
+
+		var missing []string
+		for _, repoName := range listed {
+			if !has[repoName] {
+				missing = append(missing, repoName)
+			}
+		}
+		if len(missing) > 0 {
+			lines = append(lines, fmt.Sprintf("  %s: missing in %s", name, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(lines) == 0 {
+		reporter.PrintSuccess(fmt.Sprintf("All %d tags exist in every listed repository", len(names)))
+		return
+	}
+	reporter.PrintWarning(fmt.Sprintf("%d tags are not in every repository:\n%s", len(lines), strings.Join(lines, "\n")))
+}
+
+// currentBranchTagMessage builds the annotation for a tag created with --current-branch
+func currentBranchTagMessage(message, branch string) string {
+	if message == "" {
+		return fmt.Sprintf("Branch: %s", branch)
 	}
-	return mgr.ExecuteSequential(ctx, tagDeleteTask, nil)
+	return fmt.Sprintf("%s\n\nBranch: %s", message, branch)
 }
 
 func GetTagCmd() *cobra.Command {
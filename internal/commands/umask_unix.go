@@ -0,0 +1,10 @@
+//go:build !windows
+
+package commands
+
+import "syscall"
+
+// setUmask sets the file mode creation mask of the process (config umask)
+func setUmask(mask int) {
+	syscall.Umask(mask)
+}
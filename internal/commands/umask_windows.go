@@ -0,0 +1,6 @@
+//go:build windows
+
+package commands
+
+// setUmask does nothing on Windows, which has no umask
+func setUmask(mask int) {}
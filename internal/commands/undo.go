@@ -0,0 +1,394 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Undo 플래그 변수
+var (
+	undoSnapshot string // 되돌릴 스냅샷 ID (필수)
+	undoDryRun   bool   // 시뮬레이션 모드
+	undoYes      bool   // 확인 프롬프트 생략
+	undoForce    bool   // 로컬 변경사항 폐기
+	undoOverride bool   // 보호 브랜치/태그 되돌리기 허용
+	undoParallel int    // 병렬 처리 수
+	undoFailFast bool   // 실패 시 중단
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo --snapshot <id>",
+	Short: "Revert a force push, tag --force, or branch reset",
+	Long: `Revert a destructive operation using the snapshot recorded automatically
+before it ran.
+
+Before 'push --force', 'tag --force', and 'snapshot restore --reset-branches'
+change anything, multi-git records the branch and commit of every repository and
+the references about to be overwritten (including the remote branch tips being
+force pushed) in a snapshot named auto-<date>-<time>, and prints its ID. The
+overwritten commits are kept under refs/multi-git/snapshots/ in the clone, so
+they can be restored even if they were never fetched.
+
+Undo puts every recorded reference back: remote branches and tags are force
+pushed to their previous commit (or deleted if the operation created them), local
+tags and branches are reset, and each repository is checked out on the branch and
+commit it was at. Remote references are only changed after confirmation.
+
+Examples:
+  # Revert a force push
+  multi-git push --branch release/v1.4 --force
+  ...
+  Snapshot 'auto-20240612-153012' recorded before the operation
+  multi-git undo --snapshot auto-20240612-153012
+
+  # See what would be restored
+  multi-git undo --snapshot auto-20240612-153012 --dry-run
+
+  # The recorded snapshots
+  multi-git snapshot list`,
+	Args: cobra.NoArgs,
+	Run:  runUndo,
+}
+
+func init() {
+	undoCmd.Flags().StringVar(&undoSnapshot, "snapshot", "",
+		"ID of the snapshot to revert to (required, e.g. auto-20240612-153012)")
+	undoCmd.Flags().BoolVar(&undoDryRun, "dry-run", false,
+		"Show what would be restored without changing anything")
+	undoCmd.Flags().BoolVarP(&undoYes, "yes", "y", false,
+		"Skip the confirmation prompt")
+	undoCmd.Flags().BoolVarP(&undoForce, "force", "f", false,
+		"Discard local changes when checking out the recorded commit")
+	undoCmd.Flags().BoolVar(&undoOverride, "override-protection", false,
+		"Allow restoring branches and tags listed in protected_branches or protected_tags")
+	undoCmd.Flags().IntVarP(&undoParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	undoCmd.Flags().BoolVar(&undoFailFast, "fail-fast", false,
+		"Stop on first failure")
+
+	undoCmd.MarkFlagRequired("snapshot")
+}
+
+func runUndo(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 스냅샷 ID 검증
+	if err := repository.ValidateSnapshotName(undoSnapshot); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// 5. 스냅샷 로드
+	path := mgr.SnapshotPath(undoSnapshot)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "Error: snapshot '%s' not found\n", undoSnapshot)
+		fmt.Fprintf(os.Stderr, "  hint: run 'multi-git snapshot list' to see the recorded snapshots\n")
+		os.Exit(1)
+	}
+	snapshot, err := repository.LoadSnapshot(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 보호 브랜치/태그 확인 (어느 저장소도 변경하기 전에 거부)
+	if !undoOverride {
+		branches, tags := protectedUndoTargets(cfg, snapshot)
+		exitOnProtected("restore protected branches", "protected_branches", branches)
+		exitOnProtected("restore protected tags", "protected_tags", tags)
+	}
+
+	// 6. 안전장치: 원격 참조를 강제 푸시하기 전에 확인
+	remoteRefs := 0
+	for _, repo := range cfg.Repositories {
+		for _, ref := range snapshot.Repositories[repo.Name].Refs {
+			if ref.Remote != "" {
+				remoteRefs++
+			}
+		}
+	}
+	if remoteRefs > 0 && !undoYes && !undoDryRun {
+		if !confirmUndo(snapshot, remoteRefs) {
+			fmt.Println("Cancelled.")
+			os.Exit(0)
+		}
+	}
+
+	// 7. 병렬 수 결정
+	workers := undoParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 8. Undo Task 정의
+	undoTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+
+		entry, ok := snapshot.Repositories[repo.Name]
+		if !ok {
+			result.Skip("skipped: not in snapshot")
+			return result, nil
+		}
+		if !mgr.IsGitRepository(repo) {
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", mgr.GetRepositoryPath(repo))
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		client := newGitClient(cfg, repo)
+
+		// 덮어쓴 참조 복원 (원격은 강제 푸시, 로컬은 참조 이동)
+		// 기록된 브랜치 자체는 체크아웃과 함께 되돌려 작업 트리를 맞춤
+		var restored []string
+		resetBranch := false
+		steps := repository.NewSteps(&result)
+		for _, ref := range entry.Refs {
+			if ref.Remote == "" && entry.Branch != "" && ref.Ref == "refs/heads/"+entry.Branch {
+				resetBranch = true
+				continue
+			}
+			restored = append(restored, describeSnapshotRef(ref))
+			if undoDryRun {
+				continue
+			}
+			if err := steps.Run("restore "+describeSnapshotRef(ref), func() error { return undoRef(client, ref) }); err != nil {
+				result.Error = err
+				result.Duration = time.Since(startTime)
+				return result, nil
+			}
+		}
+
+		// 기록된 브랜치와 커밋 체크아웃
+		message, _, err := restoreSnapshotEntry(client, entry, snapshotRestoreOptions{
+			fetch:         true,
+			resetBranches: resetBranch,
+			force:         undoForce,
+			dryRun:        undoDryRun,
+		})
+		if err != nil {
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		if len(restored) > 0 {
+			verb := "restored"
+			if undoDryRun {
+				verb = "would restore"
+			}
+			message = fmt.Sprintf("%s %s; %s", verb, strings.Join(restored, ", "), message)
+		}
+
+		result.Success = true
+		result.Message = message
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 9. 작업 실행
+	header := fmt.Sprintf("Undoing to snapshot: %s (%s)", snapshot.Name, snapshot.CreatedAt.Format("2006-01-02 15:04"))
+	if snapshot.Operation != "" {
+		header += fmt.Sprintf(", before '%s'", snapshot.Operation)
+	}
+	if undoDryRun {
+		header += " (dry-run)"
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, undoTask)
+
+	// 10. 결과 출력
+	reporter.PrintFullReport(summary)
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// undoRef puts a reference back to the value recorded in the snapshot: remote
+// references are force pushed from the kept object (or deleted if they did not
+// exist), local references are moved (or deleted)
+func undoRef(client *git.Client, ref repository.SnapshotRef) error {
+	if ref.Remote == "" {
+		return client.SetRef(ref.Ref, ref.Hash)
+	}
+	if ref.Hash == "" {
+		return client.ForcePushRef(ref.Remote, "", ref.Ref)
+	}
+	return client.ForcePushRef(ref.Remote, ref.Keep, ref.Ref)
+}
+
+// describeSnapshotRef names a recorded reference, e.g. "origin/release", "tag v1.2.0"
+func describeSnapshotRef(ref repository.SnapshotRef) string {
+	var name string
+	switch {
+	case strings.HasPrefix(ref.Ref, "refs/heads/"):
+		name = strings.TrimPrefix(ref.Ref, "refs/heads/")
+		if ref.Remote != "" {
+			name = ref.Remote + "/" + name
+		}
+	case strings.HasPrefix(ref.Ref, "refs/tags/"):
+		name = "tag " + strings.TrimPrefix(ref.Ref, "refs/tags/")
+		if ref.Remote != "" {
+			name += " on " + ref.Remote
+		}
+	default:
+		name = ref.Ref
+	}
+	if ref.Hash == "" {
+		return name + " (delete)"
+	}
+	return fmt.Sprintf("%s -> %s", name, ref.Hash[:7])
+}
+
+// protectedUndoTargets returns the repositories in which undo would change branches
+// matching protected_branches or tags matching protected_tags
+func protectedUndoTargets(cfg *config.Config, snapshot *repository.Snapshot) (branches, tags []string) {
+	for _, repo := range cfg.Repositories {
+		for _, ref := range snapshot.Repositories[repo.Name].Refs {
+			if branch, ok := strings.CutPrefix(ref.Ref, "refs/heads/"); ok && cfg.IsProtectedBranch(repo, branch) {
+				branches = append(branches, fmt.Sprintf("%s (%s)", repo.Name, branch))
+			}
+			if tag, ok := strings.CutPrefix(ref.Ref, "refs/tags/"); ok && cfg.IsProtectedTag(repo, tag) {
+				tags = append(tags, fmt.Sprintf("%s (%s)", repo.Name, tag))
+			}
+		}
+	}
+	return branches, tags
+}
+
+// confirmUndo asks before remote references are force pushed back
+func confirmUndo(snapshot *repository.Snapshot, remoteRefs int) bool {
+	fmt.Println()
+	fmt.Println("⚠️  WARNING: Undo will force push remote references back to their recorded commits!")
+	fmt.Printf("   Snapshot: %s (%s)\n", snapshot.Name, snapshot.CreatedAt.Format("2006-01-02 15:04"))
+	if snapshot.Operation != "" {
+		fmt.Printf("   Recorded before: %s\n", snapshot.Operation)
+	}
+	fmt.Printf("   Remote references: %d\n", remoteRefs)
+	fmt.Println()
+	fmt.Print("Continue? [y/N]: ")
+
+	reader := bufio.NewReader(os.Stdin)
+	input, err := reader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	input = strings.TrimSpace(strings.ToLower(input))
+	return input == "y" || input == "yes"
+}
+
+// autoSnapshot records the state a destructive operation overwrites, before it
+// does, so the operation can be reverted with 'multi-git undo --snapshot <id>'
+type autoSnapshot struct {
+	path     string
+	snapshot *repository.Snapshot
+	mu       sync.Mutex
+}
+
+// newAutoSnapshot prepares a snapshot named after the current time (auto-<date>-<time>)
+// Nothing is written until the first repository is recorded.
+func newAutoSnapshot(cmd *cobra.Command, mgr *repository.Manager) *autoSnapshot {
+	base := repository.AutoSnapshotPrefix + time.Now().Format("20060102-150405")
+	name := base
+	for i := 2; ; i++ {
+		if _, err := os.Stat(mgr.SnapshotPath(name)); os.IsNotExist(err) {
+			break
+		}
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	snapshot := repository.NewSnapshot(name)
+	snapshot.Operation = describeOperation(cmd)
+	return &autoSnapshot{path: mgr.SnapshotPath(name), snapshot: snapshot}
+}
+
+// describeOperation returns the command with its arguments and the flags it was
+// given, e.g. "push --branch main --force"
+func describeOperation(cmd *cobra.Command) string {
+	parts := append([]string{strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")}, cmd.Flags().Args()...)
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		switch {
+		case cmd.InheritedFlags().Lookup(f.Name) != nil:
+			// --config 등 글로벌 플래그 제외
+		case f.Value.Type() == "bool":
+			parts = append(parts, "--"+f.Name)
+		default:
+			parts = append(parts, fmt.Sprintf("--%s %s", f.Name, f.Value.String()))
+		}
+	})
+	return strings.Join(parts, " ")
+}
+
+// record saves the branch and commit the repository is at and the current value of
+// each reference about to be overwritten, keeping their objects for undo
+// Must be called before the repository or remote is changed. The snapshot file is
+// rewritten for every repository, so an interrupted run can be undone as far as it got.
+func (a *autoSnapshot) record(client *git.Client, repoName string, refs []repository.SnapshotRef) error {
+	head, err := client.GetCommitAtRevision("HEAD")
+	if err != nil {
+		return err
+	}
+	branch, err := client.GetCurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	entry := repository.SnapshotEntry{Branch: branch, Commit: head.Hash.String()}
+	for i, ref := range refs {
+		if ref.Remote != "" {
+			ref.Hash, err = client.RemoteRefHash(ref.Remote, ref.Ref)
+		} else {
+			ref.Hash, err = client.RefHash(ref.Ref)
+		}
+		if err != nil {
+			return err
+		}
+		if ref.Hash != "" {
+			ref.Keep = fmt.Sprintf("%s%s/%d", git.KeepRefPrefix, a.snapshot.Name, i)
+			if err := client.KeepRef(ref.Keep, ref.Hash, ref.Remote, ref.Ref); err != nil {
+				return err
+			}
+		}
+		entry.Refs = append(entry.Refs, ref)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.snapshot.Repositories[repoName] = entry
+	return a.snapshot.Save(a.path)
+}
+
+// printUndoHint prints the snapshot ID and how to revert, if any repository was recorded
+func (a *autoSnapshot) printUndoHint() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if len(a.snapshot.Repositories) == 0 {
+		return
+	}
+	fmt.Printf("\nSnapshot '%s' recorded before the operation (%s)\n", a.snapshot.Name, plural(len(a.snapshot.Repositories), "repository"))
+	fmt.Printf("  undo with: multi-git undo --snapshot %s\n", a.snapshot.Name)
+}
+
+func GetUndoCmd() *cobra.Command {
+	return undoCmd
+}
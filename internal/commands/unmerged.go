@@ -0,0 +1,265 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Unmerged 플래그 변수
+var (
+	unmergedInto      string   // 병합 대상 브랜치
+	unmergedPatterns  []string // 브랜치 이름 glob (예: release/*)
+	unmergedOlderThan string   // 마지막 커밋이 이보다 오래된 브랜치만 (예: "3 months")
+	unmergedRemote    string   // 원격 이름
+	unmergedLocal     bool     // 원격 추적 브랜치 대신 로컬 브랜치 확인
+	unmergedJSON      bool     // JSON 출력
+	unmergedParallel  int      // 병렬 처리 수
+)
+
+// unmergedReport is the unmerged branches of one repository
+type unmergedReport struct {
+	Repository string               `json:"repository"`
+	Into       string               `json:"into"`
+	Branches   []git.UnmergedBranch `json:"branches"`
+}
+
+var unmergedCmd = &cobra.Command{
+	Use:   "unmerged --into <branch>",
+	Short: "List branches with commits not merged into a branch",
+	Long: `List, per repository, the branches with commits that are not merged into the
+target branch (main by default), for branch hygiene reviews: release branches
+whose fixes never made it back to main, or forgotten feature branches.
+
+Branches are the remote-tracking branches (e.g. origin/release/v1.3), so the
+list shows what was pushed as of the last fetch; run 'multi-git fetch' first
+for an up-to-date list, or use --local to check local branches. Narrow the list
+with --pattern (globs such as 'release/*') and --older-than (branches whose last
+commit is older than e.g. '3 months'). Branches are listed oldest first.
+Comparing uses the git binary.
+
+Examples:
+  # Release branches with fixes missing from main
+  multi-git unmerged --into main --pattern 'release/*'
+
+  # Stale branches for the quarterly cleanup
+  multi-git unmerged --into @default --older-than '3 months'
+
+  # Local branches not merged into develop, as JSON
+  multi-git unmerged --into develop --local --json`,
+	Args: cobra.NoArgs,
+	Run:  runUnmerged,
+}
+
+func init() {
+	unmergedCmd.Flags().StringVar(&unmergedInto, "into", "main",
+		"Branch the other branches should be merged into ('@default' for each repo's default_branch)")
+	_ = unmergedCmd.RegisterFlagCompletionFunc("into", completeBranchOrDefault)
+	unmergedCmd.Flags().StringSliceVar(&unmergedPatterns, "pattern", nil,
+		"Only list branches matching these globs (comma-separated or repeatable, e.g. 'release/*')")
+	unmergedCmd.Flags().StringVar(&unmergedOlderThan, "older-than", "",
+		"Only list branches whose last commit is older than this (e.g. '2 weeks', '3 months', '2024-01-31')")
+	unmergedCmd.Flags().StringVarP(&unmergedRemote, "remote", "r", "",
+		"Remote whose branches are checked (default: config default_remote)")
+	unmergedCmd.Flags().BoolVar(&unmergedLocal, "local", false,
+		"Check local branches instead of remote-tracking branches")
+	unmergedCmd.Flags().BoolVar(&unmergedJSON, "json", false,
+		"Print the branches as JSON")
+	unmergedCmd.Flags().IntVarP(&unmergedParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	unmergedCmd.MarkFlagsMutuallyExclusive("local", "remote")
+}
+
+func runUnmerged(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 플래그 검증
+	for _, pattern := range unmergedPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --pattern '%s': %v\n", pattern, err)
+			os.Exit(1)
+		}
+	}
+	var olderThan time.Time
+	if unmergedOlderThan != "" {
+		var err error
+		if olderThan, err = git.ParseSince(unmergedOlderThan, time.Now()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --older-than: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 4. Manager와 Reporter 생성 (JSON 출력 시 진행 상황은 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if unmergedJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 5. 병렬 수 및 원격 결정
+	workers := unmergedParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+	remoteName := unmergedRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+	prefix := "refs/remotes/" + remoteName + "/"
+	if unmergedLocal {
+		prefix = "refs/heads/"
+	}
+
+	// 저장소별 결과
+	var mu sync.Mutex
+	reports := make(map[string]*unmergedReport)
+
+	// 6. Unmerged Task 정의
+	unmergedTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+		fail := func(err error) (repository.Result, error) {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		into, err := repo.ResolveBranch(unmergedInto)
+		if err != nil {
+			return fail(err)
+		}
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath))
+		}
+		client := newGitClient(cfg, repo)
+
+		target := prefix + into
+		exists, err := client.RefExists(target)
+		if err != nil {
+			return fail(err)
+		}
+		if !exists {
+			return fail(fmt.Errorf("branch '%s' not found (%s)\n  hint: run 'multi-git fetch' first, or check --into", into, target))
+		}
+
+		branches, err := client.UnmergedBranches(prefix, target)
+		if err != nil {
+			return fail(err)
+		}
+		report := &unmergedReport{Repository: repo.Name, Into: into, Branches: []git.UnmergedBranch{}}
+		for _, branch := range branches {
+			if matchesUnmergedFilters(branch, olderThan) {
+				report.Branches = append(report.Branches, branch)
+			}
+		}
+		mu.Lock()
+		reports[repo.Name] = report
+		mu.Unlock()
+
+		result.Success = true
+		result.Message = fmt.Sprintf("%s not merged into %s", plural(len(report.Branches), "branch"), into)
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Looking for branches not merged into '%s'", unmergedInto))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, unmergedTask)
+
+	// 8. 설정 파일 순서로 출력
+	report := []*unmergedReport{}
+	for _, repo := range cfg.Repositories {
+		if r, ok := reports[repo.Name]; ok {
+			report = append(report, r)
+		}
+	}
+	if unmergedJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode unmerged branches: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		printUnmergedTable(report)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// matchesUnmergedFilters checks the branch against --pattern and --older-than
+func matchesUnmergedFilters(branch git.UnmergedBranch, olderThan time.Time) bool {
+	if !olderThan.IsZero() && !branch.LastCommit.Before(olderThan) {
+		return false
+	}
+	if len(unmergedPatterns) == 0 {
+		return true
+	}
+	for _, pattern := range unmergedPatterns {
+		if ok, _ := path.Match(pattern, branch.Name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// printUnmergedTable prints the unmerged branches of all repositories as an aligned table
+func printUnmergedTable(report []*unmergedReport) {
+	table := [][]string{{"REPOSITORY", "BRANCH", "UNMERGED", "LAST COMMIT", "AUTHOR"}}
+	for _, r := range report {
+		for _, branch := range r.Branches {
+			table = append(table, []string{
+				r.Repository,
+				branch.Name,
+				fmt.Sprintf("%d commit(s)", branch.Commits),
+				branch.LastCommit.Format("2006-01-02"),
+				branch.Author,
+			})
+		}
+	}
+	if len(table) == 1 {
+		return
+	}
+
+	widths := make([]int, len(table[0]))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	fmt.Println()
+	for _, cells := range table {
+		line := make([]string, len(cells))
+		for i, cell := range cells {
+			line[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		fmt.Println(strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+func GetUnmergedCmd() *cobra.Command {
+	return unmergedCmd
+}
@@ -0,0 +1,207 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Unpushed 플래그 변수
+var (
+	unpushedJSON     bool // JSON 출력
+	unpushedParallel int  // 병렬 처리 수
+)
+
+// unpushedRow is one local branch with commits that are not pushed
+type unpushedRow struct {
+	Repository string `json:"repository"`
+	Branch     string `json:"branch"`
+	Upstream   string `json:"upstream,omitempty"` // 업스트림 (없으면 생략)
+	Gone       bool   `json:"gone,omitempty"`     // 업스트림이 원격에서 삭제됨
+	Commits    int    `json:"commits"`            // 푸시되지 않은 커밋 수
+}
+
+var unpushedCmd = &cobra.Command{
+	Use:   "unpushed",
+	Short: "List local commits that are not pushed",
+	Long: `List the local branches of every repository that have commits not present on
+their upstream, so nothing is stranded on a machine before a vacation or a
+rebuild.
+
+A branch with an upstream counts the commits its upstream does not have. A
+branch without an upstream, or whose upstream was deleted on the remote, counts
+the commits that are on no remote-tracking branch at all. Upstreams are
+compared as of the last fetch; run 'multi-git fetch' first for an up-to-date
+view. Comparing uses the git binary.
+
+Repositories with unpushed commits fail, so the exit code is 1 if anything
+would be lost with the machine.
+
+Examples:
+  # Is everything pushed?
+  multi-git unpushed
+
+  # Against the current state of the remotes
+  multi-git fetch && multi-git unpushed
+
+  # For a script
+  multi-git unpushed --json`,
+	Args: cobra.NoArgs,
+	Run:  runUnpushed,
+}
+
+func init() {
+	unpushedCmd.Flags().BoolVar(&unpushedJSON, "json", false,
+		"Print the unpushed branches as JSON")
+	unpushedCmd.Flags().IntVarP(&unpushedParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+}
+
+func runUnpushed(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성 (JSON 출력 시 진행 상황은 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if unpushedJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 4. 병렬 수 결정
+	workers := unpushedParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	// 저장소별 푸시되지 않은 브랜치
+	var mu sync.Mutex
+	unpushed := make(map[string][]git.UnpushedBranch)
+
+	// 5. Unpushed Task 정의
+	unpushedTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		branches, err := newGitClient(cfg, repo).UnpushedBranches()
+		if err != nil {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		mu.Lock()
+		unpushed[repo.Name] = branches
+		mu.Unlock()
+
+		if len(branches) > 0 {
+			var parts []string
+			for _, branch := range branches {
+				parts = append(parts, fmt.Sprintf("%s (%d)", branch.Branch, branch.Commits))
+			}
+			result.Success = false
+			result.Error = fmt.Errorf("unpushed commits on %s\n  hint: push them with 'multi-git push --branch <branch>' or 'git push' in %s",
+				strings.Join(parts, ", "), repoPath)
+		} else {
+			result.Success = true
+			result.Message = "everything pushed"
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 6. 작업 실행
+	reporter.PrintHeader("Looking for unpushed commits")
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, unpushedTask)
+
+	// 7. 설정 파일 순서로 출력
+	rows := []unpushedRow{}
+	for _, repo := range cfg.Repositories {
+		for _, branch := range unpushed[repo.Name] {
+			rows = append(rows, unpushedRow{
+				Repository: repo.Name,
+				Branch:     branch.Branch,
+				Upstream:   branch.Upstream,
+				Gone:       branch.Gone,
+				Commits:    branch.Commits,
+			})
+		}
+	}
+	if unpushedJSON {
+		data, err := json.MarshalIndent(rows, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode unpushed branches: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else if len(rows) > 0 {
+		printUnpushedTable(rows)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// upstreamText describes the upstream of the row: its name, "(gone)" or "(none)"
+func (r unpushedRow) upstreamText() string {
+	switch {
+	case r.Upstream == "":
+		return "(none)"
+	case r.Gone:
+		return r.Upstream + " (gone)"
+	default:
+		return r.Upstream
+	}
+}
+
+// printUnpushedTable prints the unpushed branches as an aligned table
+func printUnpushedTable(rows []unpushedRow) {
+	table := [][]string{{"REPOSITORY", "BRANCH", "UPSTREAM", "UNPUSHED"}}
+	for _, row := range rows {
+		table = append(table, []string{row.Repository, row.Branch, row.upstreamText(), fmt.Sprintf("%d commit(s)", row.Commits)})
+	}
+
+	widths := make([]int, len(table[0]))
+	for _, cells := range table {
+		for i, cell := range cells {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	fmt.Println()
+	for _, cells := range table {
+		line := make([]string, len(cells))
+		for i, cell := range cells {
+			line[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		fmt.Println(strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+func GetUnpushedCmd() *cobra.Command {
+	return unpushedCmd
+}
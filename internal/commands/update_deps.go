@@ -0,0 +1,387 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/deps"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/provider"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/spf13/cobra"
+)
+
+// Update-deps 플래그 변수
+var (
+	updateDepsMatch       []string      // 업데이트할 의존성 이름 패턴 (필수)
+	updateDepsVersion     string        // 대상 버전 (기본: latest)
+	updateDepsBranch      string        // 생성할 브랜치 이름
+	updateDepsFrom        string        // 브랜치 시작 지점 (기본: 현재 브랜치)
+	updateDepsTest        string        // 테스트 명령어 (설정의 test_command 덮어씀)
+	updateDepsTestTimeout time.Duration // 테스트 제한 시간
+	updateDepsRemote      string        // 원격 이름
+	updateDepsPush        bool          // 브랜치 푸시
+	updateDepsOpenPR      bool          // PR 생성 (--push 포함)
+	updateDepsDryRun      bool          // 시뮬레이션 모드
+	updateDepsParallel    int           // 병렬 처리 수
+	updateDepsFailFast    bool          // 실패 시 중단
+)
+
+var updateDepsCmd = &cobra.Command{
+	Use:   "update-deps --match <pattern>",
+	Short: "Update matching dependencies and test them in every repository",
+	Long: `Update the dependencies matching --match in the go.mod and package.json at the
+root of every repository, run the repository's tests, and commit the update on
+a new branch only where the tests pass. All matching dependencies of a
+repository are updated together in one commit.
+
+For each repository:
+  1. the branch (default: update-deps) is created from the current branch or --from
+  2. matching dependencies are updated with go get / npm install (or yarn, pnpm)
+  3. the test command runs: --test, the repository's test_command in the config,
+     or 'go test ./...' / 'npm test' depending on the manifest
+  4. if the tests pass, the manifest and lock files are committed (and pushed
+     with --push); otherwise the branch is deleted
+  5. with --open-pr, a pull request (GitLab: merge request) from the branch
+     into the starting branch is opened through the GitHub or GitLab API,
+     using the token in $GITHUB_TOKEN or $GITLAB_TOKEN
+
+Afterwards each repository is back on the branch it was on. Repositories with
+uncommitted changes fail; repositories without matching dependencies or where
+they are already up to date are skipped.
+
+Examples:
+  # Preview which dependencies would be updated
+  multi-git update-deps --match "github.com/org/*" --dry-run
+
+  # Update the organization's Go modules and npm packages and open pull requests
+  multi-git update-deps --match "github.com/org/*" --match "@org/*" --open-pr
+
+  # Pin a version and use a faster test command
+  multi-git update-deps --match github.com/org/logging --version v1.8.0 --test "make test-unit"`,
+	Args: cobra.NoArgs,
+	Run:  runUpdateDeps,
+}
+
+func init() {
+	updateDepsCmd.Flags().StringSliceVar(&updateDepsMatch, "match", nil,
+		"Dependency name pattern, e.g. 'github.com/org/*' or '@org/*' (required, repeatable)")
+	updateDepsCmd.Flags().StringVar(&updateDepsVersion, "version", "latest",
+		"Version to update to (passed to go get / npm install)")
+	updateDepsCmd.Flags().StringVarP(&updateDepsBranch, "branch", "b", "update-deps",
+		"Name of the branch to commit the update on")
+	updateDepsCmd.Flags().StringVar(&updateDepsFrom, "from", "",
+		"Branch to start the update branch from (default: current branch, '@default' = default_branch)")
+	_ = updateDepsCmd.RegisterFlagCompletionFunc("from", completeBranchOrDefault)
+	updateDepsCmd.Flags().StringVar(&updateDepsTest, "test", "",
+		"Test command for every repository (default: test_command in the config, or by manifest)")
+	updateDepsCmd.Flags().DurationVar(&updateDepsTestTimeout, "test-timeout", 10*time.Minute,
+		"Time limit of the update and test commands of a repository")
+	updateDepsCmd.Flags().StringVarP(&updateDepsRemote, "remote", "r", "",
+		"Remote to push to (default: config default_remote)")
+	updateDepsCmd.Flags().BoolVar(&updateDepsPush, "push", false,
+		"Push the update branch of repositories whose tests pass")
+	updateDepsCmd.Flags().BoolVar(&updateDepsOpenPR, "open-pr", false,
+		"Push the update branch and open a pull request ($GITHUB_TOKEN / $GITLAB_TOKEN)")
+	updateDepsCmd.Flags().BoolVar(&updateDepsDryRun, "dry-run", false,
+		"Show which dependencies would be updated without changing anything")
+	updateDepsCmd.Flags().IntVarP(&updateDepsParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	updateDepsCmd.Flags().BoolVar(&updateDepsFailFast, "fail-fast", false,
+		"Stop on first failure")
+
+	updateDepsCmd.MarkFlagRequired("match")
+}
+
+func runUpdateDeps(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 패턴 검증
+	for _, pattern := range updateDepsMatch {
+		if err := guard.ValidatePattern(pattern); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --match: %v\n", err)
+			os.Exit(1)
+		}
+	}
+	push := updateDepsPush || updateDepsOpenPR
+
+	// 3. 설정 파일 로드
+	cfg := loadConfig(cmd)
+	author, committer, _ := commitSignatures(cfg, "", "") // 플래그가 없으므로 설정 값만 사용 (실패하지 않음)
+
+	// 4. Manager와 Reporter 생성
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+
+	// --open-pr: 푸시하기 전에 모든 저장소의 PR을 열 수 있는지 확인
+	if updateDepsOpenPR && !updateDepsDryRun {
+		if err := checkPullRequestTokens(cfg, mgr.Repositories()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// 5. 병렬 수 및 원격 결정
+	workers := updateDepsParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+
+	remoteName := updateDepsRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 테스트를 통과하지 못한 저장소 (요약에 따로 표시)
+	var mu sync.Mutex
+	var testFailures []string
+
+	// 6. Update-deps Task 정의
+	updateTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		fail := func(err error) repository.Result {
+			result.Success = false
+			result.Error = err
+			result.Duration = time.Since(startTime)
+			return result
+		}
+		skip := func(message string) repository.Result {
+			result.Skip(message)
+			return result
+		}
+
+		// Step 1: 저장소 존재 확인
+		if !mgr.IsGitRepository(repo) {
+			return fail(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)), nil
+		}
+
+		client := newGitClient(cfg, repo)
+
+		// Step 2: 업데이트할 의존성 확인
+		matched, err := deps.Find(repoPath, updateDepsMatch)
+		if err != nil {
+			return fail(err), nil
+		}
+		if len(matched) == 0 {
+			return skip("no matching dependencies"), nil
+		}
+
+		// Step 3: 시작 브랜치 및 테스트 명령어 결정
+		currentBranch, err := client.GetCurrentBranch()
+		if err != nil {
+			return fail(fmt.Errorf("failed to get current branch: %w", err)), nil
+		}
+		base := currentBranch
+		if updateDepsFrom != "" {
+			base, err = repo.ResolveBranch(updateDepsFrom)
+			if err != nil {
+				return fail(fmt.Errorf("%w\n  hint: set 'default_branch' for this repository in the config", err)), nil
+			}
+		}
+		if base == "" {
+			return fail(fmt.Errorf("repository is in detached HEAD state\n  hint: use '--from' to choose the branch to start from")), nil
+		}
+		testCommand := updateDepsTest
+		if testCommand == "" {
+			testCommand = repo.TestCommand
+		}
+		if testCommand == "" {
+			testCommand = deps.DefaultTestCommand(repoPath)
+		}
+
+		if updateDepsDryRun {
+			result.Success = true
+			result.Message = fmt.Sprintf("would update %s on '%s' from '%s', then run '%s'",
+				formatDependencies(matched), updateDepsBranch, base, testCommand)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		// Step 4: 작업 전 검사
+		hasChanges, err := client.HasLocalChanges()
+		if err != nil {
+			return fail(fmt.Errorf("failed to check local changes: %w", err)), nil
+		}
+		if hasChanges {
+			return fail(fmt.Errorf("repository has uncommitted changes\n  hint: commit or stash them first")), nil
+		}
+		if exists, _ := client.BranchExists(updateDepsBranch); exists {
+			return fail(fmt.Errorf("branch '%s' already exists\n  hint: delete it or use '--branch' to choose another name", updateDepsBranch)), nil
+		}
+
+		// Step 5: 업데이트 브랜치 생성 및 체크아웃
+		if err := client.CreateBranch(updateDepsBranch, base); err != nil {
+			return fail(err), nil
+		}
+		if err := client.Checkout(&git.CheckoutOptions{Branch: updateDepsBranch}); err != nil {
+			_ = client.DeleteBranch(updateDepsBranch)
+			return fail(enhanceCheckoutError(err, updateDepsBranch)), nil
+		}
+		// 원래 브랜치로 복귀 (discard: 업데이트 브랜치와 변경사항 삭제)
+		restore := func(discard bool) {
+			if currentBranch != "" {
+				_ = client.Checkout(&git.CheckoutOptions{Branch: currentBranch, Force: discard})
+			}
+			if discard {
+				_ = client.DeleteBranch(updateDepsBranch)
+			}
+		}
+
+		// Step 6: 의존성 업데이트
+		for _, command := range deps.UpdateCommands(repoPath, matched, updateDepsVersion) {
+			if output, err := shell.ExecuteWithTimeout(repoPath, shell.DefaultShell(), command, updateDepsTestTimeout); err != nil {
+				restore(true)
+				return fail(fmt.Errorf("'%s' failed: %w\n%s", command, err, lastLines(output, 10))), nil
+			}
+		}
+		updated, err := deps.Find(repoPath, updateDepsMatch)
+		if err != nil {
+			restore(true)
+			return fail(err), nil
+		}
+		changes := changedDependencies(matched, updated)
+		if len(changes) == 0 {
+			restore(true)
+			return skip(fmt.Sprintf("already up to date (%s)", plural(len(matched), "dependency"))), nil
+		}
+
+		// Step 7: 테스트 (실패하면 커밋하지 않고 브랜치 삭제)
+		if testCommand != "" {
+			if output, err := shell.ExecuteWithTimeout(repoPath, shell.DefaultShell(), testCommand, updateDepsTestTimeout); err != nil {
+				restore(true)
+				mu.Lock()
+				testFailures = append(testFailures, repo.Name)
+				mu.Unlock()
+				return fail(fmt.Errorf("tests failed after updating %s, not committed: '%s': %w\n%s",
+					plural(len(changes), "dependency"), testCommand, err, lastLines(output, 10))), nil
+			}
+		}
+
+		// Step 8: 매니페스트와 lock 파일만 커밋
+		message := fmt.Sprintf("Update %s\n\n%s\n", updateSubject(changes), strings.Join(changes, "\n"))
+		if _, err := client.Commit(&git.CommitOptions{Message: message, Include: deps.Files(matched), Author: author, Committer: committer}); err != nil {
+			restore(true)
+			if errors.Is(err, git.ErrNothingToCommit) {
+				return skip("already up to date"), nil
+			}
+			return fail(err), nil
+		}
+		result.Message = fmt.Sprintf("updated %s on '%s', tests passed", plural(len(changes), "dependency"), updateDepsBranch)
+		result.SetDetail("dependencies", changes)
+
+		// Step 9: 푸시 및 PR 생성
+		if push {
+			if err := client.Push(&git.PushOptions{Branch: updateDepsBranch, Remote: remoteName}); err != nil {
+				restore(false)
+				return fail(fmt.Errorf("committed on '%s' but push failed: %w", updateDepsBranch, err)), nil
+			}
+			_ = client.SetUpstream(updateDepsBranch, remoteName)
+			result.Message += ", pushed"
+		}
+		restore(false)
+		if updateDepsOpenPR {
+			body := fmt.Sprintf("Updates %s:\n\n%s", plural(len(changes), "dependency"), markdownList(changes))
+			if testCommand != "" {
+				body += fmt.Sprintf("\n\nTests passed: `%s`", testCommand)
+			}
+			link, err := openPullRequest(mgr.TaskContext(repo.Name), cfg, repo, provider.PullRequest{
+				Title: "Update " + updateSubject(changes),
+				Body:  body,
+				Head:  updateDepsBranch,
+				Base:  base,
+			})
+			if err != nil {
+				return fail(fmt.Errorf("pushed '%s' but could not open a pull request: %w\n  hint: open it at %s",
+					updateDepsBranch, err, pullRequestURL(cfg, repo, base, updateDepsBranch))), nil
+			}
+			result.Message += "\n    pull request: " + link
+			result.SetDetail("pull_request", link)
+		}
+
+		result.Success = true
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 7. 작업 실행
+	header := fmt.Sprintf("Updating dependencies matching %s", strings.Join(updateDepsMatch, ", "))
+	if updateDepsDryRun {
+		header += " (dry-run)"
+	}
+	reporter.PrintHeader(header)
+
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, updateTask)
+
+	// 8. 결과 출력
+	reporter.PrintFullReport(summary)
+	if len(testFailures) > 0 {
+		fmt.Printf("\nNot updated, tests failed: %s\n", strings.Join(testFailures, ", "))
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// formatDependencies returns "name (version), ..." for a preview
+func formatDependencies(dependencies []deps.Dependency) string {
+	names := make([]string, 0, len(dependencies))
+	for _, dep := range dependencies {
+		names = append(names, fmt.Sprintf("%s (%s)", dep.Name, dep.Version))
+	}
+	return strings.Join(names, ", ")
+}
+
+// changedDependencies returns "name old -> new" for each dependency whose version changed
+func changedDependencies(before, after []deps.Dependency) []string {
+	versions := make(map[string]string)
+	for _, dep := range after {
+		versions[dep.Manifest+" "+dep.Name] = dep.Version
+	}
+	var changes []string
+	for _, dep := range before {
+		if version, ok := versions[dep.Manifest+" "+dep.Name]; ok && version != dep.Version {
+			changes = append(changes, fmt.Sprintf("%s %s -> %s", dep.Name, dep.Version, version))
+		}
+	}
+	return changes
+}
+
+// markdownList returns the items as a Markdown bullet list
+func markdownList(items []string) string {
+	return "- " + strings.Join(items, "\n- ")
+}
+
+// updateSubject returns the commit subject for the changes (the name for one dependency)
+func updateSubject(changes []string) string {
+	if len(changes) == 1 {
+		name, _, _ := strings.Cut(changes[0], " ")
+		return name
+	}
+	return plural(len(changes), "dependency")
+}
+
+// lastLines returns the last n lines of command output
+func lastLines(output string, n int) string {
+	lines := strings.Split(strings.TrimRight(output, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+func GetUpdateDepsCmd() *cobra.Command {
+	return updateDepsCmd
+}
@@ -0,0 +1,335 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// Verify 플래그 변수
+var (
+	verifyTag      string // 확인할 릴리스 태그 (필수)
+	verifyBranch   string // 릴리스 브랜치 (필수)
+	verifyRemote   string // 원격 이름
+	verifyMarkdown bool   // Markdown 출력 (릴리스 승인용)
+	verifyJSON     bool   // JSON 출력
+	verifyParallel int    // 병렬 처리 수
+)
+
+// 확인 항목 상태
+const (
+	checkOK      = "ok"
+	checkMissing = "missing"
+	checkDiffers = "differs"
+	checkSkipped = "-" // 앞선 항목이 실패해 확인할 수 없음
+)
+
+// releaseVerification is the result of the release checks in one repository
+type releaseVerification struct {
+	Repository   string `json:"repository"`
+	Branch       string `json:"branch"`
+	Commit       string `json:"commit,omitempty"` // 태그가 가리키는 커밋
+	Tag          string `json:"tag"`              // 로컬 태그 (ok, missing)
+	RemoteTag    string `json:"remote_tag"`       // 원격 태그 (ok, missing, differs)
+	TagAtTip     string `json:"tag_at_tip"`       // 태그가 로컬 브랜치 끝을 가리킴 (ok, differs, missing = 브랜치 없음)
+	BranchPushed string `json:"branch_pushed"`    // 원격 브랜치가 로컬과 같음 (ok, missing, differs)
+	Error        string `json:"error,omitempty"`  // 확인하지 못한 이유 (예: 클론되지 않음)
+	Verified     bool   `json:"verified"`
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify --tag <tag> --branch <branch>",
+	Short: "Check that a release is tagged and pushed in every repository",
+	Long: `Check in every repository that a release was completed:
+
+  - the tag exists locally
+  - the tag exists on the remote and points to the same commit
+  - the tag points to the tip of the release branch
+  - the branch on the remote is the same as the local branch
+
+The remote is asked directly (like 'git ls-remote'), so the result reflects
+what was actually pushed. The result is printed as a table, one row per
+repository, for release sign-off; use --markdown to paste it into a ticket.
+
+Exits with code 1 if any repository fails a check.
+
+Examples:
+  # Sign off a release
+  multi-git verify --tag v1.2.0 --branch release/v1.2.0
+
+  # Releases cut from each repository's default branch
+  multi-git verify --tag v1.2.0 --branch @default
+
+  # The compliance table for the release ticket
+  multi-git verify --tag v1.2.0 --branch release/v1.2.0 --markdown > sign-off.md`,
+	Args: cobra.NoArgs,
+	Run:  runVerify,
+}
+
+func init() {
+	verifyCmd.Flags().StringVar(&verifyTag, "tag", "",
+		"Release tag to verify (required)")
+	verifyCmd.Flags().StringVarP(&verifyBranch, "branch", "b", "",
+		"Release branch the tag must point to (required, '@default' for each repo's default_branch)")
+	_ = verifyCmd.RegisterFlagCompletionFunc("branch", completeBranchOrDefault)
+	verifyCmd.Flags().StringVarP(&verifyRemote, "remote", "r", "",
+		"Remote to verify against (default: config default_remote)")
+	verifyCmd.Flags().BoolVar(&verifyMarkdown, "markdown", false,
+		"Print the table as Markdown")
+	verifyCmd.Flags().BoolVar(&verifyJSON, "json", false,
+		"Print the result as JSON")
+	verifyCmd.Flags().IntVarP(&verifyParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+
+	verifyCmd.MarkFlagRequired("tag")
+	verifyCmd.MarkFlagRequired("branch")
+	verifyCmd.MarkFlagsMutuallyExclusive("markdown", "json")
+}
+
+func runVerify(cmd *cobra.Command, args []string) {
+	// 1. 글로벌 플래그 가져오기
+	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+
+	// 3. Manager와 Reporter 생성 (보고서 출력 시 진행 상황은 stderr로)
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.SetVerbose(verbose)
+	if verifyMarkdown || verifyJSON {
+		reporter.SetOutput(os.Stderr)
+	}
+
+	// 4. 병렬 수 및 원격 결정
+	workers := verifyParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+	remoteName := verifyRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 저장소별 확인 결과
+	var mu sync.Mutex
+	verifications := make(map[string]*releaseVerification)
+
+	// 5. Verify Task 정의
+	verifyTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+		repoPath := mgr.GetRepositoryPath(repo)
+
+		verification := &releaseVerification{Repository: repo.Name, Branch: verifyBranch}
+		finish := func(err error) (repository.Result, error) {
+			if err != nil {
+				verification.Error = strings.SplitN(err.Error(), "\n", 2)[0]
+			}
+			mu.Lock()
+			verifications[repo.Name] = verification
+			mu.Unlock()
+
+			result.Success = err == nil
+			result.Error = err
+			if err == nil {
+				result.Message = fmt.Sprintf("%s verified at %s", verifyTag, verification.Commit[:7])
+			}
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		branch, err := repo.ResolveBranch(verifyBranch)
+		if err != nil {
+			return finish(err)
+		}
+		verification.Branch = branch
+
+		if !mgr.IsGitRepository(repo) {
+			return finish(fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath))
+		}
+
+		refs, err := newGitClient(cfg, repo).ReleaseRefs(verifyTag, branch, remoteName)
+		if err != nil {
+			return finish(err)
+		}
+		verification.check(refs)
+		if problems := verification.problems(remoteName); len(problems) > 0 {
+			return finish(fmt.Errorf("not verified: %s", strings.Join(problems, ", ")))
+		}
+		return finish(nil)
+	}
+
+	// 6. 작업 실행
+	reporter.PrintHeader(fmt.Sprintf("Verifying release %s on '%s'", verifyTag, verifyBranch))
+	summary := executeTasks(context.Background(), cmd, mgr, reporter, workers, verifyTask)
+
+	// 7. 설정 파일 순서로 보고서 출력
+	var report []*releaseVerification
+	for _, repo := range cfg.Repositories {
+		if verification, ok := verifications[repo.Name]; ok {
+			report = append(report, verification)
+		}
+	}
+	switch {
+	case verifyJSON:
+		if report == nil {
+			report = []*releaseVerification{}
+		}
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: failed to encode verification: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	case verifyMarkdown:
+		printVerificationMarkdown(report, remoteName)
+	default:
+		printVerificationTable(report)
+	}
+	reporter.PrintSummary(summary)
+	if verbose || summary.HasFailures() {
+		reporter.PrintFailedDetails(summary)
+	}
+
+	// 실패 시 exit code 설정 (에러 예산 적용)
+	exitOnFailures(cmd, summary)
+}
+
+// check fills in the status of each check from the references
+func (v *releaseVerification) check(refs *git.ReleaseRefs) {
+	v.Commit = refs.LocalTag
+	v.Tag = checkPresent(refs.LocalTag)
+
+	v.RemoteTag = checkPresent(refs.RemoteTag)
+	if refs.LocalTag != "" && refs.RemoteTag != "" && refs.LocalTag != refs.RemoteTag {
+		v.RemoteTag = checkDiffers
+	}
+
+	switch {
+	case refs.LocalTag == "":
+		v.TagAtTip = checkSkipped
+	case refs.LocalBranch == "":
+		v.TagAtTip = checkMissing
+	case refs.LocalTag != refs.LocalBranch:
+		v.TagAtTip = checkDiffers
+	default:
+		v.TagAtTip = checkOK
+	}
+
+	switch {
+	case refs.LocalBranch == "":
+		v.BranchPushed = checkSkipped
+	case refs.RemoteBranch == "":
+		v.BranchPushed = checkMissing
+	case refs.LocalBranch != refs.RemoteBranch:
+		v.BranchPushed = checkDiffers
+	default:
+		v.BranchPushed = checkOK
+	}
+
+	v.Verified = v.Tag == checkOK && v.RemoteTag == checkOK && v.TagAtTip == checkOK && v.BranchPushed == checkOK
+}
+
+// problems describes the failed checks (empty if the release is verified)
+func (v *releaseVerification) problems(remoteName string) []string {
+	var problems []string
+	if v.Tag != checkOK {
+		problems = append(problems, fmt.Sprintf("tag %s missing", verifyTag))
+	}
+	switch v.RemoteTag {
+	case checkMissing:
+		problems = append(problems, fmt.Sprintf("tag not pushed to %s", remoteName))
+	case checkDiffers:
+		problems = append(problems, fmt.Sprintf("tag on %s points to another commit", remoteName))
+	}
+	if v.TagAtTip == checkDiffers {
+		problems = append(problems, fmt.Sprintf("tag is not at the tip of '%s'", v.Branch))
+	}
+	switch v.BranchPushed {
+	case checkSkipped:
+		problems = append(problems, fmt.Sprintf("branch '%s' missing", v.Branch))
+	case checkMissing:
+		problems = append(problems, fmt.Sprintf("branch '%s' missing on %s", v.Branch, remoteName))
+	case checkDiffers:
+		problems = append(problems, fmt.Sprintf("branch '%s' differs from %s/%s", v.Branch, remoteName, v.Branch))
+	}
+	return problems
+}
+
+// cells returns the table cells of the verification after the repository name
+func (v *releaseVerification) cells() []string {
+	if v.Error != "" && v.Tag == "" {
+		return []string{v.Branch, "error: " + v.Error, "", "", "", ""}
+	}
+	commit := checkSkipped
+	if v.Commit != "" {
+		commit = v.Commit[:7]
+	}
+	return []string{v.Branch, commit, v.Tag, v.RemoteTag, v.TagAtTip, v.BranchPushed}
+}
+
+// verificationHeader are the column titles of the verification table
+var verificationHeader = []string{"REPOSITORY", "BRANCH", "COMMIT", "TAG", "REMOTE TAG", "TAG AT TIP", "BRANCH PUSHED"}
+
+// printVerificationTable prints the verification as an aligned table
+func printVerificationTable(report []*releaseVerification) {
+	rows := [][]string{verificationHeader}
+	for _, v := range report {
+		rows = append(rows, append([]string{v.Repository}, v.cells()...))
+	}
+
+	widths := make([]int, len(verificationHeader))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	fmt.Println()
+	for _, row := range rows {
+		line := make([]string, len(row))
+		for i, cell := range row {
+			line[i] = fmt.Sprintf("%-*s", widths[i], cell)
+		}
+		fmt.Println(strings.TrimRight(strings.Join(line, "  "), " "))
+	}
+}
+
+// printVerificationMarkdown prints the verification as a Markdown table for release sign-off
+func printVerificationMarkdown(report []*releaseVerification, remoteName string) {
+	verified := 0
+	for _, v := range report {
+		if v.Verified {
+			verified++
+		}
+	}
+
+	fmt.Printf("## Release %s\n\n", verifyTag)
+	fmt.Printf("%d of %d repositories verified against `%s`.\n\n", verified, len(report), remoteName)
+	fmt.Printf("| %s |\n", strings.Join(verificationHeader, " | "))
+	fmt.Printf("|%s\n", strings.Repeat("---|", len(verificationHeader)))
+	for _, v := range report {
+		fmt.Printf("| %s | %s |\n", v.Repository, strings.Join(v.cells(), " | "))
+	}
+}
+
+// checkPresent returns ok if the hash is set, otherwise missing
+func checkPresent(hash string) string {
+	if hash == "" {
+		return checkMissing
+	}
+	return checkOK
+}
+
+func GetVerifyCmd() *cobra.Command {
+	return verifyCmd
+}
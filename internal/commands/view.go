@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/view"
+	"github.com/spf13/cobra"
+)
+
+// View 플래그 변수
+var (
+	viewDir string // 뷰 디렉토리 (기본: <config dir>/views/<name>)
+)
+
+var viewCmd = &cobra.Command{
+	Use:   "view",
+	Short: "Manage workspace views (directories of symlinks to repositories)",
+	Long: `A view is a directory of symlinks to a subset of repositories, giving
+IDE workspaces a curated set of projects without moving the clones.`,
+}
+
+var viewCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create or refresh a view",
+	Long: `Create or refresh a directory of symlinks, one per selected repository.
+
+Repositories are selected with the global --group or --interactive flags.
+Without them, the repositories of the group named <name> are used if such a
+group exists, otherwise all repositories.
+
+Running create again refreshes the view: links are added for new
+repositories and stale links into base_dir are removed. Other files in the
+directory are never touched.
+
+Examples:
+  # Link all repositories of the 'frontend' group into ~/work/frontend
+  multi-git view create frontend --dir ~/work/frontend
+
+  # Hand-pick repositories for a view
+  multi-git view create release -i`,
+	Args: cobra.ExactArgs(1),
+	Run:  runViewCreate,
+}
+
+var viewRemoveCmd = &cobra.Command{
+	Use:   "remove <name>",
+	Short: "Remove the repository links of a view",
+	Long: `Remove all symlinks into base_dir from the view directory.
+The directory itself is removed if nothing else is left in it.`,
+	Args: cobra.ExactArgs(1),
+	Run:  runViewRemove,
+}
+
+func init() {
+	viewCmd.PersistentFlags().StringVar(&viewDir, "dir", "",
+		"View directory (default: 'views/<name>' next to the config file)")
+
+	viewCmd.AddCommand(viewCreateCmd)
+	viewCmd.AddCommand(viewRemoveCmd)
+}
+
+// resolveViewDir returns the absolute directory of a view
+func resolveViewDir(cfg *config.Config, name string) (string, error) {
+	dir := viewDir
+	if dir == "" {
+		return filepath.Join(cfg.ConfigDir, "views", name), nil
+	}
+
+	expanded, err := config.ExpandPath(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Abs(expanded)
+}
+
+func runViewCreate(cmd *cobra.Command, args []string) {
+	name := args[0]
+
+	// 1. 설정 파일 로드 (--group, --interactive 적용)
+	cfg := loadConfig(cmd)
+
+	// 2. 선택자가 없으면 뷰 이름과 같은 그룹 사용
+	groupFlag := cmd.Root().PersistentFlags().Lookup("group")
+	interactiveFlag := cmd.Root().PersistentFlags().Lookup("interactive")
+	if !groupFlag.Changed && !interactiveFlag.Changed {
+		if grouped := config.FilterByGroups(cfg.Repositories, []string{name}); len(grouped) > 0 {
+			cfg.Repositories = grouped
+		}
+	}
+
+	dir, err := resolveViewDir(cfg, name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	mgr := repository.NewManager(cfg)
+	reporter := repository.NewReporter()
+	reporter.PrintHeader(fmt.Sprintf("Creating view '%s' in %s", name, dir))
+
+	// 3. 저장소별 링크 생성
+	startTime := time.Now()
+	keep := make(map[string]bool, len(cfg.Repositories))
+	results := make([]repository.Result, 0, len(cfg.Repositories))
+
+	for _, repo := range mgr.Repositories() {
+		result := repository.Result{RepoName: repo.Name}
+		repoStart := time.Now()
+		keep[repo.Name] = true
+
+		repoPath := mgr.GetRepositoryPath(repo)
+		if !mgr.IsGitRepository(repo) {
+			result.Success = false
+			result.Error = fmt.Errorf("repository not cloned: %s\n  hint: run 'multi-git clone' first", repoPath)
+			result.Duration = time.Since(repoStart)
+			results = append(results, result)
+			continue
+		}
+
+		status, err := view.Link(dir, repo.Name, repoPath)
+		if err != nil {
+			result.Success = false
+			result.Error = err
+		} else if status == view.LinkUnchanged {
+			result.Skip(fmt.Sprintf("link %s", status))
+		} else {
+			result.Success = true
+			result.Message = fmt.Sprintf("link %s", status)
+		}
+		result.Duration = time.Since(repoStart)
+		results = append(results, result)
+	}
+
+	// 4. 더 이상 선택되지 않은 저장소 링크 제거
+	removed, err := view.Prune(dir, mgr.BaseDir(), keep)
+	if err != nil {
+		reporter.PrintWarning(err.Error())
+	}
+
+	summary := repository.NewSummary(results, time.Since(startTime))
+	reporter.PrintFullReport(summary)
+	if len(removed) > 0 {
+		reporter.PrintWarning(fmt.Sprintf("Removed stale links: %s", strings.Join(removed, ", ")))
+	}
+
+	exitOnFailures(cmd, summary)
+}
+
+func runViewRemove(cmd *cobra.Command, args []string) {
+	name := args[0]
+
+	configPath, _ := cmd.Root().PersistentFlags().GetString("config")
+	cfg, err := config.LoadAndValidateProfile(configPath, configProfile(cmd))
+	if err != nil {
+		exitOnConfigError(cmd, configPath, err)
+	}
+
+	dir, err := resolveViewDir(cfg, name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	removed, err := view.Prune(dir, cfg.BaseDir, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// 비어있으면 디렉토리도 제거 (비어있지 않으면 실패하므로 무시)
+	_ = os.Remove(dir)
+
+	fmt.Printf("✓ Removed %d links from view '%s' (%s)\n", len(removed), name, dir)
+}
+
+func GetViewCmd() *cobra.Command {
+	return viewCmd
+}
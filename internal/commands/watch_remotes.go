@@ -0,0 +1,197 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval keeps the polling from hammering the hosting service
+const minWatchInterval = 10 * time.Second
+
+// WatchRemotes 플래그 변수
+var (
+	watchInterval time.Duration // 폴링 간격
+	watchRemote   string        // 원격 이름
+	watchPrune    bool          // 삭제된 원격 브랜치 참조 제거
+	watchTags     bool          // 모든 태그 fetch
+	watchParallel int           // 병렬 처리 수
+	watchOnce     bool          // 한 번만 확인 후 종료
+)
+
+var watchRemotesCmd = &cobra.Command{
+	Use:   "watch-remotes",
+	Short: "Poll remotes and fetch repositories with new commits",
+	Long: `Periodically poll the remote of every cloned repository and fetch the
+repositories whose branches have new commits, keeping local mirrors warm so
+status, diff, and checkout sweeps work on fresh data.
+
+Polling only lists the remote references (like 'git ls-remote'), so
+repositories without upstream activity are not fetched. Runs until
+interrupted (Ctrl+C or SIGTERM); use --once to poll a single time.
+
+To fetch on push instead of polling, configure provider webhooks against
+'multi-git serve --webhook-secret' (see 'multi-git serve --help').
+
+Examples:
+  # Poll every 5 minutes (default)
+  multi-git watch-remotes
+
+  # Poll the backend repositories every minute, pruning deleted branches
+  multi-git watch-remotes -g backend --interval 1m --prune
+
+  # Single pass, e.g. from cron
+  multi-git watch-remotes --once`,
+	Args: cobra.NoArgs,
+	Run:  runWatchRemotes,
+}
+
+func init() {
+	watchRemotesCmd.Flags().DurationVar(&watchInterval, "interval", 5*time.Minute,
+		"Time between polls")
+	watchRemotesCmd.Flags().StringVarP(&watchRemote, "remote", "r", "",
+		"Remote name to watch (default: config default_remote)")
+	watchRemotesCmd.Flags().BoolVar(&watchPrune, "prune", false,
+		"Remove references to branches deleted on the remote when fetching")
+	watchRemotesCmd.Flags().BoolVar(&watchTags, "tags", false,
+		"Fetch all tags when fetching")
+	watchRemotesCmd.Flags().IntVarP(&watchParallel, "parallel", "p", 0,
+		"Number of parallel operations (0 = use config value)")
+	watchRemotesCmd.Flags().BoolVar(&watchOnce, "once", false,
+		"Poll once and exit")
+}
+
+func runWatchRemotes(cmd *cobra.Command, args []string) {
+	// 1. 폴링 간격 확인
+	if watchInterval < minWatchInterval {
+		fmt.Fprintf(os.Stderr, "Error: --interval must be at least %s\n", minWatchInterval)
+		os.Exit(1)
+	}
+
+	// 2. 설정 파일 로드
+	cfg := loadConfig(cmd)
+	mgr := repository.NewManager(cfg)
+	mgr.SetRepoTimeout(cfg.RepoTimeout)
+
+	workers := watchParallel
+	if workers <= 0 {
+		workers = mgr.ParallelWorkers()
+	}
+	mgr.Config().ParallelWorkers = workers
+
+	remoteName := watchRemote
+	if remoteName == "" {
+		remoteName = mgr.DefaultRemote()
+	}
+
+	// 3. 확인 Task 정의 (변경된 저장소만 fetch)
+	watchTask := func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+
+		// 클론되지 않은 저장소는 건너뜀
+		if !mgr.IsGitRepository(repo) {
+			result.Success = true
+			result.Message = "not cloned"
+			return result, nil
+		}
+
+		client := newGitClient(cfg, repo)
+		changed, err := client.RemoteChanges(remoteName)
+		if err != nil {
+			result.Success = false
+			result.Error = enhanceFetchError(err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+		if len(changed) == 0 {
+			result.Success = true
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		fetchOpts := &git.FetchOptions{
+			Remote: remoteName,
+			Prune:  watchPrune,
+			Tags:   watchTags,
+		}
+		if err := client.FetchWithOptions(fetchOpts); err != nil {
+			result.Success = false
+			result.Error = enhanceFetchError(err)
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+
+		result.Success = true
+		result.Message = fmt.Sprintf("fetched %s", strings.Join(changed, ", "))
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+
+	// 4. 종료 시그널까지 반복
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if !watchOnce {
+		fmt.Printf("Watching %d repositories on '%s' every %s\n", mgr.RepositoryCount(), remoteName, watchInterval)
+	}
+	log.Infof("watch-remotes: watching %d repositories every %s", mgr.RepositoryCount(), watchInterval)
+
+	failed := false
+	for {
+		summary := mgr.Execute(ctx, watchTask, nil)
+		failed = printPollRound("watch-remotes", summary)
+
+		if watchOnce {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			fmt.Println("Stopped watching")
+			return
+		case <-time.After(watchInterval):
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// printPollRound prints the repositories fetched or failed in one poll of a
+// polling command (watch-remotes, mirror-sync), logging them under its name
+// Returns true if any repository failed
+func printPollRound(command string, summary *repository.Summary) bool {
+	now := time.Now().Format("15:04:05")
+	fetched, failed := 0, 0
+	for _, result := range summary.Results {
+		switch {
+		case result.IsCancelled():
+			continue
+		case !result.Success:
+			failed++
+			fmt.Printf("%s ✗ %s: %v\n", now, result.RepoName, firstLine(result.Error))
+			log.Warnf("%s: %s: %v", command, result.RepoName, result.Error)
+		case result.Message != "" && !result.IsSkipped():
+			fetched++
+			fmt.Printf("%s ↓ %s: %s\n", now, result.RepoName, result.Message)
+			log.Infof("%s: %s: %s", command, result.RepoName, result.Message)
+		}
+	}
+	log.Debugf("%s: poll finished: %d fetched, %d failed", command, fetched, failed)
+	return failed > 0
+}
+
+func GetWatchRemotesCmd() *cobra.Command {
+	return watchRemotesCmd
+}
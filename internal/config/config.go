@@ -1,12 +1,95 @@
 package config
 
-import "path/filepath"
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
 
 // Repository represents a Git repository configuration
 type Repository struct {
 	Name string `yaml:"name"`           // 저장소 이름 (필수)
 	URL  string `yaml:"url"`           // 저장소 URL (필수)
-	Path string `yaml:"path,omitempty"` // 로컬 경로 (선택적)
+	Path string `yaml:"path,omitempty"` // 로컬 경로 (선택적, {{.Group}} 등 템플릿 지원)
+	Groups []string `yaml:"groups,omitempty"` // 소속 그룹 (선택적)
+	Auth   *AuthConfig `yaml:"auth,omitempty"` // 저장소별 인증 (선택적, 전역 설정 덮어씀)
+	ProtectedPaths []string `yaml:"protected_paths,omitempty"` // 저장소별 보호 경로 (전역 설정에 추가)
+	ProtectedBranches []string `yaml:"protected_branches,omitempty"` // 저장소별 보호 브랜치 (전역 설정에 추가)
+	ProtectedTags     []string `yaml:"protected_tags,omitempty"`     // 저장소별 보호 태그 (전역 설정에 추가)
+	DefaultBranch  string   `yaml:"default_branch,omitempty"`  // 기본 브랜치 (@default로 참조)
+	Pin            string   `yaml:"pin,omitempty"`             // 고정 리비전 (커밋 SHA 또는 태그, clone/sync가 detached HEAD로 체크아웃)
+	SparsePaths    []string `yaml:"sparse_paths,omitempty"`    // clone 시 체크아웃할 디렉토리 (sparse checkout)
+	Platforms      []string `yaml:"platforms,omitempty"`       // 지원 플랫폼 (예: linux, darwin/arm64; 비어있으면 전체)
+	TestCommand    string   `yaml:"test_command,omitempty"`    // 테스트 명령어 (update-deps, 선택적)
+	Remotes        map[string]string `yaml:"remotes,omitempty"` // 추가 원격 (이름 -> URL, 전역 설정 덮어씀, 빈 URL은 제외)
+	MaxOutput      ByteSize `yaml:"max_output,omitempty"`      // exec 출력 최대 크기 (전역 설정 덮어씀)
+	OutputKeep     string   `yaml:"output_keep,omitempty"`     // 출력이 넘칠 때 유지할 부분 (전역 설정 덮어씀)
+	Nice           int      `yaml:"nice,omitempty"`            // exec 명령어 CPU 우선순위 낮추기 (전역 설정 덮어씀)
+	MaxMemory      ByteSize `yaml:"max_memory,omitempty"`      // exec 명령어 최대 메모리 (전역 설정 덮어씀)
+	PostClone      []string `yaml:"post_clone,omitempty"`      // clone 성공 후 저장소 디렉토리에서 순서대로 실행할 셸 명령어
+	GitConfig      map[string]string `yaml:"git_config,omitempty"` // 저장소 git 설정 (키 -> 값, 그룹/전역 설정 덮어씀, 빈 값은 제외)
+}
+
+// SupportsPlatform returns true if the repository is used on the given OS and architecture
+// Entries are an OS (linux), an OS and architecture (darwin/arm64), or an architecture
+// on any OS (*/amd64). A repository without platforms is used everywhere.
+func (r Repository) SupportsPlatform(goos, goarch string) bool {
+	if len(r.Platforms) == 0 {
+		return true
+	}
+	for _, platform := range r.Platforms {
+		osName, arch, hasArch := strings.Cut(platform, "/")
+		if (osName == "*" || osName == goos) && (!hasArch || arch == "*" || arch == goarch) {
+			return true
+		}
+	}
+	return false
+}
+
+// WebURL returns the https URL of the repository's web page (https://host/owner/name)
+// Returns "" if the URL has no owner path
+func (r Repository) WebURL() string {
+	host, owner := splitRepoURL(r.URL)
+	if host == "" || owner == "" {
+		return ""
+	}
+	name := strings.TrimSuffix(path.Base(strings.TrimSuffix(r.URL, "/")), ".git")
+	return fmt.Sprintf("https://%s/%s/%s", host, owner, name)
+}
+
+// DefaultBranchAlias is the symbolic branch name resolved to Repository.DefaultBranch
+const DefaultBranchAlias = "@default"
+
+// ResolveBranch resolves symbolic branch names (@default) for the repository
+// Other branch names are returned unchanged
+func (r Repository) ResolveBranch(branch string) (string, error) {
+	if branch != DefaultBranchAlias {
+		return branch, nil
+	}
+	if r.DefaultBranch == "" {
+		return "", fmt.Errorf("repository '%s' has no default_branch configured for '%s'", r.Name, DefaultBranchAlias)
+	}
+	return r.DefaultBranch, nil
+}
+
+// AuthConfig represents credentials used for remote operations
+// Secrets should be provided via environment variables (token_env, ssh_key_passphrase_env)
+type AuthConfig struct {
+	SSHKey              string `yaml:"ssh_key,omitempty"`                // SSH 개인 키 경로
+	SSHKeyPassphraseEnv string `yaml:"ssh_key_passphrase_env,omitempty"` // SSH 키 암호를 담은 환경 변수
+	Username            string `yaml:"username,omitempty"`               // HTTPS 사용자 이름
+	Token               string `yaml:"token,omitempty"`                  // HTTPS 토큰 (가급적 token_env 사용)
+	TokenEnv            string `yaml:"token_env,omitempty"`              // HTTPS 토큰을 담은 환경 변수
+}
+
+// IsEmpty returns true if no credentials are configured
+func (a AuthConfig) IsEmpty() bool {
+	return a == AuthConfig{}
 }
 
 // ConfigSection represents the config section in YAML file
@@ -14,12 +97,113 @@ type ConfigSection struct {
 	BaseDir        string `yaml:"base_dir"`         // 기본 디렉토리
 	DefaultRemote  string `yaml:"default_remote"`   // 기본 원격 이름
 	ParallelWorkers int   `yaml:"parallel_workers"` // 병렬 작업 수
+	Auth           AuthConfig `yaml:"auth,omitempty"` // 전역 인증 설정
+	ProtectedPaths []string   `yaml:"protected_paths,omitempty"` // 보호 경로 (예: deploy/**)
+	ProtectedBranches []string `yaml:"protected_branches,omitempty"` // 강제 푸시/강제 체크아웃 금지 브랜치 (예: main, release/*)
+	ProtectedTags     []string `yaml:"protected_tags,omitempty"`     // 삭제 금지 태그 (예: v*)
+	Metrics        MetricsConfig `yaml:"metrics,omitempty"`       // 사용 지표 수집 (opt-in)
+	PathTemplate   string        `yaml:"path_template,omitempty"` // path가 없는 저장소의 기본 경로 템플릿
+	Notify         NotifyConfig  `yaml:"notify,omitempty"`        // 예약 작업 실패 알림
+	HookTimeout    time.Duration `yaml:"hook_timeout,omitempty"` // 훅 명령어 제한 시간 (기본: 5m)
+	CommandTimeout time.Duration `yaml:"command_timeout,omitempty"` // 명령어 전체 제한 시간 (0 = 제한 없음)
+	RepoTimeout    time.Duration `yaml:"repo_timeout,omitempty"`    // 저장소별 제한 시간 (0 = 제한 없음)
+	MaxOpsPerSecond float64      `yaml:"max_ops_per_second,omitempty"` // 네트워크 작업 초당 시작 수 (0 = 제한 없음)
+	SSHMultiplex   bool          `yaml:"ssh_multiplex,omitempty"`    // 같은 호스트의 저장소들이 SSH 연결 공유 (기본: false)
+	SSHMaxSessions int           `yaml:"ssh_max_sessions,omitempty"` // 공유 SSH 연결 하나의 최대 동시 작업 수 (기본: 8)
+	HTTPMaxIdleConnsPerHost int  `yaml:"http_max_idle_conns_per_host,omitempty"` // 재사용을 위해 유지할 호스트별 유휴 HTTP 연결 수 (기본: 16)
+	ObjectCacheSize ByteSize     `yaml:"object_cache_size,omitempty"` // 모든 저장소가 공유하는 git 객체 캐시 크기 (기본: 저장소별 캐시)
+	AllowExternalPaths bool      `yaml:"allow_external_paths,omitempty"` // base_dir 밖의 저장소 경로 허용 (기본: false)
+	Mailmap        []string      `yaml:"mailmap,omitempty"`       // 모든 저장소에 적용할 .mailmap 항목 (예: "Alice <alice@corp.com> <alice@home.net>")
+	Remotes        map[string]string `yaml:"remotes,omitempty"`  // 모든 저장소의 추가 원격 (이름 -> URL 템플릿, 예: upstream)
+	MaxOutput      ByteSize      `yaml:"max_output,omitempty"`   // exec 출력 최대 크기 (stdout, stderr 각각, 기본: 10MB)
+	OutputKeep     string        `yaml:"output_keep,omitempty"`  // 출력이 넘칠 때 유지할 부분 (head, tail, both; 기본: both)
+	Nice           int           `yaml:"nice,omitempty"`         // exec 명령어 CPU 우선순위 낮추기 (1-19, 기본: 0 = 변경 없음)
+	MaxMemory      ByteSize      `yaml:"max_memory,omitempty"`   // exec 명령어와 자식 프로세스의 최대 메모리 (기본: 제한 없음)
+	DirMode        FileMode      `yaml:"dir_mode,omitempty"`     // base_dir와 클론 디렉토리 권한 (예: 2775, 기본: 0755와 umask)
+	Group          string        `yaml:"group,omitempty"`        // base_dir와 클론의 소유 그룹 (이름 또는 GID, 기본: 변경 없음)
+	Umask          *FileMode     `yaml:"umask,omitempty"`        // multi-git 프로세스의 umask (예: 0002, 기본: 변경 없음)
+	GitConfig      map[string]string `yaml:"git_config,omitempty"` // 모든 저장소의 git 설정 (키 -> 값, 예: pull.rebase: "true")
+	GroupGitConfig map[string]map[string]string `yaml:"group_git_config,omitempty"` // 그룹별 git 설정 (그룹 -> 키 -> 값)
+	CommitAuthor   Identity      `yaml:"commit_author,omitempty"`    // commit의 작성자 (예: "Release Bot <bot@example.com>", 기본: git config user.name/email)
+	CommitCommitter Identity     `yaml:"commit_committer,omitempty"` // commit의 커미터이자 annotated tag의 tagger (기본: commit_author)
+	Retention      RetentionConfig `yaml:"retention,omitempty"`  // 'cache clean'이 정리할 상태 파일의 보존 기준
+	Providers      map[string]ProviderConfig `yaml:"providers,omitempty"` // 호스트별 호스팅 서비스 (호스트 -> 종류와 API 주소, 예: git.corp.example)
+}
+
+// NotifyConfig represents where failures of scheduled operations are reported
+type NotifyConfig struct {
+	Webhook string `yaml:"webhook,omitempty"` // 실패 시 {"text": ...} JSON을 POST할 URL (Slack incoming webhook 호환)
+	Command string `yaml:"command,omitempty"` // 실패 시 실행할 셸 명령어 (MULTI_GIT_SCHEDULE_* 환경 변수 제공)
+}
+
+// IsEmpty returns true if no notification target is configured
+func (n NotifyConfig) IsEmpty() bool {
+	return n == NotifyConfig{}
+}
+
+// ScheduleEntry represents a multi-git command run on a cron schedule
+type ScheduleEntry struct {
+	Cron    string        `yaml:"cron"`              // cron 표현식 (예: "0 2 * * *", "@daily")
+	Command string        `yaml:"command"`           // multi-git 하위 명령어와 인자 (예: "fetch --prune")
+	Timeout time.Duration `yaml:"timeout,omitempty"` // 실행 제한 시간 (선택적, 예: 30m)
+	ErrorBudget *int      `yaml:"error_budget,omitempty"` // 허용할 하드 실패 저장소 수 (선택적, 일시적 실패는 제외)
+}
+
+// RetentionConfig limits the run state kept next to the config file (run logs,
+// automatic snapshots, checkpoints, timing and schedule history)
+type RetentionConfig struct {
+	MaxAge  time.Duration `yaml:"max_age,omitempty"`  // 이보다 오래된 항목 제거 (예: 720h, 0 = 제한 없음)
+	MaxSize ByteSize      `yaml:"max_size,omitempty"` // 로그, 스냅샷, 체크포인트 합계 상한 (오래된 것부터 제거, 0 = 제한 없음)
+}
+
+// Hosting service types of ProviderConfig
+const (
+	ProviderGitHub = "github"
+	ProviderGitLab = "gitlab"
+)
+
+// ProviderConfig names the hosting service of a git host for the commands that use
+// its API, for hosts not recognized by name (GitHub Enterprise, self-hosted GitLab)
+type ProviderConfig struct {
+	Type   string `yaml:"type"`              // github 또는 gitlab
+	APIURL string `yaml:"api_url,omitempty"` // API 주소 (기본: GitHub https://<host>/api/v3, GitLab https://<host>)
+}
+
+// MetricsConfig represents the opt-in usage metrics settings
+// Metrics never include repository names, URLs, or paths
+type MetricsConfig struct {
+	Enabled  bool   `yaml:"enabled"`            // 로컬 집계 활성화 (기본: false)
+	Endpoint string `yaml:"endpoint,omitempty"` // 'metrics export' 전송 대상 URL (선택적)
+}
+
+// Policy file modes
+const (
+	PolicyModeBlock   = "block"   // 관리 블록만 병합 (기존 내용 유지)
+	PolicyModeReplace = "replace" // 파일 전체 교체
+)
+
+// PolicyFile represents a file managed across all repositories
+type PolicyFile struct {
+	Path    string `yaml:"path"`              // 저장소 내 대상 경로 (필수)
+	Source  string `yaml:"source,omitempty"`  // 내용을 담은 파일 경로
+	Content string `yaml:"content,omitempty"` // 인라인 내용
+	Mode    string `yaml:"mode,omitempty"`    // block (기본) 또는 replace
+	Comment string `yaml:"comment,omitempty"` // block 마커 주석 접두사 (기본: #)
+}
+
+// PolicySection represents the policy section in YAML file
+type PolicySection struct {
+	Files []PolicyFile `yaml:"files"` // 관리 대상 파일 목록
 }
 
 // ConfigFile represents the entire YAML configuration file structure
 type ConfigFile struct {
 	Config       ConfigSection `yaml:"config"`
 	Repositories []Repository  `yaml:"repositories"`
+	Policy       PolicySection `yaml:"policy,omitempty"`
+	Profiles     map[string]yaml.Node `yaml:"profiles,omitempty"` // 이름 -> 최상위 설정을 덮어쓰는 프로필
+	Schedule     map[string]ScheduleEntry `yaml:"schedule,omitempty"` // 이름 -> 예약 작업
+	Hooks        map[string]string `yaml:"hooks,omitempty"` // 훅 이름 (예: post_clone) -> 셸 명령어
 }
 
 // Config represents the processed configuration
@@ -28,13 +212,76 @@ type Config struct {
 	DefaultRemote  string       // 기본 원격 이름
 	ParallelWorkers int          // 병렬 작업 수
 	Repositories   []Repository // 저장소 목록
+	Policy         PolicySection // 파일 정책
+	Auth           AuthConfig   // 전역 인증 설정
+	ProtectedPaths []string     // 전역 보호 경로
+	ProtectedBranches []string  // 전역 보호 브랜치 패턴
+	ProtectedTags     []string  // 전역 보호 태그 패턴
+	ConfigDir      string       // 설정 파일이 위치한 디렉토리 (상태 파일 저장 위치)
+	ConfigPath     string       // 설정 파일 경로 (절대 경로)
+	Metrics        MetricsConfig // 사용 지표 설정
+	Profile        string        // 선택된 프로필 (없으면 빈 문자열)
+	Profiles       []string      // 설정 파일에 정의된 프로필 이름 (정렬됨)
+	Overlays       []string      // 적용된 오버레이 파일 (절대 경로, 적용 순서)
+	Schedule       map[string]ScheduleEntry // 예약 작업 (이름 -> 작업)
+	Notify         NotifyConfig  // 예약 작업 실패 알림
+	Hooks          map[string]string // 훅 이름 (예: post_clone) -> 셸 명령어
+	HookTimeout    time.Duration     // 훅 명령어 제한 시간 (0 = 기본값)
+	CommandTimeout time.Duration     // 명령어 전체 제한 시간 (0 = 제한 없음)
+	RepoTimeout    time.Duration     // 저장소별 제한 시간 (0 = 제한 없음)
+	MaxOpsPerSecond float64          // 네트워크 작업 초당 시작 수 (0 = 제한 없음)
+	SSHMultiplex   bool              // 같은 호스트의 저장소들이 SSH 연결 공유
+	SSHMaxSessions int               // 공유 SSH 연결 하나의 최대 동시 작업 수 (0 = 기본값)
+	HTTPMaxIdleConnsPerHost int      // 호스트별 유휴 HTTP 연결 수 (0 = 기본값)
+	ObjectCacheSize ByteSize         // 공유 git 객체 캐시 크기 (0 = 저장소별 캐시)
+	AllowExternalPaths bool          // base_dir 밖의 저장소 경로 허용
+	Mailmap        []string          // 전체 저장소 공통 .mailmap 항목
+	Remotes        map[string]string // 전체 저장소 공통 추가 원격 (이름 -> URL 템플릿)
+	MaxOutput      ByteSize          // exec 출력 최대 크기 (0 = 기본값)
+	OutputKeep     string            // 출력이 넘칠 때 유지할 부분 (빈 값 = both)
+	Nice           int               // exec 명령어 CPU 우선순위 낮추기 (0 = 변경 없음)
+	MaxMemory      ByteSize          // exec 명령어 최대 메모리 (0 = 제한 없음)
+	DirMode        FileMode          // base_dir와 클론 디렉토리 권한 (0 = 기본값)
+	Group          string            // base_dir와 클론의 소유 그룹 (빈 값 = 변경 없음)
+	Umask          *FileMode         // 프로세스 umask (nil = 변경 없음)
+	GitConfig      map[string]string // 전체 저장소 공통 git 설정 (키 -> 값)
+	GroupGitConfig map[string]map[string]string // 그룹별 git 설정 (그룹 -> 키 -> 값)
+	CommitAuthor   Identity          // commit 작성자 (비어있으면 git config)
+	CommitCommitter Identity         // commit 커미터와 tagger (비어있으면 CommitAuthor)
+	Retention      RetentionConfig   // 상태 파일 보존 기준 (0 = 제한 없음)
+	Providers      map[string]ProviderConfig // 호스트별 호스팅 서비스 (호스트 -> 설정)
+}
+
+// HookOperations are the commands that run pre_<operation> and post_<operation> hooks
+var HookOperations = []string{"clone", "checkout", "pull", "fetch", "sync", "commit", "tag", "push"}
+
+// Hook returns the shell command of the hook (e.g. "post_clone"), or "" if not configured
+func (c *Config) Hook(name string) string {
+	return c.Hooks[name]
+}
+
+// ScheduleNames returns the names of the scheduled operations, sorted
+func (c *Config) ScheduleNames() []string {
+	names := make([]string, 0, len(c.Schedule))
+	for name := range c.Schedule {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // LoadAndValidate loads and validates the configuration file
 // This is the main public API for loading configuration
 func LoadAndValidate(configPath string) (*Config, error) {
+	return LoadAndValidateProfile(configPath, "")
+}
+
+// LoadAndValidateProfile loads the configuration file with the named profile and
+// overlays applied and validates it
+// An empty profile uses the top-level settings only
+func LoadAndValidateProfile(configPath, profile string, overlays ...string) (*Config, error) {
 	// Load configuration
-	config, err := LoadConfig(configPath)
+	config, err := LoadConfigProfile(configPath, profile, overlays...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,3 +306,227 @@ func GetRepositoryPath(repo Repository, baseDir string) string {
 	return repoPath
 }
 
+// ResolveAuth returns the credentials for a repository
+// Per-repository auth takes precedence over the global auth settings
+func (c *Config) ResolveAuth(repo Repository) AuthConfig {
+	if repo.Auth != nil && !repo.Auth.IsEmpty() {
+		return *repo.Auth
+	}
+	return c.Auth
+}
+
+// ProviderFor returns the hosting service configured for a git host (e.g. git.corp.example)
+// Host names are compared case-insensitively.
+func (c *Config) ProviderFor(host string) (ProviderConfig, bool) {
+	for name, provider := range c.Providers {
+		if strings.EqualFold(name, host) {
+			return provider, true
+		}
+	}
+	return ProviderConfig{}, false
+}
+
+// ProtectedPathsFor returns the protected path patterns that apply to a repository
+func (c *Config) ProtectedPathsFor(repo Repository) []string {
+	patterns := make([]string, 0, len(c.ProtectedPaths)+len(repo.ProtectedPaths))
+	patterns = append(patterns, c.ProtectedPaths...)
+	patterns = append(patterns, repo.ProtectedPaths...)
+	return patterns
+}
+
+// DefaultMaxOutput is the output kept per stream of a command when max_output is not set
+const DefaultMaxOutput ByteSize = 10 << 20
+
+// Output truncation policies (output_keep)
+var OutputKeepPolicies = []string{"head", "tail", "both"}
+
+// OutputLimitFor returns the maximum output size and truncation policy of a repository
+// Per-repository settings override the global ones; the defaults are 10MB and "both".
+func (c *Config) OutputLimitFor(repo Repository) (ByteSize, string) {
+	maxOutput, keep := c.MaxOutput, c.OutputKeep
+	if repo.MaxOutput > 0 {
+		maxOutput = repo.MaxOutput
+	}
+	if repo.OutputKeep != "" {
+		keep = repo.OutputKeep
+	}
+	if maxOutput <= 0 {
+		maxOutput = DefaultMaxOutput
+	}
+	if keep == "" {
+		keep = "both"
+	}
+	return maxOutput, keep
+}
+
+// MaxNice is the highest nice value, the lowest CPU priority
+const MaxNice = 19
+
+// ResourceLimitsFor returns the CPU priority (nice) and memory limit of exec commands
+// in a repository; per-repository settings override the global ones
+func (c *Config) ResourceLimitsFor(repo Repository) (int, ByteSize) {
+	nice, maxMemory := c.Nice, c.MaxMemory
+	if repo.Nice > 0 {
+		nice = repo.Nice
+	}
+	if repo.MaxMemory > 0 {
+		maxMemory = repo.MaxMemory
+	}
+	return nice, maxMemory
+}
+
+// RemotesFor returns the additional remotes of a repository (name -> URL)
+// The global remotes are URL templates ({{.Name}}, {{.Owner}}, ...) rendered for
+// the repository; per-repository entries override them, and an empty URL removes one.
+func (c *Config) RemotesFor(repo Repository) (map[string]string, error) {
+	remotes := make(map[string]string)
+	for name, urlTemplate := range c.Remotes {
+		if _, overridden := repo.Remotes[name]; overridden {
+			continue
+		}
+		url, err := RenderURL(urlTemplate, repo)
+		if err != nil {
+			return nil, err
+		}
+		remotes[name] = url
+	}
+	for name, url := range repo.Remotes {
+		if url == "" {
+			continue
+		}
+		rendered, err := RenderURL(url, repo)
+		if err != nil {
+			return nil, err
+		}
+		remotes[name] = rendered
+	}
+	return remotes, nil
+}
+
+// GitConfigFor returns the git config keys to set in the repository: the git_config
+// of the config section, then of the repository's groups in the order they are
+// listed, then of the repository, each overriding the previous ones
+// An empty value at a more specific level leaves the key unset.
+func (c *Config) GitConfigFor(repo Repository) map[string]string {
+	values := make(map[string]string)
+	levels := []map[string]string{c.GitConfig}
+	for _, group := range repo.Groups {
+		levels = append(levels, c.GroupGitConfig[group])
+	}
+	levels = append(levels, repo.GitConfig)
+
+	for _, level := range levels {
+		for key, value := range level {
+			if value == "" {
+				delete(values, key)
+				continue
+			}
+			values[key] = value
+		}
+	}
+	return values
+}
+
+// CommitIdentity returns the author and committer configured for commits
+// The committer defaults to the author; both are zero if neither is configured.
+func (c *Config) CommitIdentity() (author, committer Identity) {
+	author, committer = c.CommitAuthor, c.CommitCommitter
+	if committer.IsZero() {
+		committer = author
+	}
+	return author, committer
+}
+
+// IsProtectedBranch returns true if the branch matches a protected_branches pattern
+// of the config or the repository. Patterns use path.Match syntax, so 'release/*'
+// matches 'release/1.0' but not 'release/1.0/hotfix'.
+func (c *Config) IsProtectedBranch(repo Repository, branch string) bool {
+	return matchRefPatterns(c.ProtectedBranches, branch) || matchRefPatterns(repo.ProtectedBranches, branch)
+}
+
+// IsProtectedTag returns true if the tag matches a protected_tags pattern of the config or the repository
+func (c *Config) IsProtectedTag(repo Repository, tag string) bool {
+	return matchRefPatterns(c.ProtectedTags, tag) || matchRefPatterns(repo.ProtectedTags, tag)
+}
+
+// matchRefPatterns reports whether the branch or tag name matches any of the patterns
+func matchRefPatterns(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// HasGroup checks if the repository belongs to the given group
+func (r Repository) HasGroup(group string) bool {
+	for _, g := range r.Groups {
+		if g == group {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterByGroups returns repositories that belong to at least one of the given groups
+// If groups is empty, all repositories are returned
+func FilterByGroups(repos []Repository, groups []string) []Repository {
+	if len(groups) == 0 {
+		return repos
+	}
+
+	filtered := make([]Repository, 0, len(repos))
+	for _, repo := range repos {
+		for _, group := range groups {
+			if repo.HasGroup(group) {
+				filtered = append(filtered, repo)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// FilterByNames returns repositories whose name matches one of the patterns
+// Patterns are exact names or globs (e.g. "api-*"); repositories keep their config order
+// Returns an error if a pattern matches no repository, so typos are not silently ignored
+func FilterByNames(repos []Repository, patterns []string) ([]Repository, error) {
+	if len(patterns) == 0 {
+		return repos, nil
+	}
+
+	matched := make([]bool, len(repos))
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		found := false
+		for i, repo := range repos {
+			ok, err := path.Match(pattern, repo.Name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid repository pattern '%s': %w", pattern, err)
+			}
+			if ok {
+				matched[i] = true
+				found = true
+			}
+		}
+		if !found {
+			if strings.ContainsAny(pattern, "*?[") {
+				return nil, fmt.Errorf("pattern '%s' does not match any repository", pattern)
+			}
+			return nil, fmt.Errorf("repository '%s' not found in config", pattern)
+		}
+	}
+
+	filtered := make([]Repository, 0, len(repos))
+	for i, repo := range repos {
+		if matched[i] {
+			filtered = append(filtered, repo)
+		}
+	}
+	return filtered, nil
+}
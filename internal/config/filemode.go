@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FileMode is a Unix permission mode written in octal (e.g. 0775, "2770")
+type FileMode uint32
+
+// ParseFileMode parses an octal mode such as "0775", "775", or "0o775"
+func ParseFileMode(s string) (FileMode, error) {
+	text := strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(s), "0o"), "0O")
+	value, err := strconv.ParseUint(text, 8, 32)
+	if err != nil || value > 0o7777 {
+		return 0, fmt.Errorf("invalid mode '%s' (expected octal, e.g. 0755 or 2775)", s)
+	}
+	return FileMode(value), nil
+}
+
+// UnmarshalYAML parses the mode as octal, also when written without quotes
+// (YAML would otherwise read 0775 as a decimal or an octal number depending on the parser)
+func (m *FileMode) UnmarshalYAML(value *yaml.Node) error {
+	mode, err := ParseFileMode(value.Value)
+	if err != nil {
+		return err
+	}
+	*m = mode
+	return nil
+}
+
+// String formats the mode as four octal digits (e.g. "0775")
+func (m FileMode) String() string {
+	return fmt.Sprintf("%04o", uint32(m))
+}
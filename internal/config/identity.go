@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Identity is a git author or committer written as "Name <email>"
+type Identity struct {
+	Name  string // 이름
+	Email string // 이메일
+}
+
+// ParseIdentity parses an identity such as "Release Bot <bot@example.com>"
+func ParseIdentity(s string) (Identity, error) {
+	text := strings.TrimSpace(s)
+	open := strings.Index(text, "<")
+	if open < 0 || !strings.HasSuffix(text, ">") || strings.Count(text, "<") != 1 || strings.Count(text, ">") != 1 {
+		return Identity{}, fmt.Errorf("invalid identity '%s' (expected 'Name <email>')", s)
+	}
+
+	identity := Identity{
+		Name:  strings.TrimSpace(text[:open]),
+		Email: strings.TrimSpace(text[open+1 : len(text)-1]),
+	}
+	if identity.Name == "" || identity.Email == "" {
+		return Identity{}, fmt.Errorf("invalid identity '%s' (expected 'Name <email>')", s)
+	}
+	return identity, nil
+}
+
+// UnmarshalYAML parses the identity from a "Name <email>" string
+func (i *Identity) UnmarshalYAML(value *yaml.Node) error {
+	identity, err := ParseIdentity(value.Value)
+	if err != nil {
+		return err
+	}
+	*i = identity
+	return nil
+}
+
+// IsZero returns true if no identity is set
+func (i Identity) IsZero() bool {
+	return i.Name == "" && i.Email == ""
+}
+
+// String formats the identity as "Name <email>"
+func (i Identity) String() string {
+	return fmt.Sprintf("%s <%s>", i.Name, i.Email)
+}
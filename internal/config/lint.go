@@ -0,0 +1,231 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// LintSeverity is the severity of a lint issue
+type LintSeverity string
+
+const (
+	// LintWarning marks an entry that is likely a mistake
+	LintWarning LintSeverity = "warning"
+	// LintSuggestion marks an entry that works but could be cleaner
+	LintSuggestion LintSeverity = "suggestion"
+)
+
+// LintIssue is a suspicious entry found by Lint
+// Unlike validation errors, lint issues do not prevent the config from being used.
+type LintIssue struct {
+	Severity LintSeverity // 심각도
+	Field    string       // 설정 필드 (예: repositories.api.path)
+	Message  string       // 설명
+}
+
+// String returns the issue as "field: message"
+func (i LintIssue) String() string {
+	return fmt.Sprintf("%s: %s", i.Field, i.Message)
+}
+
+// Lint checks a validated configuration for suspicious entries that validation allows:
+// duplicate URLs under different names, paths escaping base_dir, scheduled commands
+// selecting groups no repository belongs to, and groups that look like typos of another.
+// Checks that need a provider API (e.g. archived repositories) are done by the caller.
+func Lint(config *Config) []LintIssue {
+	var issues []LintIssue
+	issues = append(issues, lintDuplicateURLs(config)...)
+	issues = append(issues, lintPaths(config)...)
+	issues = append(issues, lintGroups(config)...)
+	return issues
+}
+
+// lintDuplicateURLs reports repositories that point to the same remote under different names
+func lintDuplicateURLs(config *Config) []LintIssue {
+	var issues []LintIssue
+	seen := make(map[string]string) // 저장소 키 -> 처음 사용한 저장소 이름
+	for _, repo := range config.Repositories {
+		key := repositoryKey(repo.URL)
+		if first, ok := seen[key]; ok {
+			issues = append(issues, LintIssue{
+				Severity: LintWarning,
+				Field:    fmt.Sprintf("repositories.%s.url", repo.Name),
+				Message:  fmt.Sprintf("same repository as '%s' (%s); every operation runs on it twice", first, repo.URL),
+			})
+			continue
+		}
+		seen[key] = repo.Name
+	}
+	return issues
+}
+
+// lintPaths reports repository paths with needless ".." elements, and paths outside
+// base_dir when allow_external_paths is set (validation rejects them otherwise)
+func lintPaths(config *Config) []LintIssue {
+	var issues []LintIssue
+	for _, repo := range config.Repositories {
+		if repo.Path == "" || !hasDotDot(repo.Path) {
+			continue
+		}
+		field := fmt.Sprintf("repositories.%s.path", repo.Name)
+
+		fullPath := GetRepositoryPath(repo, config.BaseDir)
+		rel, err := filepath.Rel(config.BaseDir, fullPath)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			issues = append(issues, LintIssue{
+				Severity: LintWarning,
+				Field:    field,
+				Message:  fmt.Sprintf("'%s' escapes base_dir (resolves to %s; allowed by allow_external_paths)", repo.Path, fullPath),
+			})
+			continue
+		}
+		issues = append(issues, LintIssue{
+			Severity: LintSuggestion,
+			Field:    field,
+			Message:  fmt.Sprintf("'%s' can be written as '%s'", repo.Path, filepath.ToSlash(rel)),
+		})
+	}
+	return issues
+}
+
+// hasDotDot returns true if the path has a ".." element
+func hasDotDot(p string) bool {
+	for _, elem := range strings.FieldsFunc(p, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if elem == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+// lintGroups reports groups referenced by scheduled commands that no repository belongs to,
+// and groups with a single repository whose name is close to another group
+func lintGroups(config *Config) []LintIssue {
+	var issues []LintIssue
+
+	counts := make(map[string]int) // 그룹 -> 저장소 수
+	for _, repo := range config.Repositories {
+		for _, group := range repo.Groups {
+			counts[group]++
+		}
+	}
+	groups := make([]string, 0, len(counts))
+	for group := range counts {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+
+	// 1. 예약 작업이 선택하는 그룹
+	for _, name := range config.ScheduleNames() {
+		for _, group := range commandGroups(config.Schedule[name].Command) {
+			if counts[group] > 0 {
+				continue
+			}
+			message := fmt.Sprintf("group '%s' has no repositories, so the scheduled run selects nothing", group)
+			if similar := similarName(group, groups); similar != "" {
+				message += fmt.Sprintf(" (did you mean '%s'?)", similar)
+			}
+			issues = append(issues, LintIssue{
+				Severity: LintWarning,
+				Field:    fmt.Sprintf("schedule.%s.command", name),
+				Message:  message,
+			})
+		}
+	}
+
+	// 2. 오타로 보이는 그룹 (저장소가 하나뿐이고 다른 그룹과 이름이 비슷함)
+	for _, group := range groups {
+		if counts[group] != 1 {
+			continue
+		}
+		others := make([]string, 0, len(groups))
+		for _, other := range groups {
+			if other != group && counts[other] > 1 {
+				others = append(others, other)
+			}
+		}
+		similar := similarName(group, others)
+		if similar == "" {
+			continue
+		}
+		for _, repo := range config.Repositories {
+			if repo.HasGroup(group) {
+				issues = append(issues, LintIssue{
+					Severity: LintSuggestion,
+					Field:    fmt.Sprintf("repositories.%s.groups", repo.Name),
+					Message:  fmt.Sprintf("group '%s' is used only here; did you mean '%s'?", group, similar),
+				})
+			}
+		}
+	}
+
+	return issues
+}
+
+// commandGroups returns the group names selected by a command line (-g/--group, comma-separated)
+func commandGroups(command string) []string {
+	args := strings.Fields(command)
+	var groups []string
+	for i, arg := range args {
+		var value string
+		switch {
+		case arg == "-g" || arg == "--group":
+			if i+1 < len(args) {
+				value = args[i+1]
+			}
+		case strings.HasPrefix(arg, "--group="):
+			value = strings.TrimPrefix(arg, "--group=")
+		case strings.HasPrefix(arg, "-g") && len(arg) > 2 && !strings.HasPrefix(arg, "--"):
+			value = strings.TrimPrefix(strings.TrimPrefix(arg, "-g"), "=")
+		}
+		for _, group := range strings.Split(strings.Trim(value, `"'`), ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+// similarName returns the candidate within a small edit distance of name, or "" if none
+func similarName(name string, candidates []string) string {
+	best, bestDistance := "", 0
+	for _, candidate := range candidates {
+		if candidate == name {
+			continue
+		}
+		limit := 1
+		if len(name) > 4 {
+			limit = 2
+		}
+		d := editDistance(strings.ToLower(name), strings.ToLower(candidate))
+		if d <= limit && (best == "" || d < bestDistance) {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
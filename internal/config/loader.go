@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -11,6 +14,13 @@ import (
 
 // LoadConfig loads and processes the configuration file
 func LoadConfig(configPath string) (*Config, error) {
+	return LoadConfigProfile(configPath, "")
+}
+
+// LoadConfigProfile loads and processes the configuration file with the named profile applied
+// A profile overrides the top-level keys it sets; an empty profile uses the top-level settings only.
+// Overlay files are applied in order after the profile (see applyOverlay).
+func LoadConfigProfile(configPath, profile string, overlays ...string) (*Config, error) {
 	// 1. 경로 처리 및 파일 존재 여부 확인
 	expandedPath, err := expandPath(configPath)
 	if err != nil {
@@ -22,6 +32,12 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("config file not found: %s", expandedPath)
 	}
 
+	// 상태 파일과 로그 위치가 작업 디렉토리에 따라 달라지지 않도록 절대 경로 사용
+	expandedPath, err = filepath.Abs(expandedPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get absolute path for config file: %w", err)
+	}
+
 	// 2. 파일 읽기
 	data, err := os.ReadFile(expandedPath)
 	if err != nil {
@@ -34,6 +50,50 @@ func LoadConfig(configPath string) (*Config, error) {
 		return nil, fmt.Errorf("failed to parse YAML: %w", err)
 	}
 
+	// 3-1. 프로필 적용
+	profileNames := make([]string, 0, len(configFile.Profiles))
+	for name := range configFile.Profiles {
+		profileNames = append(profileNames, name)
+	}
+	sort.Strings(profileNames)
+
+	if profile != "" {
+		applied, err := applyProfile(configFile, profile, profileNames)
+		if err != nil {
+			return nil, err
+		}
+		configFile = applied
+	}
+
+	// 3-2. 오버레이 적용 (순서대로, 프로필 다음)
+	overlayPaths := make([]string, 0, len(overlays))
+	for _, overlay := range overlays {
+		overlayPath, err := expandPath(overlay)
+		if err != nil {
+			return nil, fmt.Errorf("failed to expand overlay path: %w", err)
+		}
+		if overlayPath, err = filepath.Abs(overlayPath); err != nil {
+			return nil, fmt.Errorf("failed to get absolute path for overlay: %w", err)
+		}
+		if _, err := os.Stat(overlayPath); os.IsNotExist(err) {
+			return nil, fmt.Errorf("overlay file not found: %s", overlayPath)
+		}
+		applied, err := applyOverlay(configFile, overlayPath)
+		if err != nil {
+			return nil, err
+		}
+		configFile = applied
+		overlayPaths = append(overlayPaths, overlayPath)
+	}
+
+	if profile == "" && len(configFile.Repositories) == 0 && len(profileNames) > 0 {
+		return nil, &ConfigError{
+			Type:    ErrEmptyRepositories,
+			Message: fmt.Sprintf("no top-level repositories; select a profile with --profile (available: %s)", strings.Join(profileNames, ", ")),
+			Field:   "repositories",
+		}
+	}
+
 	// 4. 환경 변수 확장 (BaseDir의 ~ 확장)
 	baseDir, err := expandPath(configFile.Config.BaseDir)
 	if err != nil {
@@ -57,17 +117,173 @@ func LoadConfig(configPath string) (*Config, error) {
 		parallelWorkers = 3
 	}
 
+	// 6. 정책 파일 기본값 및 source 경로 처리 (설정 파일 기준 상대 경로)
+	policy := configFile.Policy
+	for i := range policy.Files {
+		file := &policy.Files[i]
+		if file.Mode == "" {
+			file.Mode = PolicyModeBlock
+		}
+		if file.Comment == "" {
+			file.Comment = "#"
+		}
+		if file.Source != "" {
+			source, err := expandPath(file.Source)
+			if err != nil {
+				return nil, fmt.Errorf("failed to expand policy source: %w", err)
+			}
+			if !filepath.IsAbs(source) {
+				source = filepath.Join(filepath.Dir(expandedPath), source)
+			}
+			file.Source = source
+		}
+	}
+
 	// Config 구조체 생성
 	config := &Config{
 		BaseDir:        absBaseDir,
 		DefaultRemote:  defaultRemote,
 		ParallelWorkers: parallelWorkers,
 		Repositories:   configFile.Repositories,
+		Policy:         policy,
+		Auth:           configFile.Config.Auth,
+		ProtectedPaths: configFile.Config.ProtectedPaths,
+		ProtectedBranches: configFile.Config.ProtectedBranches,
+		ProtectedTags:     configFile.Config.ProtectedTags,
+		Metrics:        configFile.Config.Metrics,
+		ConfigDir:      filepath.Dir(expandedPath),
+		ConfigPath:     expandedPath,
+		Profile:        profile,
+		Profiles:       profileNames,
+		Overlays:       overlayPaths,
+		Schedule:       configFile.Schedule,
+		Notify:         configFile.Config.Notify,
+		Hooks:          configFile.Hooks,
+		HookTimeout:    configFile.Config.HookTimeout,
+		CommandTimeout: configFile.Config.CommandTimeout,
+		RepoTimeout:    configFile.Config.RepoTimeout,
+		MaxOpsPerSecond: configFile.Config.MaxOpsPerSecond,
+		SSHMultiplex:   configFile.Config.SSHMultiplex,
+		SSHMaxSessions: configFile.Config.SSHMaxSessions,
+		HTTPMaxIdleConnsPerHost: configFile.Config.HTTPMaxIdleConnsPerHost,
+		ObjectCacheSize: configFile.Config.ObjectCacheSize,
+		AllowExternalPaths: configFile.Config.AllowExternalPaths,
+		Mailmap:        configFile.Config.Mailmap,
+		Remotes:        configFile.Config.Remotes,
+		MaxOutput:      configFile.Config.MaxOutput,
+		OutputKeep:     configFile.Config.OutputKeep,
+		Nice:           configFile.Config.Nice,
+		MaxMemory:      configFile.Config.MaxMemory,
+		DirMode:        configFile.Config.DirMode,
+		Group:          configFile.Config.Group,
+		Umask:          configFile.Config.Umask,
+		GitConfig:      configFile.Config.GitConfig,
+		GroupGitConfig: configFile.Config.GroupGitConfig,
+		CommitAuthor:   configFile.Config.CommitAuthor,
+		CommitCommitter: configFile.Config.CommitCommitter,
+		Retention:      configFile.Config.Retention,
+		Providers:      configFile.Config.Providers,
+	}
+
+	// 7. 경로 템플릿 렌더링 (path가 없으면 path_template 사용)
+	if configFile.Config.PathTemplate != "" && !IsPathTemplate(configFile.Config.PathTemplate) {
+		return nil, &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "path_template must contain template tokens such as {{.Name}}",
+			Field:   "config.path_template",
+		}
+	}
+	for i := range config.Repositories {
+		repo := &config.Repositories[i]
+		pathTemplate := repo.Path
+		if pathTemplate == "" {
+			pathTemplate = configFile.Config.PathTemplate
+		}
+		if !IsPathTemplate(pathTemplate) {
+			continue
+		}
+
+		rendered, err := RenderPath(pathTemplate, *repo)
+		if err != nil {
+			return nil, &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: err.Error(),
+				Field:   fmt.Sprintf("repositories[%s].path", repo.Name),
+				Cause:   err,
+			}
+		}
+		repo.Path = rendered
+	}
+
+	// 8. SSH 키 경로의 ~ 확장
+	if err := expandAuthPaths(&config.Auth); err != nil {
+		return nil, err
+	}
+	for i := range config.Repositories {
+		if config.Repositories[i].Auth != nil {
+			if err := expandAuthPaths(config.Repositories[i].Auth); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	return config, nil
 }
 
+// applyProfile returns the config file with the named profile decoded over the top-level settings
+// Keys set in the profile replace the top-level values; lists such as repositories are replaced, not merged
+func applyProfile(configFile ConfigFile, profile string, available []string) (ConfigFile, error) {
+	node, ok := configFile.Profiles[profile]
+	if !ok {
+		message := fmt.Sprintf("profile '%s' not found", profile)
+		if len(available) > 0 {
+			message += fmt.Sprintf(" (available: %s)", strings.Join(available, ", "))
+		} else {
+			message += " (config file defines no profiles)"
+		}
+		return configFile, &ConfigError{Type: ErrInvalidConfig, Message: message, Field: "profiles"}
+	}
+	if mappingValue(&node, "profiles") != nil {
+		return configFile, &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "profiles cannot be nested",
+			Field:   fmt.Sprintf("profiles.%s.profiles", profile),
+		}
+	}
+
+	// yaml.v3는 기존 값 위에 디코딩하므로 프로필에 없는 키는 최상위 값 유지
+	merged := configFile
+	merged.Repositories = append([]Repository(nil), configFile.Repositories...)
+	if err := node.Decode(&merged); err != nil {
+		return configFile, &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("invalid profile '%s': %v", profile, err),
+			Field:   "profiles." + profile,
+			Cause:   err,
+		}
+	}
+	merged.Profiles = configFile.Profiles
+	return merged, nil
+}
+
+// expandAuthPaths expands ~ in the SSH key path
+func expandAuthPaths(auth *AuthConfig) error {
+	if auth.SSHKey == "" {
+		return nil
+	}
+	keyPath, err := expandPath(auth.SSHKey)
+	if err != nil {
+		return fmt.Errorf("failed to expand ssh_key: %w", err)
+	}
+	auth.SSHKey = keyPath
+	return nil
+}
+
+// ExpandPath expands a leading ~ to the user's home directory
+func ExpandPath(path string) (string, error) {
+	return expandPath(path)
+}
+
 // expandPath expands ~ to home directory and returns absolute path
 func expandPath(path string) (string, error) {
 	// 빈 경로 처리
@@ -75,6 +291,11 @@ func expandPath(path string) (string, error) {
 		return "", fmt.Errorf("path is empty")
 	}
 
+	// Windows: %USERPROFILE% 같은 환경 변수 확장
+	if runtime.GOOS == "windows" {
+		path = expandWindowsEnv(path)
+	}
+
 	// ~ 확장 처리
 	if strings.HasPrefix(path, "~") {
 		homeDir, err := os.UserHomeDir()
@@ -82,10 +303,10 @@ func expandPath(path string) (string, error) {
 			return "", fmt.Errorf("failed to get home directory: %w", err)
 		}
 
-		// ~/path 또는 ~user/path 처리
+		// ~/path (Windows에서는 ~\path도) 또는 ~user/path 처리
 		if path == "~" {
 			return homeDir, nil
-		} else if strings.HasPrefix(path, "~/") {
+		} else if strings.HasPrefix(path, "~/") || (runtime.GOOS == "windows" && strings.HasPrefix(path, `~\`)) {
 			return filepath.Join(homeDir, path[2:]), nil
 		} else {
 			// ~user 형식은 지원하지 않음 (복잡도 때문)
@@ -93,7 +314,21 @@ func expandPath(path string) (string, error) {
 		}
 	}
 
-	// 이미 절대 경로이거나 상대 경로인 경우 그대로 반환
-	return path, nil
+	// 이미 절대 경로이거나 상대 경로인 경우 그대로 반환 (Windows에서는 구분자를 \로 통일)
+	return filepath.FromSlash(path), nil
+}
+
+// windowsEnvPattern matches a %NAME% environment variable reference
+var windowsEnvPattern = regexp.MustCompile(`%([A-Za-z_][A-Za-z0-9_()]*)%`)
+
+// expandWindowsEnv expands %NAME% references to set environment variables; like
+// cmd.exe, references to unset variables are left as they are
+func expandWindowsEnv(path string) string {
+	return windowsEnvPattern.ReplaceAllStringFunc(path, func(ref string) string {
+		if value, ok := os.LookupEnv(ref[1 : len(ref)-1]); ok {
+			return value
+		}
+		return ref
+	})
 }
 
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// lockTimeout is how long a config file edit waits for another edit to finish
+const lockTimeout = 10 * time.Second
+
+// staleLockAge is the age after which a lock file is assumed to be left over by a crashed process
+const staleLockAge = 2 * time.Minute
+
+// lockConfigFile takes the edit lock of the config file (<config>.lock next to it)
+// so that concurrent edits (e.g. two 'config add' runs) do not overwrite each other.
+// The returned function releases the lock.
+func lockConfigFile(configPath string) (func(), error) {
+	lockPath := configPath + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+
+	for {
+		file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return func() { _ = os.Remove(lockPath) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to lock config file: %w", err)
+		}
+
+		// 비정상 종료로 남은 잠금 파일 제거
+		if info, statErr := os.Stat(lockPath); statErr == nil && time.Since(info.ModTime()) > staleLockAge {
+			_ = os.Remove(lockPath)
+			continue
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("config file is being edited by another process (remove %s if it is not)", lockPath)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,126 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// applyOverlay returns the config file with an overlay file applied over it
+// The overlay's top-level keys (config, policy, schedule, hooks) override the keys
+// they set. Repositories listed under 'remove' (names or globs) are dropped first;
+// entries under 'repositories' then modify the repository with the same name (the
+// fields they set replace its values) or are added if no repository has that name.
+func applyOverlay(configFile ConfigFile, overlayPath string) (ConfigFile, error) {
+	data, err := os.ReadFile(overlayPath)
+	if err != nil {
+		return configFile, fmt.Errorf("failed to read overlay: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return configFile, fmt.Errorf("failed to parse overlay %s: %w", overlayPath, err)
+	}
+	if len(doc.Content) == 0 {
+		// 빈 파일은 아무것도 바꾸지 않음
+		return configFile, nil
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return configFile, overlayError(overlayPath, "", "overlay must be a mapping with keys such as config, repositories and remove", nil)
+	}
+	if mappingValue(root, "profiles") != nil {
+		return configFile, overlayError(overlayPath, "profiles", "overlays cannot define profiles", nil)
+	}
+
+	// repositories와 remove를 제외한 키는 프로필처럼 기존 값 위에 디코딩
+	settings := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	var repoNodes, removeNode *yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		switch root.Content[i].Value {
+		case "repositories":
+			repoNodes = root.Content[i+1]
+		case "remove":
+			removeNode = root.Content[i+1]
+		default:
+			settings.Content = append(settings.Content, root.Content[i], root.Content[i+1])
+		}
+	}
+
+	merged := configFile
+	merged.Repositories = append([]Repository(nil), configFile.Repositories...)
+	if err := settings.Decode(&merged); err != nil {
+		return configFile, overlayError(overlayPath, "", err.Error(), err)
+	}
+
+	// 1. 저장소 제거 (이름 또는 glob, 일치하는 저장소가 없으면 오류)
+	if removeNode != nil {
+		var patterns []string
+		if err := removeNode.Decode(&patterns); err != nil {
+			return configFile, overlayError(overlayPath, "remove", "remove must be a list of repository names or globs", err)
+		}
+		removed, err := FilterByNames(merged.Repositories, patterns)
+		if err != nil {
+			return configFile, overlayError(overlayPath, "remove", err.Error(), err)
+		}
+		names := make(map[string]bool, len(removed))
+		for _, repo := range removed {
+			names[repo.Name] = true
+		}
+		kept := merged.Repositories[:0]
+		for _, repo := range merged.Repositories {
+			if !names[repo.Name] {
+				kept = append(kept, repo)
+			}
+		}
+		merged.Repositories = kept
+	}
+
+	// 2. 이름이 같은 저장소는 설정한 필드만 덮어쓰고, 없으면 추가
+	if repoNodes != nil {
+		if repoNodes.Kind != yaml.SequenceNode {
+			return configFile, overlayError(overlayPath, "repositories", "repositories must be a list", nil)
+		}
+		for i, node := range repoNodes.Content {
+			field := fmt.Sprintf("repositories[%d]", i)
+			nameNode := mappingValue(node, "name")
+			if nameNode == nil || nameNode.Value == "" {
+				return configFile, overlayError(overlayPath, field, "repository entry has no name", nil)
+			}
+			index := -1
+			for j, repo := range merged.Repositories {
+				if repo.Name == nameNode.Value {
+					index = j
+					break
+				}
+			}
+
+			var repo Repository
+			if index >= 0 {
+				repo = merged.Repositories[index]
+			}
+			if err := node.Decode(&repo); err != nil {
+				return configFile, overlayError(overlayPath, fmt.Sprintf("repositories[%s]", nameNode.Value), err.Error(), err)
+			}
+			if index >= 0 {
+				merged.Repositories[index] = repo
+			} else {
+				merged.Repositories = append(merged.Repositories, repo)
+			}
+		}
+	}
+
+	merged.Profiles = configFile.Profiles
+	return merged, nil
+}
+
+// overlayError reports an invalid overlay file, naming the file in the message
+func overlayError(overlayPath, field, message string, cause error) error {
+	return &ConfigError{
+		Type:    ErrInvalidConfig,
+		Message: fmt.Sprintf("invalid overlay %s: %s", overlayPath, message),
+		Field:   field,
+		Cause:   cause,
+	}
+}
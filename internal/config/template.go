@@ -0,0 +1,117 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// PathTemplateData is the data available to path templates
+// e.g. path: "{{.Group}}/{{.Name}}" or path: "{{.Host}}/{{.Owner}}/{{.Name}}"
+type PathTemplateData struct {
+	Name   string   // 저장소 이름
+	Group  string   // 첫 번째 그룹 (없으면 빈 문자열)
+	Groups []string // 전체 그룹
+	Host   string   // URL의 호스트 (예: github.com)
+	Owner  string   // URL의 소유자/조직 경로 (예: org 또는 group/subgroup)
+}
+
+// TemplateData returns the template data of the repository
+func TemplateData(repo Repository) PathTemplateData {
+	data := PathTemplateData{
+		Name:   repo.Name,
+		Groups: repo.Groups,
+	}
+	if len(repo.Groups) > 0 {
+		data.Group = repo.Groups[0]
+	}
+	data.Host, data.Owner = splitRepoURL(repo.URL)
+	return data
+}
+
+// IsPathTemplate returns true if the path contains template tokens
+func IsPathTemplate(path string) bool {
+	return strings.Contains(path, "{{")
+}
+
+// RenderPath renders a path template for the repository
+// The result must be a relative path that stays inside base_dir
+func RenderPath(pathTemplate string, repo Repository) (string, error) {
+	tmpl, err := template.New("path").Option("missingkey=error").Parse(pathTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid path template '%s': %w", pathTemplate, err)
+	}
+
+	data := TemplateData(repo)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render path template '%s': %w", pathTemplate, err)
+	}
+
+	// 빈 토큰으로 생긴 중복 구분자 정리 (예: "/name" -> "name")
+	rendered := filepath.Clean(strings.TrimLeft(buf.String(), "/"))
+	if rendered == "." || filepath.IsAbs(rendered) || rendered == ".." || strings.HasPrefix(rendered, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path template '%s' rendered to '%s', which is not inside base_dir", pathTemplate, rendered)
+	}
+	return rendered, nil
+}
+
+// RenderURL renders a URL template for the repository (same data as path templates)
+// e.g. "git@github.com:upstream-org/{{.Name}}.git". URLs without tokens are returned unchanged.
+func RenderURL(urlTemplate string, repo Repository) (string, error) {
+	if !IsPathTemplate(urlTemplate) {
+		return urlTemplate, nil
+	}
+	tmpl, err := template.New("url").Option("missingkey=error").Parse(urlTemplate)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL template '%s': %w", urlTemplate, err)
+	}
+
+	data := TemplateData(repo)
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render URL template '%s': %w", urlTemplate, err)
+	}
+	return buf.String(), nil
+}
+
+// splitRepoURL extracts the host and owner path from a repository URL
+// Supports https://host/owner/name.git and git@host:owner/name.git
+func splitRepoURL(url string) (host, owner string) {
+	var rest string
+	switch {
+	case strings.Contains(url, "://"):
+		rest = url[strings.Index(url, "://")+3:]
+		if at := strings.Index(rest, "@"); at >= 0 && at < strings.Index(rest+"/", "/") {
+			rest = rest[at+1:]
+		}
+		slash := strings.Index(rest, "/")
+		if slash < 0 {
+			return rest, ""
+		}
+		host, rest = rest[:slash], rest[slash+1:]
+	case strings.Contains(url, ":"):
+		colon := strings.Index(url, ":")
+		host, rest = url[:colon], url[colon+1:]
+		if at := strings.Index(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+	default:
+		return "", ""
+	}
+
+	// 포트 제거
+	if colon := strings.Index(host, ":"); colon >= 0 {
+		host = host[:colon]
+	}
+
+	rest = strings.Trim(rest, "/")
+	if slash := strings.LastIndex(rest, "/"); slash >= 0 {
+		owner = rest[:slash]
+	}
+	return host, owner
+}
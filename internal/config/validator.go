@@ -2,9 +2,17 @@ package config
 
 import (
 	"fmt"
+	"net/url"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/cron"
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/mailmap"
 )
 
 // ValidateConfig validates the configuration
@@ -35,12 +43,160 @@ func ValidateConfig(config *Config) error {
 	if err := checkPathConflicts(config.Repositories, config.BaseDir); err != nil {
 		return err
 	}
+	if !config.AllowExternalPaths {
+		if err := checkPathsInsideBaseDir(config.Repositories, config.BaseDir); err != nil {
+			return err
+		}
+	}
 
 	// 5. 기본값 검증
 	if err := validateDefaults(config); err != nil {
 		return err
 	}
 
+	// 6. 그룹 이름 검증
+	if err := validateGroups(config.Repositories); err != nil {
+		return err
+	}
+
+	// 7. 정책 파일 검증
+	if err := validatePolicy(config.Policy); err != nil {
+		return err
+	}
+
+	// 8. 인증 설정 검증
+	if err := validateAuth(config.Auth, "config.auth"); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		if repo.Auth != nil {
+			if err := validateAuth(*repo.Auth, fmt.Sprintf("repositories[%s].auth", repo.Name)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// 9. 보호 경로 패턴 검증
+	if err := validateProtectedPaths(config.ProtectedPaths, "config.protected_paths"); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		if err := validateProtectedPaths(repo.ProtectedPaths, fmt.Sprintf("repositories[%s].protected_paths", repo.Name)); err != nil {
+			return err
+		}
+	}
+
+	// 10. 지표 전송 대상 검증
+	if err := validateMetrics(config.Metrics); err != nil {
+		return err
+	}
+
+	// 11. sparse checkout 경로, 플랫폼, pin 및 post_clone 단계 검증
+	for _, repo := range config.Repositories {
+		if err := validateSparsePaths(repo.SparsePaths, fmt.Sprintf("repositories[%s].sparse_paths", repo.Name)); err != nil {
+			return err
+		}
+		if err := validatePlatforms(repo.Platforms, fmt.Sprintf("repositories[%s].platforms", repo.Name)); err != nil {
+			return err
+		}
+		if err := validatePin(repo.Pin, fmt.Sprintf("repositories[%s].pin", repo.Name)); err != nil {
+			return err
+		}
+		for i, step := range repo.PostClone {
+			if strings.TrimSpace(step) == "" {
+				return &ConfigError{
+					Type:    ErrInvalidConfig,
+					Message: fmt.Sprintf("post_clone step %d cannot be empty", i+1),
+					Field:   fmt.Sprintf("repositories[%s].post_clone", repo.Name),
+				}
+			}
+		}
+	}
+
+	// 12. 예약 작업 및 알림 검증
+	if err := validateSchedule(config.Schedule); err != nil {
+		return err
+	}
+	if err := validateNotify(config.Notify); err != nil {
+		return err
+	}
+
+	// 13. 훅 검증
+	if err := validateHooks(config.Hooks, config.HookTimeout); err != nil {
+		return err
+	}
+
+	// 14. 제한 시간 및 속도 제한 검증
+	if err := validateTimeouts(config); err != nil {
+		return err
+	}
+
+	// 15. mailmap 항목 검증
+	if _, err := mailmap.ParseLines(config.Mailmap); err != nil {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: err.Error(),
+			Field:   "config.mailmap",
+			Cause:   err,
+		}
+	}
+
+	// 16. 보호 브랜치 및 태그 패턴 검증
+	if err := validateRefPatterns(config.ProtectedBranches, "config.protected_branches"); err != nil {
+		return err
+	}
+	if err := validateRefPatterns(config.ProtectedTags, "config.protected_tags"); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		if err := validateRefPatterns(repo.ProtectedBranches, fmt.Sprintf("repositories[%s].protected_branches", repo.Name)); err != nil {
+			return err
+		}
+		if err := validateRefPatterns(repo.ProtectedTags, fmt.Sprintf("repositories[%s].protected_tags", repo.Name)); err != nil {
+			return err
+		}
+	}
+
+	// 17. 추가 원격 검증
+	if err := validateRemotes(config); err != nil {
+		return err
+	}
+
+	// 18. 출력 제한 검증
+	if err := validateOutputKeep(config.OutputKeep, "config.output_keep"); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		if err := validateOutputKeep(repo.OutputKeep, fmt.Sprintf("repositories[%s].output_keep", repo.Name)); err != nil {
+			return err
+		}
+	}
+
+	// 19. 자원 제한 검증
+	if err := validateNice(config.Nice, "config.nice"); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		if err := validateNice(repo.Nice, fmt.Sprintf("repositories[%s].nice", repo.Name)); err != nil {
+			return err
+		}
+	}
+
+	// 20. 디렉토리 권한 검증
+	if err := validateDirPermissions(config); err != nil {
+		return err
+	}
+
+	// 21. git 설정 키 검증
+	if err := validateGitConfig(config); err != nil {
+		return err
+	}
+
+	// 22. 호스팅 서비스 검증
+	if err := validateProviders(config.Providers); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -128,17 +284,16 @@ func checkDuplicateNames(repos []Repository) error {
 }
 
 // checkPathConflicts checks for path conflicts
+// Paths that differ only in case are conflicts too: they are the same directory on
+// case-insensitive filesystems (macOS, Windows), and config files are shared across platforms
 func checkPathConflicts(repos []Repository, baseDir string) error {
-	seen := make(map[string]string) // path -> repository name
+	seen := make(map[string]string)       // path -> repository name
+	folded := make(map[string]string)     // 대소문자 무시 path -> repository name
+	foldedPath := make(map[string]string) // 대소문자 무시 path -> 원래 path
 
 	for _, repo := range repos {
 		// 최종 경로 계산: BaseDir + Path (또는 Name)
-		var repoPath string
-		if repo.Path != "" {
-			repoPath = filepath.Join(baseDir, repo.Path)
-		} else {
-			repoPath = filepath.Join(baseDir, repo.Name)
-		}
+		repoPath := GetRepositoryPath(repo, baseDir)
 
 		// 정규화 (절대 경로로 변환)
 		absPath, err := filepath.Abs(repoPath)
@@ -160,7 +315,41 @@ func checkPathConflicts(repos []Repository, baseDir string) error {
 			}
 		}
 
+		// 대소문자만 다른 경로 체크
+		key := strings.ToLower(absPath)
+		if existingRepo, exists := folded[key]; exists {
+			return &ConfigError{
+				Type: ErrPathConflict,
+				Message: fmt.Sprintf("path conflict: repositories '%s' and '%s' have paths that differ only in case (%s, %s) and would collide on case-insensitive filesystems (macOS, Windows)",
+					existingRepo, repo.Name, foldedPath[key], absPath),
+				Field: "repositories[].path",
+			}
+		}
+
 		seen[absPath] = repo.Name
+		folded[key] = repo.Name
+		foldedPath[key] = absPath
+	}
+
+	return nil
+}
+
+// checkPathsInsideBaseDir ensures every repository resolves to a directory below base_dir
+// A path like "../other" would let destructive operations (pull --force, exec, removing a failed clone)
+// operate outside base_dir; allow_external_paths: true permits it.
+func checkPathsInsideBaseDir(repos []Repository, baseDir string) error {
+	for _, repo := range repos {
+		repoPath := GetRepositoryPath(repo, baseDir)
+		rel, err := filepath.Rel(baseDir, repoPath)
+		if err == nil && rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+
+		return &ConfigError{
+			Type:    ErrPathConflict,
+			Message: fmt.Sprintf("path '%s' of repository '%s' resolves to %s, which is not inside base_dir %s (set 'allow_external_paths: true' in the config section to allow it)", repo.Path, repo.Name, repoPath, baseDir),
+			Field:   fmt.Sprintf("repositories[%s].path", repo.Name),
+		}
 	}
 
 	return nil
@@ -188,3 +377,563 @@ func validateDefaults(config *Config) error {
 
 	return nil
 }
+
+// validateGroups checks that group names are not empty
+func validateGroups(repos []Repository) error {
+	for _, repo := range repos {
+		for _, group := range repo.Groups {
+			if strings.TrimSpace(group) == "" {
+				return &ConfigError{
+					Type:    ErrInvalidConfig,
+					Message: fmt.Sprintf("empty group name in repository '%s'", repo.Name),
+					Field:   "repositories[].groups",
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validatePolicy validates the policy file entries
+func validatePolicy(policy PolicySection) error {
+	for i, file := range policy.Files {
+		path := strings.TrimSpace(file.Path)
+		if path == "" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("policy file path is required (index: %d)", i),
+				Field:   "policy.files[].path",
+			}
+		}
+
+		// 저장소 밖을 가리키는 경로 금지
+		cleaned := filepath.Clean(path)
+		if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("policy file path must be relative to the repository: %s", file.Path),
+				Field:   "policy.files[].path",
+			}
+		}
+
+		if (file.Source == "") == (file.Content == "") {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("policy file '%s' must have exactly one of 'source' or 'content'", file.Path),
+				Field:   "policy.files[]",
+			}
+		}
+
+		if file.Mode != "" && file.Mode != PolicyModeBlock && file.Mode != PolicyModeReplace {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("invalid mode '%s' for policy file '%s' (expected: block, replace)", file.Mode, file.Path),
+				Field:   "policy.files[].mode",
+			}
+		}
+	}
+	return nil
+}
+
+// validateAuth validates authentication settings
+func validateAuth(auth AuthConfig, field string) error {
+	if auth.Token != "" && auth.TokenEnv != "" {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "only one of 'token' or 'token_env' may be set",
+			Field:   field,
+		}
+	}
+	if auth.SSHKeyPassphraseEnv != "" && auth.SSHKey == "" {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "'ssh_key_passphrase_env' requires 'ssh_key'",
+			Field:   field,
+		}
+	}
+	return nil
+}
+
+// validateProtectedPaths validates protected path glob patterns
+func validateProtectedPaths(patterns []string, field string) error {
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "protected path pattern cannot be empty",
+				Field:   field,
+			}
+		}
+		if err := guard.ValidatePattern(pattern); err != nil {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: err.Error(),
+				Field:   field,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+// remoteNamePattern matches valid remote names
+var remoteNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// IsValidRemoteName returns true if the name can be used as a git remote name
+func IsValidRemoteName(name string) bool {
+	return remoteNamePattern.MatchString(name)
+}
+
+// validateRemotes checks the names of the additional remotes and renders their URLs
+// The remote the repository is cloned from (origin, default_remote) cannot be redefined
+func validateRemotes(config *Config) error {
+	checkNames := func(remotes map[string]string, field string, allowEmpty bool) error {
+		for name, url := range remotes {
+			var message string
+			switch {
+			case !IsValidRemoteName(name):
+				message = fmt.Sprintf("invalid remote name '%s'", name)
+			case name == "origin" || name == config.DefaultRemote:
+				message = fmt.Sprintf("remote '%s' is the repository's 'url' and cannot be redefined", name)
+			case url == "" && !allowEmpty:
+				message = fmt.Sprintf("remote '%s' has no URL", name)
+			default:
+				continue
+			}
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: message,
+				Field:   field,
+			}
+		}
+		return nil
+	}
+
+	if err := checkNames(config.Remotes, "config.remotes", false); err != nil {
+		return err
+	}
+	for _, repo := range config.Repositories {
+		field := fmt.Sprintf("repositories[%s].remotes", repo.Name)
+		if err := checkNames(repo.Remotes, field, true); err != nil {
+			return err
+		}
+		if _, err := config.RemotesFor(repo); err != nil {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: err.Error(),
+				Field:   field,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+// gitConfigKeyPattern matches git config keys: section.name or section.subsection.name
+var gitConfigKeyPattern = regexp.MustCompile(`^[A-Za-z0-9-]+(\..+)?\.[A-Za-z][A-Za-z0-9-]*$`)
+
+// validateGitConfig checks the keys of git_config at every level and the groups of group_git_config
+// Remotes are configured with 'remotes', not with git_config.
+func validateGitConfig(config *Config) error {
+	checkKeys := func(values map[string]string, field string) error {
+		for key := range values {
+			var message string
+			switch {
+			case !gitConfigKeyPattern.MatchString(key):
+				message = fmt.Sprintf("invalid git config key '%s' (expected section.name or section.subsection.name, e.g. pull.rebase)", key)
+			case strings.EqualFold(strings.SplitN(key, ".", 2)[0], "remote"):
+				message = fmt.Sprintf("git config key '%s' configures a remote; use 'remotes' instead", key)
+			default:
+				continue
+			}
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: message,
+				Field:   field,
+			}
+		}
+		return nil
+	}
+
+	if err := checkKeys(config.GitConfig, "config.git_config"); err != nil {
+		return err
+	}
+	for group, values := range config.GroupGitConfig {
+		field := fmt.Sprintf("config.group_git_config[%s]", group)
+		if !slices.ContainsFunc(config.Repositories, func(repo Repository) bool { return repo.HasGroup(group) }) {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("no repository is in group '%s'", group),
+				Field:   field,
+			}
+		}
+		if err := checkKeys(values, field); err != nil {
+			return err
+		}
+	}
+	for _, repo := range config.Repositories {
+		if err := checkKeys(repo.GitConfig, fmt.Sprintf("repositories[%s].git_config", repo.Name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateOutputKeep checks the output truncation policy (head, tail, both)
+func validateOutputKeep(keep, field string) error {
+	if keep == "" || slices.Contains(OutputKeepPolicies, keep) {
+		return nil
+	}
+	return &ConfigError{
+		Type:    ErrInvalidConfig,
+		Message: fmt.Sprintf("invalid output_keep '%s' (expected %s)", keep, strings.Join(OutputKeepPolicies, ", ")),
+		Field:   field,
+	}
+}
+
+// validateNice checks the CPU priority of exec commands (0-19)
+func validateNice(nice int, field string) error {
+	if nice >= 0 && nice <= MaxNice {
+		return nil
+	}
+	return &ConfigError{
+		Type:    ErrInvalidConfig,
+		Message: fmt.Sprintf("invalid nice %d (expected 0-%d)", nice, MaxNice),
+		Field:   field,
+	}
+}
+
+// validateDirPermissions checks that dir_mode and umask leave the owner full access,
+// since multi-git could not write its own clones otherwise
+func validateDirPermissions(config *Config) error {
+	if config.DirMode != 0 && config.DirMode&0o700 != 0o700 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("dir_mode %s must give the owner read, write, and execute access (e.g. 0755, 2775)", config.DirMode),
+			Field:   "config.dir_mode",
+		}
+	}
+	if config.Umask != nil && (*config.Umask > 0o777 || *config.Umask&0o700 != 0) {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("umask %s must not restrict the owner (e.g. 0002, 0027)", *config.Umask),
+			Field:   "config.umask",
+		}
+	}
+	if strings.TrimSpace(config.Group) != config.Group {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("invalid group '%s'", config.Group),
+			Field:   "config.group",
+		}
+	}
+	return nil
+}
+
+// validateRefPatterns validates protected branch or tag name patterns (path.Match syntax)
+func validateRefPatterns(patterns []string, field string) error {
+	for _, pattern := range patterns {
+		if strings.TrimSpace(pattern) == "" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "protected branch or tag pattern cannot be empty",
+				Field:   field,
+			}
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("invalid pattern '%s': %v", pattern, err),
+				Field:   field,
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+// knownOS and knownArch are the GOOS and GOARCH values accepted in platforms
+var (
+	knownOS   = []string{"linux", "darwin", "windows", "freebsd", "openbsd", "netbsd", "dragonfly", "solaris", "illumos", "aix", "android", "ios", "plan9", "js", "wasip1"}
+	knownArch = []string{"amd64", "arm64", "386", "arm", "ppc64", "ppc64le", "mips", "mipsle", "mips64", "mips64le", "riscv64", "s390x", "loong64", "wasm"}
+)
+
+// validatePlatforms checks that platform entries are "os", "os/arch", or "*/arch" with Go names
+func validatePlatforms(platforms []string, field string) error {
+	for _, platform := range platforms {
+		osName, arch, hasArch := strings.Cut(platform, "/")
+		valid := (osName == "*" && hasArch || slices.Contains(knownOS, osName)) &&
+			(!hasArch || arch == "*" && osName != "*" || slices.Contains(knownArch, arch))
+		if !valid {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("invalid platform '%s' (use a Go OS and architecture, e.g. linux, darwin/arm64, */amd64)", platform),
+				Field:   field,
+			}
+		}
+	}
+	return nil
+}
+
+// validateSparsePaths checks that sparse checkout paths are directories inside the repository
+func validateSparsePaths(paths []string, field string) error {
+	for _, path := range paths {
+		if strings.TrimSpace(path) == "" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "sparse checkout path cannot be empty",
+				Field:   field,
+			}
+		}
+
+		cleaned := filepath.Clean(path)
+		if filepath.IsAbs(cleaned) || cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("sparse checkout path must be a directory inside the repository: %s", path),
+				Field:   field,
+			}
+		}
+		if strings.ContainsAny(path, "*?[!") {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("sparse checkout path must be a directory, not a pattern: %s", path),
+				Field:   field,
+			}
+		}
+	}
+	return nil
+}
+
+// validatePin checks that a pin names a single revision (a commit SHA or a tag),
+// not a range or an expression like HEAD~1
+func validatePin(pin, field string) error {
+	if pin == "" {
+		return nil
+	}
+	if strings.HasPrefix(pin, "-") || strings.Contains(pin, "..") || strings.Contains(pin, "@{") ||
+		strings.ContainsAny(pin, " \t~^:?*[\\") || pin == "@" || pin == "HEAD" {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("pin must be a commit SHA or a tag name: %s", pin),
+			Field:   field,
+		}
+	}
+	return nil
+}
+
+// validateMetrics validates the metrics export endpoint
+func validateMetrics(metrics MetricsConfig) error {
+	if metrics.Endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(metrics.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("metrics endpoint must be an http(s) URL: %s", metrics.Endpoint),
+			Field:   "config.metrics.endpoint",
+			Cause:   err,
+		}
+	}
+	return nil
+}
+
+// unschedulableCommands cannot be run as scheduled operations
+// (long-running, interactive, or recursive)
+var unschedulableCommands = map[string]bool{
+	"schedule":   true,
+	"serve":      true,
+	"open":       true,
+	"shell-init": true,
+	"help":       true,
+}
+
+// validateSchedule validates the cron expression and command of each scheduled operation
+func validateSchedule(schedule map[string]ScheduleEntry) error {
+	for name, entry := range schedule {
+		field := fmt.Sprintf("schedule.%s", name)
+		if strings.TrimSpace(name) == "" || strings.ContainsAny(name, " \t") {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("invalid schedule name '%s' (must not be empty or contain spaces)", name),
+				Field:   field,
+			}
+		}
+
+		if _, err := cron.Parse(entry.Cron); err != nil {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: err.Error(),
+				Field:   field + ".cron",
+				Cause:   err,
+			}
+		}
+
+		args := strings.Fields(entry.Command)
+		if len(args) == 0 {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "scheduled command is required (e.g. \"fetch --prune\")",
+				Field:   field + ".command",
+			}
+		}
+		if args[0] == "multi-git" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("scheduled command must start with a multi-git subcommand, without 'multi-git': %s", entry.Command),
+				Field:   field + ".command",
+			}
+		}
+		if unschedulableCommands[args[0]] {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("'%s' cannot be scheduled", args[0]),
+				Field:   field + ".command",
+			}
+		}
+
+		if entry.Timeout < 0 {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "timeout cannot be negative",
+				Field:   field + ".timeout",
+			}
+		}
+
+		if entry.ErrorBudget != nil && *entry.ErrorBudget < 0 {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: "error_budget cannot be negative",
+				Field:   field + ".error_budget",
+			}
+		}
+	}
+	return nil
+}
+
+// validateNotify validates the failure notification webhook
+func validateNotify(notify NotifyConfig) error {
+	if notify.Webhook == "" {
+		return nil
+	}
+	u, err := url.Parse(notify.Webhook)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: fmt.Sprintf("notify webhook must be an http(s) URL: %s", notify.Webhook),
+			Field:   "config.notify.webhook",
+			Cause:   err,
+		}
+	}
+	return nil
+}
+
+// validateProviders checks that every provider is keyed by a host name and has a
+// supported type and an http(s) API URL
+func validateProviders(providers map[string]ProviderConfig) error {
+	for host, provider := range providers {
+		field := fmt.Sprintf("config.providers[%s]", host)
+		var message string
+		switch {
+		case host == "" || strings.ContainsAny(host, "/@ "):
+			message = fmt.Sprintf("provider key must be a host name such as git.corp.example, not '%s'", host)
+		case provider.Type != ProviderGitHub && provider.Type != ProviderGitLab:
+			message = fmt.Sprintf("provider type must be %s or %s, not '%s'", ProviderGitHub, ProviderGitLab, provider.Type)
+		case provider.APIURL != "":
+			u, err := url.Parse(provider.APIURL)
+			if err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "" {
+				continue
+			}
+			message = fmt.Sprintf("provider api_url must be an http(s) URL: %s", provider.APIURL)
+		default:
+			continue
+		}
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: message,
+			Field:   field,
+		}
+	}
+	return nil
+}
+
+// validateHooks checks that every hook name is pre_<operation> or post_<operation>
+// for a supported operation and has a command
+func validateHooks(hooks map[string]string, timeout time.Duration) error {
+	for name, command := range hooks {
+		field := fmt.Sprintf("hooks.%s", name)
+
+		phase, operation, _ := strings.Cut(name, "_")
+		if (phase != "pre" && phase != "post") || !slices.Contains(HookOperations, operation) {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("unknown hook '%s' (expected pre_<operation> or post_<operation>, operations: %s)", name, strings.Join(HookOperations, ", ")),
+				Field:   field,
+			}
+		}
+		if strings.TrimSpace(command) == "" {
+			return &ConfigError{
+				Type:    ErrInvalidConfig,
+				Message: fmt.Sprintf("hook '%s' has no command", name),
+				Field:   field,
+			}
+		}
+	}
+
+	if timeout < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "hook_timeout cannot be negative",
+			Field:   "config.hook_timeout",
+		}
+	}
+	return nil
+}
+
+// validateTimeouts checks that the command and repository timeouts, the rate limit, and the connection limits are not negative
+func validateTimeouts(config *Config) error {
+	if config.HTTPMaxIdleConnsPerHost < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "http_max_idle_conns_per_host cannot be negative",
+			Field:   "config.http_max_idle_conns_per_host",
+		}
+	}
+	if config.SSHMaxSessions < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "ssh_max_sessions cannot be negative",
+			Field:   "config.ssh_max_sessions",
+		}
+	}
+	if config.MaxOpsPerSecond < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "max_ops_per_second cannot be negative",
+			Field:   "config.max_ops_per_second",
+		}
+	}
+	if config.CommandTimeout < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "command_timeout cannot be negative",
+			Field:   "config.command_timeout",
+		}
+	}
+	if config.RepoTimeout < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "repo_timeout cannot be negative",
+			Field:   "config.repo_timeout",
+		}
+	}
+	if config.Retention.MaxAge < 0 {
+		return &ConfigError{
+			Type:    ErrInvalidConfig,
+			Message: "retention.max_age cannot be negative",
+			Field:   "config.retention.max_age",
+		}
+	}
+	return nil
+}
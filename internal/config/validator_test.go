@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCheckPathConflicts(t *testing.T) {
+	baseDir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		repos   []Repository
+		wantErr string // 에러 메시지에 포함될 문자열 (비어있으면 에러 없음)
+	}{
+		{
+			name:  "distinct names",
+			repos: []Repository{{Name: "api"}, {Name: "web"}},
+		},
+		{
+			name:  "nested paths",
+			repos: []Repository{{Name: "api"}, {Name: "api-docs", Path: "api/docs"}},
+		},
+		{
+			name:    "same path",
+			repos:   []Repository{{Name: "api"}, {Name: "api-v2", Path: "api"}},
+			wantErr: "resolve to the same path",
+		},
+		{
+			name:    "same path after cleaning",
+			repos:   []Repository{{Name: "api", Path: "services/api"}, {Name: "api-v2", Path: "services/./other/../api"}},
+			wantErr: "resolve to the same path",
+		},
+		{
+			name:    "names differing only in case",
+			repos:   []Repository{{Name: "api"}, {Name: "API"}},
+			wantErr: "differ only in case",
+		},
+		{
+			name:    "paths differing only in case",
+			repos:   []Repository{{Name: "api", Path: "Services/api"}, {Name: "api-v2", Path: "services/API"}},
+			wantErr: "differ only in case",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkPathError(t, checkPathConflicts(tt.repos, baseDir), tt.wantErr)
+		})
+	}
+}
+
+func TestCheckPathsInsideBaseDir(t *testing.T) {
+	baseDir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		repo    Repository
+		wantErr bool
+	}{
+		{name: "name only", repo: Repository{Name: "api"}},
+		{name: "subdirectory", repo: Repository{Name: "api", Path: "services/api"}},
+		{name: "parent segment staying inside", repo: Repository{Name: "api", Path: "services/../api"}},
+		{name: "directory name starting with dots", repo: Repository{Name: "api", Path: "..api"}},
+		{name: "absolute path joined to base_dir", repo: Repository{Name: "api", Path: "/api"}},
+		{name: "base_dir itself", repo: Repository{Name: "api", Path: "."}, wantErr: true},
+		{name: "parent of base_dir", repo: Repository{Name: "api", Path: ".."}, wantErr: true},
+		{name: "sibling of base_dir", repo: Repository{Name: "api", Path: "../other"}, wantErr: true},
+		{name: "escaping through a subdirectory", repo: Repository{Name: "api", Path: "services/../../other"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantErr := ""
+			if tt.wantErr {
+				wantErr = "not inside base_dir"
+			}
+			checkPathError(t, checkPathsInsideBaseDir([]Repository{tt.repo}, baseDir), wantErr)
+		})
+	}
+}
+
+func TestValidateConfigExternalPaths(t *testing.T) {
+	newConfig := func(allowExternal bool) *Config {
+		return &Config{
+			BaseDir:            t.TempDir(),
+			DefaultRemote:      "origin",
+			ParallelWorkers:    1,
+			AllowExternalPaths: allowExternal,
+			Repositories: []Repository{
+				{Name: "api", URL: "https://github.com/example/api.git", Path: "../api"},
+			},
+		}
+	}
+
+	checkPathError(t, ValidateConfig(newConfig(false)), "allow_external_paths")
+	checkPathError(t, ValidateConfig(newConfig(true)), "")
+}
+
+func TestValidateProviders(t *testing.T) {
+	tests := []struct {
+		name      string
+		providers map[string]ProviderConfig
+		wantErr   string // 에러 메시지에 포함될 문자열 (비어있으면 에러 없음)
+	}{
+		{name: "none"},
+		{name: "GitLab", providers: map[string]ProviderConfig{"git.corp.example": {Type: ProviderGitLab}}},
+		{name: "GitHub with API URL", providers: map[string]ProviderConfig{"code.corp.example": {Type: ProviderGitHub, APIURL: "https://code.corp.example/api/v3"}}},
+		{name: "URL as key", providers: map[string]ProviderConfig{"https://git.corp.example": {Type: ProviderGitLab}}, wantErr: "must be a host name"},
+		{name: "missing type", providers: map[string]ProviderConfig{"git.corp.example": {}}, wantErr: "provider type must be"},
+		{name: "unknown type", providers: map[string]ProviderConfig{"git.corp.example": {Type: "gitea"}}, wantErr: "provider type must be"},
+		{name: "API URL without scheme", providers: map[string]ProviderConfig{"git.corp.example": {Type: ProviderGitLab, APIURL: "git.corp.example"}}, wantErr: "http(s) URL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateProviders(tt.providers)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			var configErr *ConfigError
+			if !errors.As(err, &configErr) || !strings.Contains(configErr.Message, tt.wantErr) {
+				t.Fatalf("expected a ConfigError containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// checkPathError fails the test unless err is a path conflict containing want,
+// or nil if want is empty
+func checkPathError(t *testing.T, err error, want string) {
+	t.Helper()
+
+	if want == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+
+	var configErr *ConfigError
+	if !errors.As(err, &configErr) {
+		t.Fatalf("expected a ConfigError containing %q, got %v", want, err)
+	}
+	if configErr.Type != ErrPathConflict {
+		t.Errorf("expected error type %s, got %s", ErrPathConflict, configErr.Type)
+	}
+	if !strings.Contains(configErr.Message, want) {
+		t.Errorf("expected error message containing %q, got %q", want, configErr.Message)
+	}
+}
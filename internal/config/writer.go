@@ -0,0 +1,385 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// UpdateRepositoryURLs rewrites the url of the named repositories in the config file
+// The file is edited as a YAML document, so comments and key order are preserved
+// urls maps repository names to their new URL. Returns an error if a name is not found.
+// With a profile, the repositories of that profile are edited if it defines its own list.
+func UpdateRepositoryURLs(configPath, profile string, urls map[string]string) error {
+	unlock, err := lockConfigFile(configPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	doc, perm, err := readDocument(configPath)
+	if err != nil {
+		return err
+	}
+
+	repos := repositoryList(documentRoot(doc), profile)
+	if repos == nil || repos.Kind != yaml.SequenceNode {
+		return fmt.Errorf("config file has no repositories list")
+	}
+
+	// 저장소 이름으로 url 노드 찾아서 교체
+	updated := make(map[string]bool, len(urls))
+	for _, entry := range repos.Content {
+		name := mappingValue(entry, "name")
+		if name == nil {
+			continue
+		}
+		newURL, ok := urls[name.Value]
+		if !ok {
+			continue
+		}
+		url := mappingValue(entry, "url")
+		if url == nil {
+			continue
+		}
+		url.Value = newURL
+		url.Style = 0 // 기존 따옴표 스타일 제거 (필요 시 인코더가 다시 결정)
+		updated[name.Value] = true
+	}
+	for name := range urls {
+		if !updated[name] {
+			return fmt.Errorf("repository '%s' not found in config file", name)
+		}
+	}
+
+	return writeDocument(configPath, doc, perm)
+}
+
+// AddRepository adds a repository to the config file
+// Fails if a repository with the same name or URL exists, or if the config file would
+// not be valid with it (e.g. its path conflicts with another repository). The file is
+// edited as a YAML document, so comments and key order are preserved. With a profile,
+// the repository is added to that profile if it defines its own list.
+func AddRepository(configPath, profile string, repo Repository) error {
+	unlock, err := lockConfigFile(configPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	doc, perm, err := readDocument(configPath)
+	if err != nil {
+		return err
+	}
+
+	root := documentRoot(doc)
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("config file is not a YAML mapping")
+	}
+	list := repositoryList(root, profile)
+	if list == nil {
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "repositories"}, list)
+	}
+	if list.Kind != yaml.SequenceNode {
+		// "repositories:"만 있고 비어있는 경우
+		if list.Kind != yaml.ScalarNode || list.Value != "" {
+			return fmt.Errorf("repositories in config file is not a list")
+		}
+		*list = yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	}
+
+	// 이름과 URL 중복 확인
+	key := repositoryKey(repo.URL)
+	for _, entry := range list.Content {
+		if name := mappingValue(entry, "name"); name != nil && name.Value == repo.Name {
+			return fmt.Errorf("repository '%s' already exists in config file", repo.Name)
+		}
+		if url := mappingValue(entry, "url"); url != nil && repositoryKey(url.Value) == key {
+			existing := "another repository"
+			if name := mappingValue(entry, "name"); name != nil {
+				existing = fmt.Sprintf("repository '%s'", name.Value)
+			}
+			return fmt.Errorf("%s already points to %s", existing, repo.URL)
+		}
+	}
+
+	var entry yaml.Node
+	if err := entry.Encode(repo); err != nil {
+		return fmt.Errorf("failed to encode repository '%s': %w", repo.Name, err)
+	}
+	list.Content = append(list.Content, &entry)
+
+	return writeValidatedDocument(configPath, profile, doc, perm)
+}
+
+// RemoveRepository removes the named repository from the config file
+// The local clone is not touched. Comments and key order of the file are preserved.
+// With a profile, the repository is removed from that profile if it defines its own list.
+func RemoveRepository(configPath, profile, name string) error {
+	unlock, err := lockConfigFile(configPath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	doc, perm, err := readDocument(configPath)
+	if err != nil {
+		return err
+	}
+
+	list := repositoryList(documentRoot(doc), profile)
+	if list == nil || list.Kind != yaml.SequenceNode {
+		return fmt.Errorf("config file has no repositories list")
+	}
+
+	for i, entry := range list.Content {
+		if entryName := mappingValue(entry, "name"); entryName != nil && entryName.Value == name {
+			list.Content = append(list.Content[:i], list.Content[i+1:]...)
+			return writeValidatedDocument(configPath, profile, doc, perm)
+		}
+	}
+	return fmt.Errorf("repository '%s' not found in config file", name)
+}
+
+// repositoryList returns the repositories sequence of the config document, or nil
+// With a profile that defines its own repositories, the profile's list is returned
+func repositoryList(root *yaml.Node, profile string) *yaml.Node {
+	if profile != "" {
+		if profileRepos := mappingValue(mappingValue(mappingValue(root, "profiles"), profile), "repositories"); profileRepos != nil {
+			return profileRepos
+		}
+	}
+	return mappingValue(root, "repositories")
+}
+
+// NewConfigBaseDir is the base_dir written to config files created by AppendRepositories
+const NewConfigBaseDir = "~/repositories"
+
+// AppendRepositories adds repositories to the top-level list of the config file
+// Repositories whose name or URL is already in the file are left out; their names are
+// returned as skipped. The file is edited as a YAML document, so comments and key order
+// are preserved. If the file does not exist, a new config file is created.
+func AppendRepositories(configPath string, repos []Repository) (added, skipped []string, err error) {
+	configPath, err = expandPath(configPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to expand config path: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return nil, nil, fmt.Errorf("failed to create config directory: %w", err)
+	}
+	unlock, err := lockConfigFile(configPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	var doc *yaml.Node
+	perm := os.FileMode(0644)
+	if _, statErr := os.Stat(configPath); os.IsNotExist(statErr) {
+		doc, err = newConfigDocument()
+	} else {
+		doc, perm, err = readDocument(configPath)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := documentRoot(doc)
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, fmt.Errorf("config file is not a YAML mapping")
+	}
+	list := mappingValue(root, "repositories")
+	if list == nil {
+		list = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		root.Content = append(root.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "repositories"}, list)
+	}
+	if list.Kind != yaml.SequenceNode {
+		// "repositories:"만 있고 비어있는 경우
+		if list.Kind != yaml.ScalarNode || list.Value != "" {
+			return nil, nil, fmt.Errorf("repositories in config file is not a list")
+		}
+		*list = yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	}
+
+	// 이름과 URL로 기존 저장소 확인
+	names := make(map[string]bool)
+	urls := make(map[string]bool)
+	for _, entry := range list.Content {
+		if name := mappingValue(entry, "name"); name != nil {
+			names[name.Value] = true
+		}
+		if url := mappingValue(entry, "url"); url != nil {
+			urls[repositoryKey(url.Value)] = true
+		}
+	}
+
+	for _, repo := range repos {
+		key := repositoryKey(repo.URL)
+		if names[repo.Name] || urls[key] {
+			skipped = append(skipped, repo.Name)
+			continue
+		}
+
+		var entry yaml.Node
+		if err := entry.Encode(repo); err != nil {
+			return nil, nil, fmt.Errorf("failed to encode repository '%s': %w", repo.Name, err)
+		}
+		list.Content = append(list.Content, &entry)
+		names[repo.Name] = true
+		urls[key] = true
+		added = append(added, repo.Name)
+	}
+
+	if len(added) == 0 {
+		return nil, skipped, nil
+	}
+	if err := writeDocument(configPath, doc, perm); err != nil {
+		return nil, nil, err
+	}
+	return added, skipped, nil
+}
+
+// repositoryKey returns the URL used to detect duplicate repositories
+// HTTPS and SSH URLs of the same repository share the key
+func repositoryKey(url string) string {
+	if web := (Repository{URL: url}).WebURL(); web != "" {
+		return strings.ToLower(web)
+	}
+	return url
+}
+
+// newConfigDocument returns the YAML document of a new config file without repositories
+func newConfigDocument() (*yaml.Node, error) {
+	var doc yaml.Node
+	initial := map[string]any{
+		"config": map[string]any{
+			"base_dir":         NewConfigBaseDir,
+			"default_remote":   "origin",
+			"parallel_workers": 3,
+		},
+	}
+	if err := doc.Encode(initial); err != nil {
+		return nil, fmt.Errorf("failed to create config: %w", err)
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{&doc}}, nil
+}
+
+// readDocument parses the config file as a YAML document
+// Returns the file permissions so the file can be written back with them
+func readDocument(configPath string) (*yaml.Node, os.FileMode, error) {
+	info, err := os.Stat(configPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read config file: %w", err)
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if doc.Kind == 0 {
+		// 빈 파일
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	return &doc, info.Mode().Perm(), nil
+}
+
+// writeDocument encodes the YAML document and replaces the config file with it
+func writeDocument(configPath string, doc *yaml.Node, perm os.FileMode) error {
+	return writeDocumentChecked(configPath, doc, perm, nil)
+}
+
+// writeValidatedDocument replaces the config file with the YAML document only if the
+// result loads and validates with the profile; otherwise the file is left unchanged
+func writeValidatedDocument(configPath, profile string, doc *yaml.Node, perm os.FileMode) error {
+	return writeDocumentChecked(configPath, doc, perm, func(tmpPath string) error {
+		if _, err := LoadAndValidateProfile(tmpPath, profile); err != nil {
+			return fmt.Errorf("config file not changed: %w", err)
+		}
+		return nil
+	})
+}
+
+// writeDocumentChecked encodes the YAML document and replaces the config file with it
+// If check is set, it is called with the written temporary file before the replacement.
+// A symlinked config file keeps its link: the file it points to is replaced.
+func writeDocumentChecked(configPath string, doc *yaml.Node, perm os.FileMode, check func(tmpPath string) error) error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	// 심볼릭 링크인 설정 파일 (예: dotfiles 저장소로의 링크)은 링크가 아닌 대상 파일을 교체
+	target, err := filepath.EvalSymlinks(configPath)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("failed to resolve config file: %w", err)
+		}
+		target = configPath
+	}
+
+	// 대상 옆의 임시 파일에 쓴 후 교체 (중간에 실패해도 설정 파일이 깨지지 않도록)
+	tmp, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	tmpPath := tmp.Name()
+	_, err = tmp.Write(buf.Bytes())
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmpPath, perm)
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	if check != nil {
+		if err := check(tmpPath); err != nil {
+			_ = os.Remove(tmpPath)
+			return err
+		}
+	}
+	if err := os.Rename(tmpPath, target); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// documentRoot returns the top-level node of a parsed YAML document
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		return doc.Content[0]
+	}
+	return doc
+}
+
+// mappingValue returns the value node for key in a YAML mapping, or nil
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
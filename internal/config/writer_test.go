@@ -0,0 +1,79 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestWriteDocumentSymlink(t *testing.T) {
+	dir := t.TempDir()
+	targetDir := filepath.Join(dir, "dotfiles")
+	if err := os.Mkdir(targetDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	target := filepath.Join(targetDir, "multi-git.yaml")
+	if err := os.WriteFile(target, []byte("config:\n  base_dir: old\n"), 0640); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, "config.yaml")
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	doc, perm, err := readDocument(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mappingValue(mappingValue(documentRoot(doc), "config"), "base_dir").Value = "new"
+
+	// 검사에 실패하면 변경하지 않음
+	errCheck := errors.New("check failed")
+	if err := writeDocumentChecked(link, doc, perm, func(string) error { return errCheck }); !errors.Is(err, errCheck) {
+		t.Fatalf("expected the check error, got %v", err)
+	}
+	assertFile(t, target, "config:\n  base_dir: old\n")
+
+	if err := writeDocument(link, doc, perm); err != nil {
+		t.Fatal(err)
+	}
+	info, err := os.Lstat(link)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("config file is no longer a symlink (mode %s)", info.Mode())
+	}
+	assertFile(t, target, "config:\n  base_dir: new\n")
+	if info, err := os.Stat(target); err != nil {
+		t.Fatal(err)
+	} else if runtime.GOOS != "windows" && info.Mode().Perm() != 0640 {
+		t.Errorf("expected mode 0640, got %s", info.Mode().Perm())
+	}
+
+	// 임시 파일이 남지 않음
+	for _, d := range []string{dir, targetDir} {
+		tmpFiles, err := filepath.Glob(filepath.Join(d, ".*.tmp"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tmpFiles) > 0 {
+			t.Errorf("temporary files left behind: %v", tmpFiles)
+		}
+	}
+}
+
+// assertFile fails the test unless the file at path has the content want
+func assertFile(t *testing.T, path, want string) {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("expected %q, got %q", want, data)
+	}
+}
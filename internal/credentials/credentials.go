@@ -0,0 +1,40 @@
+package credentials
+
+import (
+	"os"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+)
+
+// GitAuth converts the repository credentials from config into git auth options
+// Secrets referenced by environment variable are read at call time
+// Returns nil if no credentials are configured (system defaults are used)
+func GitAuth(cfg *config.Config, repo config.Repository) *git.AuthOptions {
+	auth := cfg.ResolveAuth(repo)
+	if auth.IsEmpty() {
+		return nil
+	}
+
+	token := auth.Token
+	if auth.TokenEnv != "" {
+		token = os.Getenv(auth.TokenEnv)
+	}
+
+	opts := &git.AuthOptions{
+		Username:   auth.Username,
+		Password:   token,
+		SSHKeyPath: auth.SSHKey,
+	}
+	if auth.SSHKeyPassphraseEnv != "" {
+		opts.SSHKeyPassphrase = os.Getenv(auth.SSHKeyPassphraseEnv)
+	}
+	return opts
+}
+
+// NewClient returns a git client for the repository with its credentials applied
+func NewClient(cfg *config.Config, repo config.Repository) *git.Client {
+	client := git.NewClient(config.GetRepositoryPath(repo, cfg.BaseDir))
+	client.SetAuth(GitAuth(cfg, repo))
+	return client
+}
@@ -0,0 +1,218 @@
+// Package cron parses cron expressions and computes their next run times
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// aliases maps the predefined schedules to their five-field expressions
+var aliases = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// field describes the range and names of one cron field
+type field struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// maxSearch bounds the search for the next run (e.g. "0 0 30 2 *" never runs)
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Schedule is a parsed cron expression
+// Times are matched in the location of the time passed to Next
+type Schedule struct {
+	expr    string
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool // day of month가 '*' (day of week만 적용)
+	dowStar bool // day of week가 '*' (day of month만 적용)
+}
+
+// Parse parses a standard five-field cron expression
+// (minute hour day-of-month month day-of-week) or one of the
+// predefined schedules such as @daily and @hourly
+// Fields support '*', lists (1,15), ranges (1-5), steps (*/15), and
+// month and weekday names (jan, mon); 7 is also Sunday
+func Parse(expr string) (*Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if alias, ok := aliases[strings.ToLower(spec)]; ok {
+		spec = alias
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression '%s': expected 5 fields (minute hour day month weekday), got %d", expr, len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression '%s': %w", expr, err)
+		}
+		bits[i] = b
+	}
+
+	// 7은 일요일(0)과 동일
+	dow := bits[4]
+	if dow&(1<<7) != 0 {
+		dow = (dow | 1) &^ (1 << 7)
+	}
+
+	return &Schedule{
+		expr:    strings.TrimSpace(expr),
+		minute:  bits[0],
+		hour:    bits[1],
+		dom:     bits[2],
+		month:   bits[3],
+		dow:     dow,
+		domStar: parts[2] == "*" || parts[2] == "?",
+		dowStar: parts[4] == "*" || parts[4] == "?",
+	}, nil
+}
+
+// String returns the expression the schedule was parsed from
+func (s *Schedule) String() string {
+	return s.expr
+}
+
+// Next returns the first time after t that matches the schedule
+// Returns the zero time if the schedule never matches (e.g. February 30)
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(maxSearch)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches applies the cron rule for days: if both day of month and
+// day of week are restricted, a day matching either one runs
+func (s *Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) != 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case s.domStar && s.dowStar:
+		return true
+	case s.domStar:
+		return dowMatch
+	case s.dowStar:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// parseField parses one comma-separated field into a bit set
+func parseField(spec string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(spec, ",") {
+		if item == "" {
+			return 0, fmt.Errorf("empty item in %s field '%s'", f.name, spec)
+		}
+
+		rangeSpec, step := item, 1
+		if before, after, ok := strings.Cut(item, "/"); ok {
+			n, err := strconv.Atoi(after)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field", after, f.name)
+			}
+			rangeSpec, step = before, n
+		}
+
+		start, end := f.min, f.max
+		switch {
+		case rangeSpec == "*" || rangeSpec == "?":
+			if f.name == "day of week" {
+				end = 6
+			}
+		default:
+			var err error
+			if before, after, ok := strings.Cut(rangeSpec, "-"); ok {
+				if start, err = parseValue(before, f); err != nil {
+					return 0, err
+				}
+				if end, err = parseValue(after, f); err != nil {
+					return 0, err
+				}
+				if start > end {
+					return 0, fmt.Errorf("invalid range '%s' in %s field", rangeSpec, f.name)
+				}
+			} else {
+				if start, err = parseValue(rangeSpec, f); err != nil {
+					return 0, err
+				}
+				end = start
+				// "5/15"는 5부터 끝까지 15 간격
+				if step > 1 {
+					end = f.max
+				}
+			}
+		}
+
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// parseValue parses a number or name within the field's range
+func parseValue(value string, f field) (int, error) {
+	if n, ok := f.names[strings.ToLower(value)]; ok {
+		return n, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s' in %s field", value, f.name)
+	}
+	if n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s %d out of range (%d-%d)", f.name, n, f.min, f.max)
+	}
+	return n, nil
+}
@@ -0,0 +1,185 @@
+// Package deps finds and updates the dependencies declared in the manifests
+// (go.mod, package.json) at the root of a repository
+package deps
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"golang.org/x/mod/modfile"
+)
+
+// Manifest file names
+const (
+	GoMod       = "go.mod"
+	PackageJSON = "package.json"
+)
+
+// lockFiles are the files package managers update together with a manifest
+var lockFiles = map[string][]string{
+	GoMod:       {"go.mod", "go.sum"},
+	PackageJSON: {"package.json", "package-lock.json", "npm-shrinkwrap.json", "yarn.lock", "pnpm-lock.yaml"},
+}
+
+// Dependency is a dependency declared in a manifest
+type Dependency struct {
+	Manifest string // 선언된 매니페스트 (go.mod, package.json)
+	Name     string // 모듈 경로 또는 패키지 이름
+	Version  string // 선언된 버전
+	Dev      bool   // package.json devDependencies 여부
+}
+
+// Find returns the dependencies of the repository's manifests whose name matches
+// any of the patterns, sorted by manifest and name. Patterns are globs where '*'
+// matches within a path segment and a pattern matching a prefix of the name's
+// segments matches the name (e.g. "github.com/org/*" matches "github.com/org/lib/v2").
+// Indirect Go requirements are not included; they follow from 'go mod tidy'.
+func Find(repoPath string, patterns []string) ([]Dependency, error) {
+	var found []Dependency
+
+	// 1. go.mod (직접 의존성만)
+	data, err := os.ReadFile(filepath.Join(repoPath, GoMod))
+	switch {
+	case err == nil:
+		file, err := modfile.ParseLax(GoMod, data, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", GoMod, err)
+		}
+		for _, req := range file.Require {
+			if !req.Indirect && guard.MatchAny(patterns, req.Mod.Path) {
+				found = append(found, Dependency{Manifest: GoMod, Name: req.Mod.Path, Version: req.Mod.Version})
+			}
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read %s: %w", GoMod, err)
+	}
+
+	// 2. package.json (dependencies, devDependencies)
+	data, err = os.ReadFile(filepath.Join(repoPath, PackageJSON))
+	switch {
+	case err == nil:
+		var manifest struct {
+			Dependencies    map[string]string `json:"dependencies"`
+			DevDependencies map[string]string `json:"devDependencies"`
+		}
+		if err := json.Unmarshal(data, &manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", PackageJSON, err)
+		}
+		for name, version := range manifest.Dependencies {
+			if guard.MatchAny(patterns, name) {
+				found = append(found, Dependency{Manifest: PackageJSON, Name: name, Version: version})
+			}
+		}
+		for name, version := range manifest.DevDependencies {
+			if guard.MatchAny(patterns, name) {
+				found = append(found, Dependency{Manifest: PackageJSON, Name: name, Version: version, Dev: true})
+			}
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, fmt.Errorf("failed to read %s: %w", PackageJSON, err)
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		if found[i].Manifest != found[j].Manifest {
+			return found[i].Manifest < found[j].Manifest
+		}
+		return found[i].Name < found[j].Name
+	})
+	return found, nil
+}
+
+// UpdateCommands returns the shell commands that update the dependencies to the
+// version ("latest" for the newest release) with the repository's package manager
+func UpdateCommands(repoPath string, dependencies []Dependency, version string) []string {
+	var goArgs, npmArgs, npmDevArgs []string
+	for _, dep := range dependencies {
+		spec := shellQuote(dep.Name + "@" + version)
+		switch {
+		case dep.Manifest == GoMod:
+			goArgs = append(goArgs, spec)
+		case dep.Dev:
+			npmDevArgs = append(npmDevArgs, spec)
+		default:
+			npmArgs = append(npmArgs, spec)
+		}
+	}
+
+	var commands []string
+	if len(goArgs) > 0 {
+		commands = append(commands, "go get "+strings.Join(goArgs, " "), "go mod tidy")
+	}
+	add, addDev := nodeAddCommands(repoPath)
+	if len(npmArgs) > 0 {
+		commands = append(commands, add+" "+strings.Join(npmArgs, " "))
+	}
+	if len(npmDevArgs) > 0 {
+		commands = append(commands, addDev+" "+strings.Join(npmDevArgs, " "))
+	}
+	return commands
+}
+
+// Files returns the manifest and lock files the updates of the dependencies may change
+func Files(dependencies []Dependency) []string {
+	seen := make(map[string]bool)
+	var files []string
+	for _, dep := range dependencies {
+		for _, file := range lockFiles[dep.Manifest] {
+			if !seen[file] {
+				seen[file] = true
+				files = append(files, file)
+			}
+		}
+	}
+	return files
+}
+
+// DefaultTestCommand returns the test command for the manifests of the repository
+// ("go test ./..." for go.mod, the package manager's test script for package.json).
+// Returns "" if the repository has neither.
+func DefaultTestCommand(repoPath string) string {
+	var commands []string
+	if fileExists(filepath.Join(repoPath, GoMod)) {
+		commands = append(commands, "go test ./...")
+	}
+	if fileExists(filepath.Join(repoPath, PackageJSON)) {
+		switch {
+		case fileExists(filepath.Join(repoPath, "yarn.lock")):
+			commands = append(commands, "yarn test")
+		case fileExists(filepath.Join(repoPath, "pnpm-lock.yaml")):
+			commands = append(commands, "pnpm test")
+		default:
+			commands = append(commands, "npm test")
+		}
+	}
+	return strings.Join(commands, " && ")
+}
+
+// nodeAddCommands returns the commands adding dependencies and dev dependencies
+// with the package manager whose lock file the repository has (npm by default)
+func nodeAddCommands(repoPath string) (string, string) {
+	switch {
+	case fileExists(filepath.Join(repoPath, "yarn.lock")):
+		return "yarn add", "yarn add --dev"
+	case fileExists(filepath.Join(repoPath, "pnpm-lock.yaml")):
+		return "pnpm add", "pnpm add --save-dev"
+	default:
+		return "npm install", "npm install --save-dev"
+	}
+}
+
+// fileExists returns true if the path exists
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// shellQuote quotes a word for /bin/sh
+func shellQuote(word string) string {
+	return "'" + strings.ReplaceAll(word, "'", `'\''`) + "'"
+}
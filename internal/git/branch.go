@@ -0,0 +1,172 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// CreateBranch creates a local branch without checking it out
+// The branch starts at startPoint (a branch, remote branch such as origin/main,
+// tag, or commit), or at HEAD if empty
+func (c *Client) CreateBranch(name, startPoint string) error {
+	if name == "" {
+		return fmt.Errorf("branch name is required")
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	branchRef := plumbing.NewBranchReferenceName(name)
+	if _, err := repo.Reference(branchRef, false); err == nil {
+		return fmt.Errorf("branch '%s' already exists", name)
+	}
+
+	// 시작 지점 결정
+	var start plumbing.Hash
+	if startPoint == "" {
+		head, err := repo.Head()
+		if err != nil {
+			return fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		start = head.Hash()
+	} else {
+		hash, err := repo.ResolveRevision(plumbing.Revision(startPoint))
+		if err != nil {
+			return fmt.Errorf("start point '%s' not found: %w", startPoint, err)
+		}
+		start = *hash
+	}
+
+	ref := plumbing.NewHashReference(branchRef, start)
+	if err := repo.Storer.SetReference(ref); err != nil {
+		return fmt.Errorf("failed to create branch '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// DeleteBranch deletes a local branch and its tracking configuration
+// The currently checked out branch cannot be deleted
+func (c *Client) DeleteBranch(name string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	current, err := c.GetCurrentBranch()
+	if err != nil {
+		return err
+	}
+	if current == name {
+		return fmt.Errorf("cannot delete the currently checked out branch '%s'", name)
+	}
+
+	branchRef := plumbing.NewBranchReferenceName(name)
+	if _, err := repo.Reference(branchRef, false); err != nil {
+		return fmt.Errorf("branch '%s' not found", name)
+	}
+
+	if err := repo.Storer.RemoveReference(branchRef); err != nil {
+		return fmt.Errorf("failed to delete branch '%s': %w", name, err)
+	}
+
+	// 추적 설정 제거 (없으면 무시)
+	if err := repo.DeleteBranch(name); err != nil && !errors.Is(err, git.ErrBranchNotFound) {
+		return fmt.Errorf("failed to remove config for branch '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// DeleteRemoteBranch deletes a branch from the remote
+func (c *Client) DeleteRemoteBranch(name, remoteName string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	// Create refspec to delete remote branch
+	branchRef := plumbing.NewBranchReferenceName(name)
+	refSpec := config.RefSpec(fmt.Sprintf(":%s", branchRef))
+
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
+	err = repo.Push(&git.PushOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       auth,
+	})
+
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return fmt.Errorf("failed to delete remote branch '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// SetUpstream configures a local branch to track remoteName/branch
+func (c *Client) SetUpstream(branch, remoteName string) error {
+	return c.SetUpstreamTo(branch, remoteName, branch)
+}
+
+// SetUpstreamTo configures a local branch to track remoteName/remoteBranch
+func (c *Client) SetUpstreamTo(branch, remoteName, remoteBranch string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	// 기존 설정이 있으면 교체
+	if err := repo.DeleteBranch(branch); err != nil && !errors.Is(err, git.ErrBranchNotFound) {
+		return fmt.Errorf("failed to update config for branch '%s': %w", branch, err)
+	}
+	err = repo.CreateBranch(&config.Branch{
+		Name:   branch,
+		Remote: remoteName,
+		Merge:  plumbing.NewBranchReferenceName(remoteBranch),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set upstream for branch '%s': %w", branch, err)
+	}
+	return nil
+}
+
+// UpstreamRef returns the remote-tracking reference a local branch tracks
+// (e.g. refs/remotes/origin/main), or "" if no upstream is configured
+func (c *Client) UpstreamRef(branch string) (string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return "", err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("failed to read config: %w", err)
+	}
+	tracking, ok := cfg.Branches[branch]
+	if !ok || tracking.Remote == "" || tracking.Merge == "" {
+		return "", nil
+	}
+	// remote = "." 은 로컬 브랜치를 추적
+	if tracking.Remote == "." {
+		return tracking.Merge.String(), nil
+	}
+	return plumbing.NewRemoteReferenceName(tracking.Remote, tracking.Merge.Short()).String(), nil
+}
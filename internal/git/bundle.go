@@ -0,0 +1,28 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// CreateBundle writes all references of the repository (branches, tags, and
+// remote-tracking branches) with their history to a bundle file, which can be
+// cloned or fetched from like a remote. Uses the git binary.
+func (c *Client) CreateBundle(path string) error {
+	if err := os.MkdirAll(longPath(filepath.Dir(path)), 0755); err != nil {
+		return fmt.Errorf("failed to create bundle directory: %w", err)
+	}
+
+	// 임시 파일에 쓴 후 교체 (중단되어도 이전 번들 유지)
+	tmpPath := path + ".tmp"
+	if _, err := c.runGit("bundle", "create", "--quiet", tmpPath, "--all"); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write bundle: %w", err)
+	}
+	return nil
+}
@@ -122,8 +122,98 @@ func (c *Client) createNewBranch(repo *git.Repository, worktree *git.Worktree, o
 	})
 }
 
+// CheckoutCommit checks out a revision (commit hash, tag) as a detached HEAD
+// Fails with local changes unless force is set, like Checkout.
+func (c *Client) CheckoutCommit(rev string, force bool) error {
+	commit, err := c.GetCommitAtRevision(rev)
+	if err != nil {
+		return err
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	if !force {
+		hasChanges, err := c.HasLocalChanges()
+		if err != nil {
+			return fmt.Errorf("failed to check local changes: %w", err)
+		}
+		if hasChanges {
+			return fmt.Errorf("local changes would be overwritten by checkout (use --force to discard)")
+		}
+	}
+
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: commit.Hash, Force: force}); err != nil {
+		return fmt.Errorf("failed to checkout '%s': %w", rev, err)
+	}
+	return nil
+}
+
+// CheckoutBranchAt points a branch at a revision, creating it if needed, and checks it out
+// Commits on the branch after the revision are no longer on it (they stay in the reflog).
+// Fails with local changes unless force is set, like Checkout.
+func (c *Client) CheckoutBranchAt(branch, rev string, force bool) error {
+	commit, err := c.GetCommitAtRevision(rev)
+	if err != nil {
+		return err
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	if !force {
+		hasChanges, err := c.HasLocalChanges()
+		if err != nil {
+			return fmt.Errorf("failed to check local changes: %w", err)
+		}
+		if hasChanges {
+			return fmt.Errorf("local changes would be overwritten by checkout (use --force to discard)")
+		}
+	}
+
+	// 현재 브랜치를 옮기면 작업 트리가 따라오지 않으므로 먼저 커밋으로 이동
+	if err := worktree.Checkout(&git.CheckoutOptions{Hash: commit.Hash, Force: force}); err != nil {
+		return fmt.Errorf("failed to checkout '%s': %w", rev, err)
+	}
+	branchRef := plumbing.NewBranchReferenceName(branch)
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(branchRef, commit.Hash)); err != nil {
+		return fmt.Errorf("failed to move branch '%s': %w", branch, err)
+	}
+	if err := worktree.Checkout(&git.CheckoutOptions{Branch: branchRef}); err != nil {
+		return fmt.Errorf("failed to checkout branch '%s': %w", branch, err)
+	}
+	return nil
+}
+
 // Fetch fetches updates from a remote
 func (c *Client) Fetch(remoteName string) error {
+	return c.FetchWithOptions(&FetchOptions{Remote: remoteName})
+}
+
+// FetchWithOptions fetches updates from a remote with the given options
+// Returns nil if the remote is already up to date
+func (c *Client) FetchWithOptions(opts *FetchOptions) error {
+	if opts == nil {
+		opts = &FetchOptions{}
+	}
+
+	remoteName := opts.Remote
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
 	repo, err := c.OpenRepository()
 	if err != nil {
 		return err
@@ -134,9 +224,21 @@ func (c *Client) Fetch(remoteName string) error {
 		return fmt.Errorf("remote '%s' not found: %w", remoteName, err)
 	}
 
-	err = remote.Fetch(&git.FetchOptions{
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
+	fetchOpts := &git.FetchOptions{
 		Force: true,
-	})
+		Auth:  auth,
+		Prune: opts.Prune,
+	}
+	if opts.Tags {
+		fetchOpts.Tags = git.AllTags
+	}
+
+	err = remote.Fetch(fetchOpts)
 	if err != nil && err != git.NoErrAlreadyUpToDate {
 		return fmt.Errorf("failed to fetch from '%s': %w", remoteName, err)
 	}
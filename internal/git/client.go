@@ -3,10 +3,14 @@ package git
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 )
 
 // Common errors
@@ -14,11 +18,17 @@ var (
 	ErrRepositoryNotFound = errors.New("repository not found")
 	ErrRemoteNotFound     = errors.New("remote not found")
 	ErrBranchNotFound     = errors.New("branch not found")
+	ErrNotOnDisk          = errors.New("operation requires a repository on disk")
 )
 
 // Client wraps git operations for a repository
 type Client struct {
-	path string // 저장소 경로
+	path string          // 저장소 경로
+	auth *AuthOptions    // 인증 정보 (nil이면 시스템 기본값)
+	repo *git.Repository // 스토리지 기반 저장소 (nil이면 path에서 열기)
+
+	mu     sync.Mutex      // opened 보호
+	opened *git.Repository // path에서 연 저장소 (처음 사용할 때 열고 재사용)
 }
 
 // NewClient creates a new Git client for the given repository path
@@ -28,6 +38,19 @@ func NewClient(path string) *Client {
 	}
 }
 
+// NewStorageClient creates a Git client for a repository opened on any go-git storage
+// (e.g. an in-memory clone). Operations that need the git binary return ErrNotOnDisk.
+func NewStorageClient(repo *git.Repository) *Client {
+	return &Client{
+		repo: repo,
+	}
+}
+
+// SetAuth sets the credentials used for remote operations
+func (c *Client) SetAuth(auth *AuthOptions) {
+	c.auth = auth
+}
+
 // Path returns the repository path
 func (c *Client) Path() string {
 	return c.path
@@ -35,25 +58,59 @@ func (c *Client) Path() string {
 
 // OpenRepository opens an existing Git repository at the client's path
 // Returns the git.Repository instance and any error encountered
+// The repository is opened on first use and reused by later calls, so the
+// operations of a client share one open repository and its object cache.
+// A failed open is not remembered (the repository may be cloned later).
 func (c *Client) OpenRepository() (*git.Repository, error) {
-	repo, err := git.PlainOpen(c.path)
+	if c.repo != nil {
+		return c.repo, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.opened != nil {
+		return c.opened, nil
+	}
+	repo, err := plainOpen(c.path)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open repository at %s: %w", c.path, err)
 	}
+	c.opened = repo
 	return repo, nil
 }
 
+// release forgets the opened repository, so the next operation opens it again
+func (c *Client) release() {
+	c.mu.Lock()
+	c.opened = nil
+	c.mu.Unlock()
+}
+
 // IsRepository checks if the path is a valid Git repository
 func (c *Client) IsRepository() bool {
-	repo, err := git.PlainOpen(c.path)
+	repo, err := c.OpenRepository()
 	if err != nil {
 		return false
 	}
+	if c.repo != nil {
+		return true
+	}
 	// Check if we can get the worktree (validates it's a real repo)
 	_, err = repo.Worktree()
 	return err == nil
 }
 
+// plainOpen opens the repository at path, following a .git file ("gitdir: <path>")
+// and the shared git directory of linked worktrees (commondir)
+// With EnableObjectCache, the repository uses the shared object cache.
+func plainOpen(path string) (*git.Repository, error) {
+	repo, err := git.PlainOpenWithOptions(longPath(path), &git.PlainOpenOptions{EnableDotGitCommonDir: true})
+	if err != nil {
+		return nil, err
+	}
+	return withObjectCache(repo)
+}
+
 // RepositoryExists checks if a repository exists at the given path
 func RepositoryExists(path string) bool {
 	client := NewClient(path)
@@ -94,6 +151,40 @@ func (c *Client) GetRemoteURL(remoteName string) (string, error) {
 	return cfg.URLs[0], nil
 }
 
+// ReplaceRemoteURL replaces oldURL with newURL in every remote that uses it
+// Returns the names of the updated remotes
+func (c *Client) ReplaceRemoteURL(oldURL, newURL string) ([]string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repository config: %w", err)
+	}
+
+	var updated []string
+	for name, remote := range cfg.Remotes {
+		for i, url := range remote.URLs {
+			if url == oldURL {
+				remote.URLs[i] = newURL
+				updated = append(updated, name)
+				break
+			}
+		}
+	}
+	if len(updated) == 0 {
+		return nil, nil
+	}
+
+	if err := repo.SetConfig(cfg); err != nil {
+		return nil, fmt.Errorf("failed to update remote URL: %w", err)
+	}
+	sort.Strings(updated)
+	return updated, nil
+}
+
 // ListRemotes returns all configured remotes
 func (c *Client) ListRemotes() ([]*config.RemoteConfig, error) {
 	repo, err := c.OpenRepository()
@@ -114,6 +205,70 @@ func (c *Client) ListRemotes() ([]*config.RemoteConfig, error) {
 	return configs, nil
 }
 
+// remoteAuth returns the authentication method for the named remote
+func (c *Client) remoteAuth(repo *git.Repository, remoteName string) (transport.AuthMethod, error) {
+	remote, err := repo.Remote(remoteName)
+	if err != nil {
+		return nil, fmt.Errorf("remote '%s' not found: %w", remoteName, err)
+	}
+
+	urls := remote.Config().URLs
+	if len(urls) == 0 {
+		return nil, nil
+	}
+	return c.auth.AuthMethod(urls[0])
+}
+
+// ListRemoteNames returns the names of all configured remotes
+func (c *Client) ListRemoteNames() ([]string, error) {
+	remotes, err := c.ListRemotes()
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(remotes))
+	for i, remote := range remotes {
+		names[i] = remote.Name
+	}
+	return names, nil
+}
+
+// AddRemote adds a remote with the given URL
+func (c *Client) AddRemote(remoteName, url string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	if _, err := repo.CreateRemote(&config.RemoteConfig{Name: remoteName, URLs: []string{url}}); err != nil {
+		return fmt.Errorf("failed to add remote '%s': %w", remoteName, err)
+	}
+	return nil
+}
+
+// SetRemoteURL replaces the URLs of an existing remote with the given URL
+func (c *Client) SetRemoteURL(remoteName, url string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := repo.Config()
+	if err != nil {
+		return fmt.Errorf("failed to read repository config: %w", err)
+	}
+	remote, ok := cfg.Remotes[remoteName]
+	if !ok {
+		return fmt.Errorf("remote '%s' not found", remoteName)
+	}
+	remote.URLs = []string{url}
+
+	if err := repo.SetConfig(cfg); err != nil {
+		return fmt.Errorf("failed to update remote URL: %w", err)
+	}
+	return nil
+}
+
 // HasRemote checks if a remote with the given name exists
 func (c *Client) HasRemote(remoteName string) bool {
 	_, err := c.GetRemote(remoteName)
@@ -179,6 +334,39 @@ func (c *Client) ListBranches() ([]string, error) {
 	return branchNames, nil
 }
 
+// ListRemoteTrackingBranches returns the branch names of the local remote-tracking
+// references (refs/remotes/<remote>/<branch>) without the remote prefix
+// Unlike ListRemoteBranches, the remote is not contacted
+func (c *Client) ListRemoteTrackingBranches() ([]string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := repo.References()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list references: %w", err)
+	}
+
+	var branchNames []string
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if !ref.Name().IsRemote() {
+			return nil
+		}
+		// "origin/feature/x" -> "feature/x" (origin/HEAD 제외)
+		_, branch, found := strings.Cut(ref.Name().Short(), "/")
+		if found && branch != "HEAD" {
+			branchNames = append(branchNames, branch)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate references: %w", err)
+	}
+
+	return branchNames, nil
+}
+
 // BranchExists checks if a local branch with the given name exists
 func (c *Client) BranchExists(branchName string) (bool, error) {
 	branches, err := c.ListBranches()
@@ -1,9 +1,11 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/go-git/go-git/v5"
@@ -18,10 +20,59 @@ func Clone(url, path string, opts *CloneOptions) error {
 	}
 
 	// 디렉토리 준비
-	if err := prepareDirectory(path); err != nil {
+	if err := prepareDirectory(path, opts.Dirs); err != nil {
 		return fmt.Errorf("failed to prepare directory: %w", err)
 	}
 
+	// partial clone과 sparse checkout은 go-git이 지원하지 않으므로 git 바이너리 사용
+	if opts.Filter != "" || len(opts.SparsePaths) > 0 {
+		if err := cloneWithGit(url, path, opts); err != nil {
+			// 실패 시 생성된 디렉토리 정리
+			_ = os.RemoveAll(path)
+			return fmt.Errorf("failed to clone repository: %w", err)
+		}
+		return applyClonePermissions(path, opts.Dirs)
+	}
+
+	cloneOpts, err := goGitCloneOptions(url, opts)
+	if err != nil {
+		return err
+	}
+
+	// 클론 실행
+	if _, err := git.PlainCloneContext(opts.context(), longPath(path), false, cloneOpts); err != nil {
+		// 실패 시 생성된 디렉토리 정리
+		_ = os.RemoveAll(path)
+		return fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	return applyClonePermissions(path, opts.Dirs)
+}
+
+// applyClonePermissions gives the new clone the configured mode and group
+// Group-writable clones also get core.sharedRepository=group for later writes.
+func applyClonePermissions(path string, dirs *DirPermissions) error {
+	if err := dirs.ApplyTree(path); err != nil {
+		return fmt.Errorf("cloned but %w", err)
+	}
+	if dirs.groupWritable() {
+		if err := shareRepository(path); err != nil {
+			return fmt.Errorf("cloned but failed to set core.sharedRepository: %w", err)
+		}
+	}
+	return nil
+}
+
+// context returns the context of the clone (context.Background() if not set)
+func (o *CloneOptions) context() context.Context {
+	if o.Context == nil {
+		return context.Background()
+	}
+	return o.Context
+}
+
+// goGitCloneOptions converts the clone options for go-git
+func goGitCloneOptions(url string, opts *CloneOptions) (*git.CloneOptions, error) {
 	// go-git 클론 옵션 설정
 	cloneOpts := &git.CloneOptions{
 		URL: url,
@@ -32,23 +83,67 @@ func Clone(url, path string, opts *CloneOptions) error {
 		cloneOpts.Depth = opts.Depth
 	}
 
-	// 특정 브랜치 클론
+	// 특정 브랜치 체크아웃
 	if opts.Branch != "" {
 		cloneOpts.ReferenceName = plumbing.NewBranchReferenceName(opts.Branch)
-		cloneOpts.SingleBranch = true
 	}
+	cloneOpts.SingleBranch = opts.SingleBranch
+
+	// 인증 설정
+	auth, err := opts.Auth.AuthMethod(url)
+	if err != nil {
+		return nil, err
+	}
+	cloneOpts.Auth = auth
 
 	// 진행 상황 출력
 	if opts.Progress != nil {
 		cloneOpts.Progress = opts.Progress
 	}
 
-	// 클론 실행
-	_, err := git.PlainClone(path, false, cloneOpts)
+	return cloneOpts, nil
+}
+
+// cloneWithGit clones with the git binary (partial clone, sparse checkout)
+func cloneWithGit(url, path string, opts *CloneOptions) error {
+	authArgs, env, err := opts.Auth.gitCommandAuth(url)
 	if err != nil {
-		// 실패 시 생성된 디렉토리 정리
-		_ = os.RemoveAll(path)
-		return fmt.Errorf("failed to clone repository: %w", err)
+		return err
+	}
+
+	args := append(authArgs, "clone", "--quiet")
+	if opts.Depth > 0 {
+		args = append(args, "--depth", strconv.Itoa(opts.Depth))
+	}
+	if opts.Branch != "" {
+		args = append(args, "--branch", opts.Branch)
+	}
+	if opts.SingleBranch {
+		args = append(args, "--single-branch")
+	} else if opts.Depth > 0 {
+		// git은 --depth에 --single-branch를 암시하므로 go-git과 동작을 맞춤
+		args = append(args, "--no-single-branch")
+	}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	if len(opts.SparsePaths) > 0 {
+		// 최상위 파일만 체크아웃한 상태로 시작
+		args = append(args, "--sparse")
+	}
+	args = append(args, "--", url, path)
+
+	if _, err := runGitCommandContext(opts.context(), filepath.Dir(path), env, args...); err != nil {
+		return err
+	}
+
+	if len(opts.SparsePaths) > 0 {
+		sparseArgs := append(authArgs, "sparse-checkout", "set", "--cone", "--")
+		sparseArgs = append(sparseArgs, opts.SparsePaths...)
+		// partial clone이면 체크아웃에 필요한 blob을 원격에서 가져오므로 인증 필요
+		if _, err := runGitCommandContext(opts.context(), path, env, sparseArgs...); err != nil {
+			return fmt.Errorf("failed to set sparse checkout paths: %w", err)
+		}
 	}
 
 	return nil
@@ -76,7 +171,7 @@ func CloneIfNotExists(url, path string, opts *CloneOptions) (bool, error) {
 }
 
 // prepareDirectory creates the parent directory if it doesn't exist
-func prepareDirectory(path string) error {
+func prepareDirectory(path string, dirs *DirPermissions) error {
 	// 이미 존재하면 에러
 	if DirectoryExists(path) {
 		return fmt.Errorf("directory already exists: %s", path)
@@ -84,7 +179,7 @@ func prepareDirectory(path string) error {
 
 	// 부모 디렉토리 생성
 	parentDir := filepath.Dir(path)
-	if err := os.MkdirAll(parentDir, 0755); err != nil {
+	if err := dirs.MkdirAll(parentDir); err != nil {
 		return fmt.Errorf("failed to create parent directory: %w", err)
 	}
 
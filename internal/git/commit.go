@@ -0,0 +1,137 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// ErrNothingToCommit is returned by Commit when no changes are staged
+var ErrNothingToCommit = errors.New("nothing to commit")
+
+// Commit stages changes according to opts and creates a commit on the current branch
+// Author and committer are taken from opts, or else from the git config (user.name, user.email)
+// Returns the hash of the new commit
+func (c *Client) Commit(opts *CommitOptions) (string, error) {
+	if opts == nil || strings.TrimSpace(opts.Message) == "" {
+		return "", fmt.Errorf("commit message is required")
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return "", err
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("failed to get worktree: %w", err)
+	}
+
+	// 1. 변경사항 stage
+	if opts.AddAll {
+		if err := worktree.AddWithOptions(&git.AddOptions{All: true}); err != nil {
+			return "", fmt.Errorf("failed to stage changes: %w", err)
+		}
+	}
+	for _, pattern := range opts.Include {
+		if err := worktree.AddGlob(pattern); err != nil && !errors.Is(err, git.ErrGlobNoMatches) {
+			return "", fmt.Errorf("failed to stage '%s': %w", pattern, err)
+		}
+	}
+
+	// 2. stage된 변경사항 확인
+	if !opts.AllowEmpty {
+		staged, err := c.hasStagedChanges(worktree)
+		if err != nil {
+			return "", err
+		}
+		if !staged {
+			return "", ErrNothingToCommit
+		}
+	}
+
+	// 3. 작성자와 커미터 확인 (지정하지 않으면 git config)
+	author := withTime(opts.Author)
+	if author == nil {
+		author, err = configSignature(repo)
+		if err != nil {
+			return "", err
+		}
+	}
+	committer := withTime(opts.Committer)
+	if committer == nil {
+		committer = author
+	}
+
+	// Signed-off-by는 git과 같이 커미터 기준
+	message := opts.Message
+	if opts.Signoff {
+		message = fmt.Sprintf("%s\n\nSigned-off-by: %s <%s>", strings.TrimRight(message, "\n"), committer.Name, committer.Email)
+	}
+
+	// 4. 커밋 생성
+	hash, err := worktree.Commit(message, &git.CommitOptions{
+		Author:            author,
+		Committer:         committer,
+		AllowEmptyCommits: opts.AllowEmpty,
+	})
+	if err != nil {
+		if errors.Is(err, git.ErrEmptyCommit) {
+			return "", ErrNothingToCommit
+		}
+		return "", fmt.Errorf("failed to commit: %w", err)
+	}
+
+	return hash.String(), nil
+}
+
+// hasStagedChanges checks if the index differs from HEAD
+func (c *Client) hasStagedChanges(worktree *git.Worktree) (bool, error) {
+	status, err := worktree.Status()
+	if err != nil {
+		return false, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+
+	for _, file := range status {
+		if file.Staging != git.Unmodified && file.Staging != git.Untracked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// configSignature returns the signature from user.name and user.email
+// Local repository config takes precedence over global and system config
+func configSignature(repo *git.Repository) (*object.Signature, error) {
+	cfg, err := repo.ConfigScoped(config.SystemScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read git config: %w", err)
+	}
+
+	if cfg.User.Name == "" || cfg.User.Email == "" {
+		return nil, fmt.Errorf("git user.name and user.email are not configured")
+	}
+
+	return &object.Signature{
+		Name:  cfg.User.Name,
+		Email: cfg.User.Email,
+		When:  time.Now(),
+	}, nil
+}
+
+// withTime returns a copy of the signature with the current time if it has none
+func withTime(signature *object.Signature) *object.Signature {
+	if signature == nil {
+		return nil
+	}
+	copied := *signature
+	if copied.When.IsZero() {
+		copied.When = time.Now()
+	}
+	return &copied
+}
@@ -0,0 +1,81 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// credentialHelperTimeout bounds a credential helper (e.g. waiting for a locked keychain)
+const credentialHelperTimeout = 30 * time.Second
+
+// helperCredential is the username and password returned by a credential helper
+type helperCredential struct {
+	username string
+	password string
+}
+
+// helperCredentials caches the answers of the credential helpers for this run (URL -> *helperCredential, nil if none)
+var helperCredentials sync.Map
+
+// credentialFromHelpers asks the credential helpers configured in git
+// (credential.helper, e.g. osxkeychain, manager-core, store) for the credentials
+// of an http(s) URL, the way plain git does before it prompts. It never prompts
+// itself. Returns nil if no helper has credentials or git is not installed.
+func credentialFromHelpers(url string) *helperCredential {
+	if cached, ok := helperCredentials.Load(url); ok {
+		return cached.(*helperCredential)
+	}
+	cred := runCredentialFill(url)
+	helperCredentials.Store(url, cred)
+	return cred
+}
+
+// runCredentialFill runs 'git credential fill' for the URL
+func runCredentialFill(url string) *helperCredential {
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), credentialHelperTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, gitPath, "credential", "fill")
+	cmd.Stdin = strings.NewReader("url=" + url + "\n\n")
+	// 헬퍼에 자격 증명이 없을 때 터미널이나 askpass 창으로 묻지 않도록
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ASKPASS=",
+		"GCM_INTERACTIVE=never",
+	)
+	var stdout bytes.Buffer
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		return nil
+	}
+
+	cred := &helperCredential{}
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		key, value, _ := strings.Cut(line, "=")
+		switch key {
+		case "username":
+			cred.username = value
+		case "password":
+			cred.password = value
+		}
+	}
+	if cred.password == "" {
+		return nil
+	}
+	return cred
+}
+
+// isHTTPURL checks if the URL uses the http or https transport
+func isHTTPURL(url string) bool {
+	return strings.HasPrefix(url, "https://") || strings.HasPrefix(url, "http://")
+}
@@ -0,0 +1,130 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// FileChange represents the changes of a single file between two refs
+type FileChange struct {
+	Path      string `json:"path"`      // 파일 경로 (이름 변경은 "old => new")
+	Additions int    `json:"additions"` // 추가된 줄 수 (바이너리 파일은 0)
+	Deletions int    `json:"deletions"` // 삭제된 줄 수 (바이너리 파일은 0)
+	Binary    bool   `json:"binary"`    // 바이너리 파일 여부
+}
+
+// DiffStat summarizes the changes between two refs
+type DiffStat struct {
+	Commits   int          `json:"commits"`   // from에서 to까지의 커밋 수
+	Additions int          `json:"additions"` // 추가된 줄 수 합계
+	Deletions int          `json:"deletions"` // 삭제된 줄 수 합계
+	Files     []FileChange `json:"files"`     // 변경된 파일 목록
+}
+
+// RefExists returns true if the revision (branch, tag, remote branch, hash, HEAD~n) resolves to a commit
+func (c *Client) RefExists(ref string) (bool, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return false, err
+	}
+	if _, err := repo.ResolveRevision(plumbing.Revision(ref)); err != nil {
+		if err == plumbing.ErrReferenceNotFound {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to resolve '%s': %w", ref, err)
+	}
+	return true, nil
+}
+
+// GetDiffStat returns the commits and per-file line changes between two refs
+// Uses the git binary (git rev-list, git diff --numstat)
+func (c *Client) GetDiffStat(from, to string) (*DiffStat, error) {
+	output, err := c.runGit("rev-list", "--count", from+".."+to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count commits between '%s' and '%s': %w", from, to, err)
+	}
+	stat := &DiffStat{}
+	stat.Commits, err = strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return nil, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	output, err = c.runGit("diff", "--numstat", from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff '%s' and '%s': %w", from, to, err)
+	}
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		// "추가\t삭제\t경로" (바이너리 파일은 "-\t-\t경로")
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		change := FileChange{Path: fields[2]}
+		if fields[0] == "-" && fields[1] == "-" {
+			change.Binary = true
+		} else {
+			change.Additions, _ = strconv.Atoi(fields[0])
+			change.Deletions, _ = strconv.Atoi(fields[1])
+		}
+		stat.Additions += change.Additions
+		stat.Deletions += change.Deletions
+		stat.Files = append(stat.Files, change)
+	}
+	return stat, nil
+}
+
+// AheadBehind returns the number of commits ref has that base does not (ahead)
+// and that base has that ref does not (behind)
+// Uses the git binary (git rev-list --left-right --count)
+func (c *Client) AheadBehind(base, ref string) (ahead, behind int, err error) {
+	output, err := c.runGit("rev-list", "--left-right", "--count", base+"..."+ref)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to compare '%s' with '%s': %w", ref, base, err)
+	}
+	// "base에만 있는 커밋 수\tref에만 있는 커밋 수"
+	fields := strings.Fields(output)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	if behind, err = strconv.Atoi(fields[0]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	if ahead, err = strconv.Atoi(fields[1]); err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	return ahead, behind, nil
+}
+
+// RangeLog returns the non-merge commits reachable from to but not from from, newest first
+// Uses the git binary (git log from..to)
+func (c *Client) RangeLog(from, to string) ([]LogEntry, error) {
+	// 필드는 0x1f, 커밋은 0x1e로 구분
+	output, err := c.runGit("log", "--no-merges", "--format=%H%x1f%an%x1f%ae%x1f%at%x1f%s%x1e", from+".."+to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits between '%s' and '%s': %w", from, to, err)
+	}
+
+	var entries []LogEntry
+	for _, record := range strings.Split(output, "\x1e") {
+		fields := strings.Split(strings.TrimSpace(record), "\x1f")
+		if len(fields) != 5 {
+			continue
+		}
+		seconds, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected git log output: %q", record)
+		}
+		entries = append(entries, LogEntry{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Email:   fields[2],
+			Date:    time.Unix(seconds, 0),
+			Subject: fields[4],
+		})
+	}
+	return entries, nil
+}
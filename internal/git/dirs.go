@@ -0,0 +1,168 @@
+package git
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"os/user"
+	"path/filepath"
+	"runtime"
+	"strconv"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// defaultDirMode is the mode of the directories multi-git creates without a configured mode
+const defaultDirMode os.FileMode = 0755
+
+// DirPermissions are the mode and group of base_dir, the parent directories of
+// clones, and the clones themselves, e.g. to make clones on a shared build server
+// group-writable. They are not applied on Windows.
+type DirPermissions struct {
+	Mode os.FileMode // 디렉토리 권한 (setgid 포함 가능, 0 = 0755와 umask)
+	GID  int         // 소유 그룹 ID (-1 = 변경 없음)
+}
+
+// NewDirPermissions returns the permissions for an octal mode (0 = default) and
+// a group name or ID ("" = unchanged)
+func NewDirPermissions(mode uint32, group string) (*DirPermissions, error) {
+	perms := &DirPermissions{Mode: unixMode(mode), GID: -1}
+	if group == "" || runtime.GOOS == "windows" {
+		return perms, nil
+	}
+
+	if gid, err := strconv.Atoi(group); err == nil {
+		perms.GID = gid
+		return perms, nil
+	}
+	g, err := user.LookupGroup(group)
+	if _, ok := err.(user.UnknownGroupError); ok {
+		return nil, fmt.Errorf("unknown group '%s'", group)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up group '%s': %w", group, err)
+	}
+	gid, err := strconv.Atoi(g.Gid)
+	if err != nil {
+		return nil, fmt.Errorf("unknown group '%s': invalid GID %s", group, g.Gid)
+	}
+	perms.GID = gid
+	return perms, nil
+}
+
+// unixMode converts Unix permission bits (with setuid, setgid, sticky) to an os.FileMode
+func unixMode(mode uint32) os.FileMode {
+	m := os.FileMode(mode & 0777)
+	if mode&04000 != 0 {
+		m |= os.ModeSetuid
+	}
+	if mode&02000 != 0 {
+		m |= os.ModeSetgid
+	}
+	if mode&01000 != 0 {
+		m |= os.ModeSticky
+	}
+	return m
+}
+
+// isDefault returns true if nothing is configured (plain os.MkdirAll behaviour)
+func (p *DirPermissions) isDefault() bool {
+	return p == nil || runtime.GOOS == "windows" || (p.Mode == 0 && p.GID < 0)
+}
+
+// MkdirAll creates the directory and missing parents, giving the directories it
+// creates the mode (regardless of the umask) and group; existing directories are
+// not changed
+func (p *DirPermissions) MkdirAll(path string) error {
+	if p.isDefault() {
+		return os.MkdirAll(longPath(path), defaultDirMode)
+	}
+
+	// 없는 상위 디렉토리 찾기 (위에서부터 생성)
+	var missing []string
+	for dir := filepath.Clean(path); ; dir = filepath.Dir(dir) {
+		if _, err := os.Stat(dir); err == nil {
+			break
+		}
+		missing = append(missing, dir)
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	for i := len(missing) - 1; i >= 0; i-- {
+		if err := os.Mkdir(missing[i], defaultDirMode); err != nil && !os.IsExist(err) {
+			return err
+		}
+		if err := p.apply(missing[i], true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ApplyTree gives every directory and file under root the mode and group
+// Files get the mode's permission bits without execute, plus the execute bits
+// of the mode if the owner can execute the file (e.g. 2775 -> 0664 and 0775).
+func (p *DirPermissions) ApplyTree(root string) error {
+	if p.isDefault() {
+		return nil
+	}
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type()&fs.ModeSymlink != 0 {
+			return nil
+		}
+		return p.apply(path, d.IsDir())
+	})
+}
+
+// apply sets the mode and group of one directory or file
+func (p *DirPermissions) apply(path string, isDir bool) error {
+	if p.GID >= 0 {
+		if err := os.Lchown(path, -1, p.GID); err != nil {
+			return fmt.Errorf("failed to set group of %s: %w", path, err)
+		}
+	}
+	if p.Mode == 0 {
+		return nil
+	}
+
+	mode := p.Mode
+	if !isDir {
+		info, err := os.Lstat(path)
+		if err != nil {
+			return err
+		}
+		mode = p.Mode.Perm() &^ 0111
+		if info.Mode()&0100 != 0 {
+			mode |= p.Mode.Perm() & 0111
+		}
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		return fmt.Errorf("failed to set mode of %s: %w", path, err)
+	}
+	return nil
+}
+
+// groupWritable returns true if the mode lets the group write
+func (p *DirPermissions) groupWritable() bool {
+	return !p.isDefault() && p.Mode&0020 != 0
+}
+
+// shareRepository sets core.sharedRepository=group in the clone, so that git
+// keeps objects and refs it writes later group-writable like the clone itself
+func shareRepository(path string) error {
+	repo, err := git.PlainOpen(longPath(path))
+	if err != nil {
+		return err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return err
+	}
+	cfg.Raw.Section("core").SetOption("sharedRepository", "group")
+	return repo.SetConfig(cfg)
+}
@@ -0,0 +1,112 @@
+package git
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// DefaultDiscoverDepth is how many directory levels below the root are searched for repositories
+const DefaultDiscoverDepth = 3
+
+// DefaultDiscoverIgnore are the directories never searched: hidden directories
+// and dependency directories that may contain checked out packages
+var DefaultDiscoverIgnore = []string{".*", "node_modules", "vendor"}
+
+// DiscoverOptions configures the search for repositories in a directory tree
+type DiscoverOptions struct {
+	MaxDepth int      // 탐색할 최대 디렉토리 깊이 (1 = 루트의 하위 디렉토리만)
+	Ignore   []string // 제외할 디렉토리 glob (이름 또는 루트 기준 상대 경로)
+	Remote   string   // URL을 읽을 원격 이름 (예: origin)
+}
+
+// DiscoveredRepository is a repository found in the directory tree
+type DiscoveredRepository struct {
+	Path string // 루트 기준 상대 경로 ('/' 구분, 루트 자체면 ".")
+	URL  string // 원격 URL (원격이 없으면 빈 문자열)
+}
+
+// Discover searches the directory tree under root for git repositories, down to
+// MaxDepth levels. The search does not descend into repositories it found (nested
+// repositories and submodules are left to their parent). Results are sorted by path.
+func Discover(root string, opts DiscoverOptions) ([]DiscoveredRepository, error) {
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+
+	var found []DiscoveredRepository
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// 읽을 수 없는 하위 디렉토리는 건너뜀
+			if p != root && d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel != "." && discoverIgnored(rel, opts.Ignore) {
+			return filepath.SkipDir
+		}
+
+		if _, err := os.Lstat(filepath.Join(p, ".git")); err == nil {
+			found = append(found, DiscoveredRepository{Path: rel, URL: remoteURL(p, opts.Remote)})
+			return filepath.SkipDir
+		}
+		if rel != "." && depth(rel) >= opts.MaxDepth {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return found, nil
+}
+
+// discoverIgnored returns true if the directory name or its relative path matches an ignore pattern
+func discoverIgnored(rel string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, path.Base(rel)); ok {
+			return true
+		}
+		if ok, _ := path.Match(pattern, rel); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// depth returns the number of directory levels of a relative slash path
+func depth(rel string) int {
+	n := 1
+	for _, c := range rel {
+		if c == '/' {
+			n++
+		}
+	}
+	return n
+}
+
+// remoteURL returns the first URL of the named remote of the repository, or ""
+func remoteURL(repoPath, remoteName string) string {
+	repo, err := git.PlainOpen(longPath(repoPath))
+	if err != nil {
+		return ""
+	}
+	remote, err := repo.Remote(remoteName)
+	if err != nil || len(remote.Config().URLs) == 0 {
+		return ""
+	}
+	return remote.Config().URLs[0]
+}
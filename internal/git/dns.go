@@ -0,0 +1,115 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+)
+
+// ErrHostNotFound is returned by ResolveHosts for host names that do not exist in DNS
+var ErrHostNotFound = errors.New("host not found in DNS")
+
+// RemoteHost returns the host name an operation on the remote URL connects to,
+// applying HostName of ~/.ssh/config for SSH URLs
+// Returns "" if no name has to be resolved: local paths, IP addresses, and URLs
+// reached through a proxy (which resolves the name itself).
+func RemoteHost(rawURL string) string {
+	var host string
+	switch {
+	case strings.Contains(rawURL, "://"):
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return ""
+		}
+		switch u.Scheme {
+		case "http", "https":
+			// HTTP(S)_PROXY가 적용되면 프록시가 이름을 해석
+			if proxyURL, err := http.ProxyFromEnvironment(&http.Request{URL: u}); err != nil || proxyURL != nil {
+				return ""
+			}
+			host = u.Hostname()
+		case "ssh":
+			host = sshResolvedHost(u.Hostname())
+		case "git":
+			host = u.Hostname()
+		default:
+			return ""
+		}
+	case isSCPURL(rawURL):
+		host = sshResolvedHost(sshHost(rawURL))
+	default:
+		return ""
+	}
+
+	if host == "" || net.ParseIP(host) != nil {
+		return ""
+	}
+	return host
+}
+
+// isSCPURL returns true for scp-style SSH URLs (user@host:path), not local paths
+func isSCPURL(rawURL string) bool {
+	before, _, ok := strings.Cut(rawURL, ":")
+	// "C:\repo" 같은 Windows 경로와 "./a:b" 같은 로컬 경로 제외
+	return ok && len(before) > 1 && !strings.ContainsAny(before, `/\`)
+}
+
+// sshResolvedHost returns the host an SSH connection to the host or alias dials
+// Returns "" if the connection goes through ALL_PROXY.
+func sshResolvedHost(host string) string {
+	if os.Getenv("ALL_PROXY") != "" || os.Getenv("all_proxy") != "" {
+		return ""
+	}
+	if ssh.DefaultSSHConfig != nil {
+		if configHost := ssh.DefaultSSHConfig.Get(host, "Hostname"); configHost != "" {
+			return configHost
+		}
+	}
+	return host
+}
+
+// ResolveHosts looks up the host names concurrently, waiting at most timeout
+// Returns the error of each host that could not be resolved; errors wrap
+// ErrHostNotFound if the name does not exist.
+func ResolveHosts(ctx context.Context, hosts []string, timeout time.Duration) map[string]error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	failed := make(map[string]error)
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+			_, err := net.DefaultResolver.LookupHost(ctx, host)
+			if err == nil {
+				return
+			}
+
+			var dnsErr *net.DNSError
+			switch {
+			case errors.As(err, &dnsErr) && dnsErr.IsNotFound:
+				err = fmt.Errorf("%w: %s", ErrHostNotFound, host)
+			case ctx.Err() != nil:
+				err = fmt.Errorf("cannot resolve host %s: DNS lookup timed out after %s", host, timeout)
+			default:
+				err = fmt.Errorf("cannot resolve host %s: %w", host, err)
+			}
+			mu.Lock()
+			failed[host] = err
+			mu.Unlock()
+		}(host)
+	}
+	wg.Wait()
+	return failed
+}
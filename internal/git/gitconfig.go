@@ -0,0 +1,133 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/config"
+	format "github.com/go-git/go-git/v5/plumbing/format/config"
+)
+
+// ConfigDrift is a git config key whose value differs from the wanted value
+type ConfigDrift struct {
+	Key     string // 설정 키 (예: pull.rebase)
+	Want    string // 원하는 값
+	Current string // 현재 값 (없으면 빈 문자열)
+	Missing bool   // 키가 설정되지 않음
+}
+
+// splitConfigKey splits a git config key into section, subsection, and name
+// (e.g. "branch.main.rebase" -> "branch", "main", "rebase")
+func splitConfigKey(key string) (section, subsection, name string, err error) {
+	first := strings.Index(key, ".")
+	last := strings.LastIndex(key, ".")
+	if first <= 0 || last == len(key)-1 {
+		return "", "", "", fmt.Errorf("invalid git config key '%s' (expected section.name or section.subsection.name)", key)
+	}
+	section, name = key[:first], key[last+1:]
+	if first != last {
+		subsection = key[first+1 : last]
+	}
+	return section, subsection, name, nil
+}
+
+// ConfigDrift returns the keys of the repository config (.git/config) whose value
+// differs from values, sorted by key
+func (c *Client) ConfigDrift(values map[string]string) ([]ConfigDrift, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repository config: %w", err)
+	}
+
+	var drift []ConfigDrift
+	for _, key := range sortedConfigKeys(values) {
+		section, subsection, name, err := splitConfigKey(key)
+		if err != nil {
+			return nil, err
+		}
+		options := rawOptions(cfg.Raw, section, subsection)
+		current := options.Get(name)
+		if !options.Has(name) {
+			drift = append(drift, ConfigDrift{Key: key, Want: values[key], Missing: true})
+		} else if current != values[key] {
+			drift = append(drift, ConfigDrift{Key: key, Want: values[key], Current: current})
+		}
+	}
+	return drift, nil
+}
+
+// SetConfigValues writes the keys of values that differ into the repository
+// config (.git/config), replacing all values of a key
+// Returns the keys that were changed, sorted.
+func (c *Client) SetConfigValues(values map[string]string) ([]string, error) {
+	drift, err := c.ConfigDrift(values)
+	if err != nil || len(drift) == 0 {
+		return nil, err
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := repo.Config()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read repository config: %w", err)
+	}
+
+	changed := make([]string, 0, len(drift))
+	for _, d := range drift {
+		section, subsection, name, _ := splitConfigKey(d.Key)
+		if subsection == "" {
+			cfg.Raw.Section(section).SetOption(name, d.Want)
+		} else {
+			cfg.Raw.Section(section).Subsection(subsection).SetOption(name, d.Want)
+		}
+		changed = append(changed, d.Key)
+	}
+
+	// 원시 설정을 다시 읽어 go-git의 구조화된 필드(user.email 등)와 일치시킴
+	// (그대로 저장하면 이전 값의 구조화된 필드가 원시 값을 덮어씀)
+	var buf bytes.Buffer
+	if err := format.NewEncoder(&buf).Encode(cfg.Raw); err != nil {
+		return nil, fmt.Errorf("failed to encode repository config: %w", err)
+	}
+	updated, err := config.ReadConfig(&buf)
+	if err != nil {
+		return nil, fmt.Errorf("invalid git config: %w", err)
+	}
+	if err := repo.SetConfig(updated); err != nil {
+		return nil, fmt.Errorf("failed to write repository config: %w", err)
+	}
+	return changed, nil
+}
+
+// rawOptions returns the options of a section or subsection (empty if it does not exist)
+func rawOptions(raw *format.Config, section, subsection string) format.Options {
+	if !raw.HasSection(section) {
+		return nil
+	}
+	s := raw.Section(section)
+	if subsection == "" {
+		return s.Options
+	}
+	if !s.HasSubsection(subsection) {
+		return nil
+	}
+	return s.Subsection(subsection).Options
+}
+
+// sortedConfigKeys returns the keys of the map in sorted order
+func sortedConfigKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
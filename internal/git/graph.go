@@ -0,0 +1,167 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// RefTip represents a branch or tag and the commit it points to
+type RefTip struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+}
+
+// CommitInfo represents a single commit in the graph
+type CommitInfo struct {
+	Hash    string    `json:"hash"`
+	Parents []string  `json:"parents"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+}
+
+// CommitGraph represents commits, tags, and branch tips of a repository
+type CommitGraph struct {
+	Head      string       `json:"head"`                // HEAD 커밋
+	Since     string       `json:"since,omitempty"`     // --since가 가리키는 커밋
+	Branches  []RefTip     `json:"branches"`            // 로컬 브랜치 끝
+	Tags      []RefTip     `json:"tags"`                // 태그 (annotated tag는 대상 커밋으로 변환)
+	Commits   []CommitInfo `json:"commits"`             // 최신순 커밋 목록
+	Truncated bool         `json:"truncated,omitempty"` // MaxCommits로 잘렸는지 여부
+}
+
+// GetCommitGraph collects commits reachable from local branch tips,
+// excluding those reachable from opts.Since
+func (c *Client) GetCommitGraph(opts *GraphOptions) (*CommitGraph, error) {
+	if opts == nil {
+		opts = &GraphOptions{}
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &CommitGraph{
+		Branches: []RefTip{},
+		Tags:     []RefTip{},
+		Commits:  []CommitInfo{},
+	}
+
+	if head, err := repo.Head(); err == nil {
+		graph.Head = head.Hash().String()
+	}
+
+	// 1. 브랜치 끝 수집
+	branches, err := repo.Branches()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+	var tips []plumbing.Hash
+	err = branches.ForEach(func(ref *plumbing.Reference) error {
+		graph.Branches = append(graph.Branches, RefTip{Name: ref.Name().Short(), Commit: ref.Hash().String()})
+		tips = append(tips, ref.Hash())
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate branches: %w", err)
+	}
+
+	// 2. 태그 수집 (annotated tag는 커밋으로 변환)
+	tags, err := repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	err = tags.ForEach(func(ref *plumbing.Reference) error {
+		hash := ref.Hash()
+		if tagObj, err := repo.TagObject(hash); err == nil {
+			commit, err := tagObj.Commit()
+			if err != nil {
+				// 커밋이 아닌 객체를 가리키는 태그는 제외
+				return nil
+			}
+			hash = commit.Hash
+		}
+		graph.Tags = append(graph.Tags, RefTip{Name: ref.Name().Short(), Commit: hash.String()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to iterate tags: %w", err)
+	}
+
+	// 3. --since에서 도달 가능한 커밋 제외 집합 구성
+	excluded := make(map[plumbing.Hash]bool)
+	if opts.Since != "" {
+		sinceHash, err := repo.ResolveRevision(plumbing.Revision(opts.Since))
+		if err != nil {
+			return nil, fmt.Errorf("revision '%s' not found: %w", opts.Since, err)
+		}
+		graph.Since = sinceHash.String()
+
+		sinceCommit, err := repo.CommitObject(*sinceHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit for '%s': %w", opts.Since, err)
+		}
+		iter := object.NewCommitPreorderIter(sinceCommit, nil, nil)
+		err = iter.ForEach(func(commit *object.Commit) error {
+			excluded[commit.Hash] = true
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to walk history of '%s': %w", opts.Since, err)
+		}
+	}
+
+	// 4. 브랜치 끝에서 도달 가능한 커밋 수집
+	seen := make(map[plumbing.Hash]bool)
+	queue := tips
+	for len(queue) > 0 {
+		hash := queue[0]
+		queue = queue[1:]
+		if seen[hash] || excluded[hash] {
+			continue
+		}
+		seen[hash] = true
+
+		commit, err := repo.CommitObject(hash)
+		if err != nil {
+			if errors.Is(err, plumbing.ErrObjectNotFound) {
+				// shallow clone 경계
+				continue
+			}
+			return nil, fmt.Errorf("failed to get commit %s: %w", hash, err)
+		}
+
+		info := CommitInfo{
+			Hash:    commit.Hash.String(),
+			Parents: make([]string, 0, len(commit.ParentHashes)),
+			Author:  commit.Author.Name,
+			Email:   commit.Author.Email,
+			Date:    commit.Author.When,
+			Subject: strings.SplitN(strings.TrimSpace(commit.Message), "\n", 2)[0],
+		}
+		for _, parent := range commit.ParentHashes {
+			info.Parents = append(info.Parents, parent.String())
+			queue = append(queue, parent)
+		}
+		graph.Commits = append(graph.Commits, info)
+	}
+
+	// 최신순 정렬 후 최대 개수 적용
+	sort.SliceStable(graph.Commits, func(i, j int) bool {
+		return graph.Commits[i].Date.After(graph.Commits[j].Date)
+	})
+	if opts.MaxCommits > 0 && len(graph.Commits) > opts.MaxCommits {
+		graph.Commits = graph.Commits[:opts.MaxCommits]
+		graph.Truncated = true
+	}
+
+	return graph, nil
+}
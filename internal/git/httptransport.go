@@ -0,0 +1,66 @@
+package git
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+)
+
+// DefaultHTTPMaxIdleConnsPerHost is how many idle connections to one host are kept
+// for reuse when no limit is configured (net/http keeps only 2)
+const DefaultHTTPMaxIdleConnsPerHost = 16
+
+// httpTransportCacheSize is how many transports for endpoints with their own TLS
+// or proxy settings are kept, so those endpoints also reuse their connections
+const httpTransportCacheSize = 64
+
+// HTTPTransport is a go-git transport for HTTP(S) remotes that shares one tuned
+// net/http transport across the operations of a run: connections are kept
+// alive and reused between repositories on the same host, and HTTP/2 is
+// negotiated where the server supports it, so fetching 100 repositories from
+// one host does not perform 100 TLS handshakes.
+type HTTPTransport struct {
+	transport *http.Transport
+}
+
+// EnableHTTPTransport installs a shared transport for the http:// and https://
+// URLs of go-git operations and returns it
+// maxIdleConnsPerHost <= 0 uses DefaultHTTPMaxIdleConnsPerHost. Close the
+// transport when the run is over to close its idle connections and restore
+// the default transport.
+func EnableHTTPTransport(maxIdleConnsPerHost int) *HTTPTransport {
+	if maxIdleConnsPerHost <= 0 {
+		maxIdleConnsPerHost = DefaultHTTPMaxIdleConnsPerHost
+	}
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          0, // 전체 유휴 연결 수는 제한 없음 (호스트별로 제한)
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+
+	gitClient := githttp.NewClientWithOptions(&http.Client{Transport: transport}, &githttp.ClientOptions{
+		CacheMaxEntries: httpTransportCacheSize,
+	})
+	client.InstallProtocol("http", gitClient)
+	client.InstallProtocol("https", gitClient)
+	return &HTTPTransport{transport: transport}
+}
+
+// Close restores the default HTTP transport and closes the idle connections
+func (t *HTTPTransport) Close() error {
+	client.InstallProtocol("http", githttp.DefaultClient)
+	client.InstallProtocol("https", githttp.DefaultClient)
+	t.transport.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,298 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/mailmap"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+// LogOptions represents options for listing commits
+type LogOptions struct {
+	Ref     string           // 시작 revision (비어있으면 HEAD)
+	Since   time.Time        // 이 시각 이후의 커밋만 (zero면 제한 없음)
+	Author  string           // 작성자 이름 또는 이메일에 포함된 문자열 (대소문자 무시, 선택적)
+	Max     int              // 최대 커밋 수 (0 = 제한 없음)
+	Mailmap *mailmap.Mailmap // 작성자 이름/이메일 정규화 (선택적, Author 필터도 정규화된 값에 적용)
+	Grep    *regexp.Regexp   // 커밋 메시지가 일치하는 커밋만 (선택적)
+	Pickaxe string           // 이 문자열의 등장 횟수를 바꾼 커밋만 (git log -S, 선택적)
+	Paths   []string         // 이 경로 패턴 중 하나와 일치하는 파일을 바꾼 커밋만 (선택적, '**' 지원)
+}
+
+// LogEntry represents a commit listed by Log
+type LogEntry struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body,omitempty"`
+	Files   []string  `json:"files,omitempty"` // LogOptions.Paths와 일치하는 변경된 파일
+}
+
+// ShortHash returns the first 7 characters of the commit hash
+func (e LogEntry) ShortHash() string {
+	if len(e.Hash) > 7 {
+		return e.Hash[:7]
+	}
+	return e.Hash
+}
+
+// Log returns the commits reachable from opts.Ref (or HEAD), newest first
+// Returns an empty list for a repository without commits.
+func (c *Client) Log(opts *LogOptions) ([]LogEntry, error) {
+	if opts == nil {
+		opts = &LogOptions{}
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	// 1. 시작 커밋 결정
+	var from plumbing.Hash
+	if opts.Ref == "" {
+		head, err := repo.Head()
+		if err != nil {
+			if errors.Is(err, plumbing.ErrReferenceNotFound) {
+				return []LogEntry{}, nil
+			}
+			return nil, fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		from = head.Hash()
+	} else {
+		hash, err := repo.ResolveRevision(plumbing.Revision(opts.Ref))
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve '%s': %w", opts.Ref, err)
+		}
+		from = *hash
+	}
+
+	// 2. 커밋 순회 (커밋 시각 순, 최신 우선)
+	logOpts := &git.LogOptions{From: from, Order: git.LogOrderCommitterTime}
+	if !opts.Since.IsZero() {
+		logOpts.Since = &opts.Since
+	}
+	iter, err := repo.Log(logOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	defer iter.Close()
+
+	author := strings.ToLower(opts.Author)
+	entries := []LogEntry{}
+	err = iter.ForEach(func(commit *object.Commit) error {
+		name, email := opts.Mailmap.Resolve(commit.Author.Name, commit.Author.Email)
+		if author != "" &&
+			!strings.Contains(strings.ToLower(name), author) &&
+			!strings.Contains(strings.ToLower(email), author) {
+			return nil
+		}
+		if opts.Grep != nil && !opts.Grep.MatchString(commit.Message) {
+			return nil
+		}
+		var files []string
+		if len(opts.Paths) > 0 {
+			files, err = changedPaths(commit, opts.Paths)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				return nil
+			}
+		}
+		if opts.Pickaxe != "" {
+			changed, err := changesOccurrences(commit, opts.Pickaxe)
+			if err != nil {
+				return err
+			}
+			if !changed {
+				return nil
+			}
+		}
+
+		subject, body, _ := strings.Cut(strings.TrimSpace(commit.Message), "\n")
+		entries = append(entries, LogEntry{
+			Hash:    commit.Hash.String(),
+			Author:  name,
+			Email:   email,
+			Date:    commit.Author.When,
+			Subject: strings.TrimSpace(subject),
+			Body:    strings.TrimSpace(body),
+			Files:   files,
+		})
+		if opts.Max > 0 && len(entries) >= opts.Max {
+			return storer.ErrStop
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read history: %w", err)
+	}
+	return entries, nil
+}
+
+// changedPaths returns the files changed by the commit (compared to its parent)
+// that match one of the patterns. Merge commits are not searched; the commits
+// they merge are.
+func changedPaths(commit *object.Commit, patterns []string) ([]string, error) {
+	if commit.NumParents() > 1 {
+		return nil, nil
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tree of %s: %w", commit.Hash, err)
+	}
+	var parentTree *object.Tree
+	if commit.NumParents() == 1 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent of %s: %w", commit.Hash, err)
+		}
+		if parentTree, err = parent.Tree(); err != nil {
+			return nil, fmt.Errorf("failed to get tree of %s: %w", parent.Hash, err)
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", commit.Hash, err)
+	}
+	var files []string
+	for _, change := range changes {
+		name := change.To.Name
+		if name == "" {
+			name = change.From.Name // 삭제된 파일
+		}
+		if guard.MatchAny(patterns, name) {
+			files = append(files, name)
+		}
+	}
+	return files, nil
+}
+
+// changesOccurrences reports whether the commit changes the number of occurrences
+// of s in any file, like 'git log -S'. Merge commits and binary files are not searched.
+func changesOccurrences(commit *object.Commit, s string) (bool, error) {
+	if commit.NumParents() > 1 {
+		return false, nil
+	}
+
+	// 루트 커밋: 문자열을 포함한 파일이 추가됨
+	if commit.NumParents() == 0 {
+		files, err := commit.Files()
+		if err != nil {
+			return false, fmt.Errorf("failed to read files of %s: %w", commit.Hash, err)
+		}
+		found := false
+		err = files.ForEach(func(file *object.File) error {
+			if binary, err := file.IsBinary(); err != nil || binary {
+				return nil
+			}
+			content, err := file.Contents()
+			if err != nil {
+				return err
+			}
+			if strings.Contains(content, s) {
+				found = true
+				return storer.ErrStop
+			}
+			return nil
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to read files of %s: %w", commit.Hash, err)
+		}
+		return found, nil
+	}
+
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return false, fmt.Errorf("failed to get parent of %s: %w", commit.Hash, err)
+	}
+	patch, err := parent.Patch(commit)
+	if err != nil {
+		return false, fmt.Errorf("failed to diff %s: %w", commit.Hash, err)
+	}
+
+	// 파일별로 추가된 줄과 삭제된 줄의 등장 횟수 비교
+	for _, filePatch := range patch.FilePatches() {
+		if filePatch.IsBinary() {
+			continue
+		}
+		added, deleted := 0, 0
+		for _, chunk := range filePatch.Chunks() {
+			switch chunk.Type() {
+			case diff.Add:
+				added += strings.Count(chunk.Content(), s)
+			case diff.Delete:
+				deleted += strings.Count(chunk.Content(), s)
+			}
+		}
+		if added != deleted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// relativeSincePattern matches relative times like "2 weeks", "3d", "1 month ago"
+var relativeSincePattern = regexp.MustCompile(`^(\d+)\s*([a-z]+?)s?(\s+ago)?$`)
+
+// ParseSince parses the --since value of log into a point in time before now
+// Accepts relative times ("2 weeks", "3 days ago", "12h", "1mo"), "today",
+// "yesterday", and dates ("2024-01-31", RFC 3339).
+func ParseSince(value string, now time.Time) (time.Time, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	switch value {
+	case "today":
+		y, m, d := now.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
+	case "yesterday":
+		y, m, d := now.AddDate(0, 0, -1).Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, now.Location()), nil
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, strings.ToUpper(value)); err == nil {
+		return t, nil
+	}
+
+	match := relativeSincePattern.FindStringSubmatch(value)
+	if match == nil {
+		return time.Time{}, fmt.Errorf("invalid time '%s' (use e.g. '2 weeks', '3d', 'yesterday', or '2024-01-31')", value)
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time '%s': %w", value, err)
+	}
+
+	switch match[2] {
+	case "m", "min", "minute":
+		return now.Add(-time.Duration(n) * time.Minute), nil
+	case "h", "hour":
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case "d", "day":
+		return now.AddDate(0, 0, -n), nil
+	case "w", "week":
+		return now.AddDate(0, 0, -7*n), nil
+	case "mo", "month":
+		return now.AddDate(0, -n, 0), nil
+	case "y", "year":
+		return now.AddDate(-n, 0, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time unit '%s' in '%s' (use minutes, hours, days, weeks, months, or years)", match[2], value)
+}
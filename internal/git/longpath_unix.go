@@ -0,0 +1,8 @@
+//go:build !windows
+
+package git
+
+// longPath returns path unchanged; only Windows limits the path length (MAX_PATH)
+func longPath(path string) string {
+	return path
+}
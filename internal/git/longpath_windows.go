@@ -0,0 +1,30 @@
+//go:build windows
+
+package git
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// longPath returns path as an absolute path with the \\?\ prefix, so that go-git and
+// directory creation are not limited by MAX_PATH for repositories nested under a deep
+// base_dir (the git binary gets core.longpaths=true instead, see runGitCommand).
+// The prefix is added regardless of length: whether a path fits depends on the file
+// names below it, which are not known here. Relative paths are made absolute first,
+// since the prefix turns off their resolution; paths that cannot be resolved are
+// returned as is.
+func longPath(path string) string {
+	if strings.HasPrefix(path, `\\?\`) {
+		return path
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil || !filepath.IsAbs(abs) {
+		return path
+	}
+	// UNC 경로 (\\server\share\...)는 \\?\UNC\server\share\... 형식
+	if strings.HasPrefix(abs, `\\`) {
+		return `\\?\UNC\` + abs[2:]
+	}
+	return `\\?\` + abs
+}
@@ -0,0 +1,41 @@
+//go:build windows
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLongPath(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	deep := `C:\repos\` + strings.Repeat(`nested\`, 40) + "api"
+
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "drive", path: `C:\repos\api`, want: `\\?\C:\repos\api`},
+		{name: "drive with forward slashes and dots", path: `C:/repos/./team/../api`, want: `\\?\C:\repos\api`},
+		{name: "drive beyond MAX_PATH", path: deep, want: `\\?\` + deep},
+		{name: "UNC", path: `\\server\share\repos\api`, want: `\\?\UNC\server\share\repos\api`},
+		{name: "already prefixed", path: `\\?\C:\repos\api`, want: `\\?\C:\repos\api`},
+		{name: "already prefixed UNC", path: `\\?\UNC\server\share\api`, want: `\\?\UNC\server\share\api`},
+		{name: "relative", path: `repos\api`, want: `\\?\` + filepath.Join(wd, "repos", "api")},
+		{name: "current directory", path: ".", want: `\\?\` + wd},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := longPath(tt.path); got != tt.want {
+				t.Errorf("longPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// CloneMirror clones a repository as a bare mirror (like git clone --mirror)
+// Every reference of the remote (branches, tags, and others) is copied to the same
+// name; FetchMirror keeps them in sync. Depth, Branch, Filter, and SparsePaths are ignored.
+func CloneMirror(url, path string, opts *CloneOptions) error {
+	// 옵션이 nil이면 기본값 사용
+	if opts == nil {
+		opts = &CloneOptions{}
+	}
+
+	// 디렉토리 준비
+	if err := prepareDirectory(path, opts.Dirs); err != nil {
+		return fmt.Errorf("failed to prepare directory: %w", err)
+	}
+
+	auth, err := opts.Auth.AuthMethod(url)
+	if err != nil {
+		return err
+	}
+	cloneOpts := &git.CloneOptions{
+		URL:      url,
+		Auth:     auth,
+		Mirror:   true,
+		Progress: opts.Progress,
+	}
+
+	// bare 미러 클론 실행 (fetch refspec: +refs/*:refs/*)
+	if _, err := git.PlainCloneContext(opts.context(), longPath(path), true, cloneOpts); err != nil {
+		// 실패 시 생성된 디렉토리 정리
+		_ = os.RemoveAll(path)
+		return fmt.Errorf("failed to clone mirror: %w", err)
+	}
+
+	return applyClonePermissions(path, opts.Dirs)
+}
+
+// FetchMirror updates a mirror created by CloneMirror from its origin remote,
+// overwriting references that moved and removing those deleted on the remote
+// Returns false if the mirror was already up to date.
+func (c *Client) FetchMirror() (bool, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return false, fmt.Errorf("not a git repository: %s: %w\n  hint: remove it so the mirror is cloned again", c.Path(), err)
+	}
+
+	remote, err := repo.Remote("origin")
+	if err != nil || !remote.Config().Mirror {
+		return false, fmt.Errorf("not a mirror (no 'origin' remote with mirror = true): %s\n  hint: remove it so the mirror is cloned again", c.Path())
+	}
+
+	auth, err := c.remoteAuth(repo, "origin")
+	if err != nil {
+		return false, err
+	}
+
+	// 프루닝만 있어도 갱신으로 보고하도록 fetch 전후의 참조를 비교
+	before, err := referenceHashes(repo)
+	if err != nil {
+		return false, err
+	}
+	err = remote.Fetch(&git.FetchOptions{
+		Force: true,
+		Prune: true,
+		Auth:  auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return false, fmt.Errorf("failed to fetch mirror: %w", err)
+	}
+	after, err := referenceHashes(repo)
+	if err != nil {
+		return false, err
+	}
+	return !maps.Equal(before, after), nil
+}
+
+// referenceHashes returns the hash of every reference of the repository by name
+func referenceHashes(repo *git.Repository) (map[string]string, error) {
+	refs, err := repo.References()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list references: %w", err)
+	}
+	hashes := make(map[string]string)
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference {
+			hashes[ref.Name().String()] = ref.Hash().String()
+		}
+		return nil
+	})
+	return hashes, err
+}
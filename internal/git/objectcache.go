@@ -0,0 +1,140 @@
+package git
+
+import (
+	"sync"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+var (
+	sharedObjectCacheMu sync.RWMutex
+	sharedObjectCache   cache.Object // nil이면 저장소마다 go-git 기본 캐시 (96MB)
+)
+
+// ObjectCache is an object cache shared by every repository opened during a run
+// Objects read by one client are found by the next client that opens the same
+// repository, and the total size of the cache is bounded, instead of each open
+// repository keeping a cache of its own.
+type ObjectCache struct {
+	cache cache.Object
+}
+
+// EnableObjectCache makes repositories opened from now on share an object cache
+// of at most maxSize bytes and returns it
+// Close the cache when the run is over to go back to a cache per repository.
+func EnableObjectCache(maxSize int64) *ObjectCache {
+	objects := cache.NewObjectLRU(cache.FileSize(maxSize))
+
+	sharedObjectCacheMu.Lock()
+	sharedObjectCache = objects
+	sharedObjectCacheMu.Unlock()
+	return &ObjectCache{cache: objects}
+}
+
+// Close stops sharing the cache and releases the cached objects
+// Repositories already opened keep using it until they are released.
+func (c *ObjectCache) Close() error {
+	sharedObjectCacheMu.Lock()
+	if sharedObjectCache == c.cache {
+		sharedObjectCache = nil
+	}
+	sharedObjectCacheMu.Unlock()
+	c.cache.Clear()
+	return nil
+}
+
+// scopedCache is the view of one repository on the shared object cache: entries
+// are keyed by the object hash combined with the repository, so a repository
+// never gets objects it does not have from the cache
+type scopedCache struct {
+	objects cache.Object
+	scope   plumbing.Hash // 저장소 git 디렉토리 경로의 해시
+}
+
+// scopedObject is a cached object stored under its scoped key
+type scopedObject struct {
+	plumbing.EncodedObject
+	key plumbing.Hash
+}
+
+// Hash returns the scoped key the shared cache stores the object under
+func (o *scopedObject) Hash() plumbing.Hash {
+	return o.key
+}
+
+func newScopedCache(objects cache.Object, gitDir string) *scopedCache {
+	return &scopedCache{objects: objects, scope: plumbing.ComputeHash(plumbing.AnyObject, []byte(gitDir))}
+}
+
+// key combines the object hash with the repository
+func (c *scopedCache) key(h plumbing.Hash) plumbing.Hash {
+	for i := range h {
+		h[i] ^= c.scope[i]
+	}
+	return h
+}
+
+func (c *scopedCache) Put(o plumbing.EncodedObject) {
+	c.objects.Put(&scopedObject{EncodedObject: o, key: c.key(o.Hash())})
+}
+
+func (c *scopedCache) Get(h plumbing.Hash) (plumbing.EncodedObject, bool) {
+	o, ok := c.objects.Get(c.key(h))
+	if !ok {
+		return nil, false
+	}
+	return o.(*scopedObject).EncodedObject, true
+}
+
+// Clear does nothing: the entries of other repositories are kept, and the
+// entries of this repository are evicted as the cache fills up
+func (c *scopedCache) Clear() {}
+
+// cachedStorage is the storage of a repository on disk that looks objects up in
+// its cache before reading the packfile: go-git opens the packfile on every
+// lookup and only then consults the cache, which dominates the time of
+// operations that walk history (e.g. tag lists with --contains)
+type cachedStorage struct {
+	*filesystem.Storage
+	objects cache.Object // 객체 캐시 (저장소 전용 또는 공유 캐시의 저장소 범위)
+}
+
+// EncodedObject returns the object from the cache, or reads it from the storage
+func (s *cachedStorage) EncodedObject(t plumbing.ObjectType, h plumbing.Hash) (plumbing.EncodedObject, error) {
+	if obj, ok := s.objects.Get(h); ok && (t == plumbing.AnyObject || obj.Type() == t) {
+		return obj, nil
+	}
+	return s.Storage.EncodedObject(t, h)
+}
+
+// withObjectCache reopens a repository on the storage of its git directory with
+// an object cache consulted before the packfiles: the shared object cache if
+// enabled, otherwise a cache of its own
+func withObjectCache(repo *git.Repository) (*git.Repository, error) {
+	storage, ok := repo.Storer.(*filesystem.Storage)
+	if !ok {
+		return repo, nil
+	}
+
+	sharedObjectCacheMu.RLock()
+	shared := sharedObjectCache
+	sharedObjectCacheMu.RUnlock()
+	var objects cache.Object = cache.NewObjectLRUDefault()
+	if shared != nil {
+		objects = newScopedCache(shared, storage.Filesystem().Root())
+	}
+	cached := &cachedStorage{
+		Storage: filesystem.NewStorage(storage.Filesystem(), objects),
+		objects: objects,
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		// bare 저장소는 작업 트리 없이 열기
+		return git.Open(cached, nil)
+	}
+	return git.Open(cached, worktree.Filesystem)
+}
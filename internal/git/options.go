@@ -1,15 +1,24 @@
 package git
 
 import (
+	"context"
 	"io"
 	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 // CloneOptions represents options for cloning a repository
 type CloneOptions struct {
-	Depth    int       // Shallow clone depth (0 = full clone)
-	Branch   string    // 특정 브랜치만 클론
-	Progress io.Writer // 진행 상황 출력 (nil이면 출력 안 함)
+	Depth        int             // Shallow clone depth (0 = full clone)
+	Branch       string          // 클론 후 체크아웃할 브랜치 (비어있으면 원격 HEAD)
+	SingleBranch bool            // Branch(또는 원격 HEAD)의 히스토리만 가져옴
+	Filter       string          // partial clone 필터 (예: "blob:none", git 바이너리 사용)
+	SparsePaths  []string        // 체크아웃할 디렉토리 (cone 모드 sparse checkout, git 바이너리 사용)
+	Progress     io.Writer       // 진행 상황 출력 (nil이면 출력 안 함)
+	Auth         *AuthOptions    // 인증 정보 (nil이면 시스템 기본값)
+	Context      context.Context // 취소되면 클론 중단 (nil이면 취소 불가)
+	Dirs         *DirPermissions // 생성하는 디렉토리와 클론의 권한 (nil이면 0755와 umask)
 }
 
 // CheckoutOptions represents options for checking out a branch
@@ -22,11 +31,20 @@ type CheckoutOptions struct {
 
 // TagOptions represents options for tag operations
 type TagOptions struct {
-	Name      string // 태그 이름
-	Message   string // 태그 메시지 (annotated tag용)
-	Annotated bool   // annotated tag (true) vs lightweight tag (false)
-	Force     bool   // 기존 태그 덮어쓰기
-	Push      bool   // 원격에 푸시
+	Name      string            // 태그 이름
+	Message   string            // 태그 메시지 (annotated tag용)
+	Annotated bool              // annotated tag (true) vs lightweight tag (false)
+	Force     bool              // 기존 태그 덮어쓰기
+	Push      bool              // 원격에 푸시
+	Ref       string            // 태그할 커밋 (해시, 태그, 브랜치; 비어있으면 HEAD)
+	Tagger    *object.Signature // annotated tag의 tagger (nil이면 multi-git 기본값)
+}
+
+// FetchOptions represents options for fetching from remote
+type FetchOptions struct {
+	Remote string // 원격 이름 (기본: origin)
+	Prune  bool   // 원격에서 삭제된 브랜치 참조 제거
+	Tags   bool   // 모든 태그 fetch
 }
 
 // PushOptions represents options for pushing to remote
@@ -41,15 +59,35 @@ type PushOptions struct {
 
 // AuthOptions represents authentication options
 type AuthOptions struct {
-	Username string // 사용자 이름 (HTTPS용)
-	Password string // 비밀번호 또는 토큰 (HTTPS용)
-	// SSH 키는 시스템 기본값 사용
+	Username         string // 사용자 이름 (HTTPS용)
+	Password         string // 비밀번호 또는 토큰 (HTTPS용)
+	SSHKeyPath       string // SSH 개인 키 경로 (비어있으면 ssh-agent 사용)
+	SSHKeyPassphrase string // SSH 키 암호 (선택적)
 }
 
 // PullOptions represents options for pulling from remote
 type PullOptions struct {
-	Remote     string // 원격 이름 (기본: origin)
-	Branch     string // 풀할 브랜치 이름 (비어있으면 현재 브랜치)
-	Force      bool   // 강제 풀 (로컬 변경사항 무시)
-	FetchFirst bool   // fetch 먼저 수행
+	Remote     string          // 원격 이름 (기본: origin)
+	Branch     string          // 풀할 브랜치 이름 (비어있으면 현재 브랜치)
+	Force      bool            // 강제 풀 (로컬 변경사항 무시)
+	FetchFirst bool            // fetch 먼저 수행
+	Progress   io.Writer       // 진행 상황 출력 (nil이면 출력 안 함)
+	Context    context.Context // 취소되면 풀 중단 (nil이면 취소 불가)
+}
+
+// GraphOptions represents options for reading the commit graph
+type GraphOptions struct {
+	Since      string // 이 revision(태그, 브랜치, 커밋)에서 도달 가능한 커밋 제외 (비어있으면 전체)
+	MaxCommits int    // 최대 커밋 수 (0 = 제한 없음)
+}
+
+// CommitOptions represents options for creating a commit
+type CommitOptions struct {
+	Message    string            // 커밋 메시지 (필수)
+	AddAll     bool              // 추적되지 않은 파일을 포함한 모든 변경사항 stage
+	Include    []string          // 이 glob 패턴에 맞는 경로만 stage
+	AllowEmpty bool              // 변경사항이 없어도 커밋 생성
+	Signoff    bool              // Signed-off-by 트레일러 추가
+	Author     *object.Signature // 작성자 (nil이면 git config user.name/email)
+	Committer  *object.Signature // 커미터 (nil이면 작성자)
 }
@@ -0,0 +1,51 @@
+package git
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FormatPatch writes one email-style patch file per commit in from..to to outDir
+// Uses the git binary (git format-patch). Returns the names of the written files
+// in order; an empty list if there are no commits in the range. Empty commits
+// carry no changes and are left out.
+func (c *Client) FormatPatch(from, to, outDir string) ([]string, error) {
+	output, err := c.runGit("format-patch", "--output-directory", outDir, from+".."+to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export patches for %s..%s: %w", from, to, err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		// 빈 커밋은 내용 없는 파일로 쓰여 git am이 거부하므로 제외
+		if info, err := os.Stat(filepath.Join(outDir, filepath.Base(line))); err == nil && info.Size() == 0 {
+			_ = os.Remove(filepath.Join(outDir, filepath.Base(line)))
+			continue
+		}
+		files = append(files, filepath.Base(line))
+	}
+	return files, nil
+}
+
+// ApplyPatches applies email-style patch files as commits on the current branch
+// Uses the git binary (git am). If a patch does not apply, the whole series is
+// aborted so the repository is left as it was.
+func (c *Client) ApplyPatches(files []string, threeWay bool) error {
+	args := []string{"am"}
+	if threeWay {
+		args = append(args, "--3way")
+	}
+	args = append(args, files...)
+
+	if _, err := c.runGit(args...); err != nil {
+		_, _ = c.runGit("am", "--abort")
+		return fmt.Errorf("patches do not apply: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-git/go-git/v5"
@@ -39,14 +40,26 @@ func (c *Client) Pull(opts *PullOptions) error {
 		}
 	}
 
+	// 인증 정보 설정
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
 	// Pull 옵션 설정
 	pullOpts := &git.PullOptions{
 		RemoteName: remoteName,
 		Force:      opts.Force,
+		Auth:       auth,
+		Progress:   opts.Progress,
 	}
 
 	// Pull 실행
-	err = worktree.Pull(pullOpts)
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	err = worktree.PullContext(ctx, pullOpts)
 	if err != nil {
 		if err == git.NoErrAlreadyUpToDate {
 			// 이미 최신 상태는 에러가 아님
@@ -57,3 +70,25 @@ func (c *Client) Pull(opts *PullOptions) error {
 
 	return nil
 }
+
+// PullWithStats pulls like Pull and returns the number of commits and files it brought in
+// Both are 0 if the branch was already up to date or the git binary is not available
+func (c *Client) PullWithStats(opts *PullOptions) (commits, files int, err error) {
+	before, headErr := c.GetLatestCommit()
+	if err := c.Pull(opts); err != nil {
+		return 0, 0, err
+	}
+	if headErr != nil {
+		return 0, 0, nil
+	}
+
+	after, err := c.GetLatestCommit()
+	if err != nil || after.Hash == before.Hash {
+		return 0, 0, nil
+	}
+	commits, files, err = c.ChangeStats(before.Hash.String(), after.Hash.String())
+	if err != nil {
+		return 0, 0, nil
+	}
+	return commits, files, nil
+}
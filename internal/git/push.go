@@ -1,13 +1,19 @@
 package git
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 )
 
+// ErrPushRejected is returned by a push without force when the remote branch has
+// commits that the local branch does not have
+var ErrPushRejected = errors.New("rejected: the remote has new commits")
+
 // Push pushes the current branch to the remote
 func (c *Client) Push(opts *PushOptions) error {
 	if opts == nil {
@@ -71,11 +77,17 @@ func (c *Client) Push(opts *PushOptions) error {
 		refSpec = config.RefSpec(fmt.Sprintf("%s:%s", localBranchRef, remoteBranchRef))
 	}
 
+	auth, err := c.remoteAuth(repo, opts.Remote)
+	if err != nil {
+		return err
+	}
+
 	// Execute push
 	pushOpts := &git.PushOptions{
 		RemoteName: opts.Remote,
 		RefSpecs:   []config.RefSpec{refSpec},
 		Force:      opts.Force,
+		Auth:       auth,
 	}
 
 	err = repo.Push(pushOpts)
@@ -83,12 +95,25 @@ func (c *Client) Push(opts *PushOptions) error {
 		if err == git.NoErrAlreadyUpToDate {
 			return nil // Not an error
 		}
+		if !opts.Force && isNonFastForward(err) {
+			return fmt.Errorf("failed to push branch '%s': %w (%v)", branchName, ErrPushRejected, err)
+		}
 		return fmt.Errorf("failed to push branch '%s': %w", branchName, err)
 	}
 
 	return nil
 }
 
+// isNonFastForward returns true if a push failed because the remote branch is not
+// an ancestor of the pushed commit (detected locally by go-git or reported by the server)
+func isNonFastForward(err error) bool {
+	if errors.Is(err, git.ErrForceNeeded) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "non-fast-forward") || strings.Contains(msg, "fetch first")
+}
+
 // ForcePush force pushes the specified branch to the remote
 // This is a convenience wrapper around Push with Force=true
 func (c *Client) ForcePush(branch, remote string) error {
@@ -110,9 +135,15 @@ func (c *Client) PushAll(remote string) error {
 		return err
 	}
 
+	auth, err := c.remoteAuth(repo, remote)
+	if err != nil {
+		return err
+	}
+
 	err = repo.Push(&git.PushOptions{
 		RemoteName: remote,
 		RefSpecs:   []config.RefSpec{config.RefSpec("refs/heads/*:refs/heads/*")},
+		Auth:       auth,
 	})
 
 	if err != nil && err != git.NoErrAlreadyUpToDate {
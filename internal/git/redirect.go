@@ -0,0 +1,60 @@
+package git
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// redirectCheckTimeout limits how long DetectRedirect waits for the remote
+const redirectCheckTimeout = 15 * time.Second
+
+// infoRefsPath is the smart HTTP discovery endpoint requested by git clients
+const infoRefsPath = "/info/refs"
+
+// DetectRedirect checks whether the hosting service redirects the repository URL,
+// which happens when a repository was renamed or transferred.
+// Returns the canonical URL, or "" if the URL is not redirected.
+// Only HTTP(S) URLs can be checked; SSH URLs always return "".
+func DetectRedirect(url string, auth *AuthOptions) (string, error) {
+	if !strings.HasPrefix(url, "https://") && !strings.HasPrefix(url, "http://") {
+		return "", nil
+	}
+
+	base := strings.TrimSuffix(url, "/")
+	req, err := http.NewRequest(http.MethodGet, base+infoRefsPath+"?service=git-upload-pack", nil)
+	if err != nil {
+		return "", fmt.Errorf("invalid repository URL: %w", err)
+	}
+	if auth != nil && auth.Password != "" {
+		username := auth.Username
+		if username == "" {
+			username = "git"
+		}
+		req.SetBasicAuth(username, auth.Password)
+	}
+
+	client := &http.Client{Timeout: redirectCheckTimeout}
+	res, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to check repository URL: %w", err)
+	}
+	res.Body.Close()
+
+	// 리다이렉트 후 최종 요청 URL에서 저장소 URL 복원
+	final := res.Request.URL
+	if !strings.HasSuffix(final.Path, infoRefsPath) {
+		return "", nil
+	}
+	canonical := fmt.Sprintf("%s://%s%s", final.Scheme, final.Host, strings.TrimSuffix(final.Path, infoRefsPath))
+
+	// .git 접미사만 다른 경우는 이동으로 보지 않음
+	if strings.TrimSuffix(canonical, ".git") == strings.TrimSuffix(base, ".git") {
+		return "", nil
+	}
+	if strings.HasSuffix(base, ".git") && !strings.HasSuffix(canonical, ".git") {
+		canonical += ".git"
+	}
+	return canonical, nil
+}
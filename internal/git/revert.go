@@ -0,0 +1,46 @@
+package git
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ============================================================================
+// 릴리스 되돌리기 (go-git이 지원하지 않는 revert, describe는 git 바이너리 사용)
+// ============================================================================
+
+// PreviousTag returns the nearest tag reachable from the parent of the given tag
+// Returns an error if the tag has no earlier tag in its history
+func (c *Client) PreviousTag(tag string) (string, error) {
+	output, err := c.runGit("describe", "--tags", "--abbrev=0", tag+"^{commit}^")
+	if err != nil {
+		return "", fmt.Errorf("no tag found before '%s': %w", tag, err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// ReleaseCommits returns the non-merge commits reachable from to but not from
+// from, newest first
+func (c *Client) ReleaseCommits(from, to string) ([]string, error) {
+	output, err := c.runGit("rev-list", "--no-merges", from+".."+to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits between '%s' and '%s': %w", from, to, err)
+	}
+	return strings.Fields(output), nil
+}
+
+// RevertCommits reverts the commits in the given order on the current branch,
+// creating one revert commit each. On conflicts the revert is aborted so the
+// branch is left unchanged.
+func (c *Client) RevertCommits(commits []string) error {
+	if len(commits) == 0 {
+		return nil
+	}
+
+	args := append([]string{"revert", "--no-edit"}, commits...)
+	if _, err := c.runGit(args...); err != nil {
+		_, _ = c.runGit("revert", "--abort")
+		return fmt.Errorf("failed to revert (revert aborted, resolve manually): %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/kevinburke/ssh_config"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// defaultIdentityFiles are the keys ssh tries when ~/.ssh/config sets no IdentityFile
+var defaultIdentityFiles = []string{"~/.ssh/id_rsa", "~/.ssh/id_ecdsa", "~/.ssh/id_ed25519"}
+
+// sshConfigAuth builds the SSH authentication for a URL without a configured key
+// the way the ssh binary does: the User and IdentityFile of the matching Host in
+// ~/.ssh/config (or the default keys), then the keys of ssh-agent. HostName and
+// Port of host aliases are applied by go-git itself. Passphrase-protected key
+// files are left to ssh-agent. Returns nil if there is no key at all.
+func sshConfigAuth(url string) (transport.AuthMethod, error) {
+	host := sshHost(url)
+	user := sshUser(url)
+	if !hasSSHUser(url) {
+		if configUser := ssh_config.Get(host, "User"); configUser != "" {
+			user = configUser
+		}
+	}
+
+	// 1. ~/.ssh/config의 IdentityFile (없으면 기본 키 파일)
+	files := ssh_config.GetAll(host, "IdentityFile")
+	if len(files) == 0 || (len(files) == 1 && files[0] == ssh_config.Default("IdentityFile")) {
+		files = defaultIdentityFiles
+	}
+	var signers []gossh.Signer
+	for _, file := range files {
+		signer, err := loadIdentityFile(expandSSHPath(file, host, user))
+		if err != nil {
+			continue // 없는 파일이나 암호가 걸린 키는 ssh-agent에 맡김
+		}
+		signers = append(signers, signer)
+	}
+
+	// 2. ssh-agent (IdentitiesOnly이고 키 파일이 있으면 사용 안 함)
+	var agentAuth *ssh.PublicKeysCallback
+	if len(signers) == 0 || !strings.EqualFold(ssh_config.Get(host, "IdentitiesOnly"), "yes") {
+		if auth, err := ssh.NewSSHAgentAuth(user); err == nil {
+			agentAuth = auth
+		}
+	}
+
+	if len(signers) == 0 {
+		if agentAuth == nil {
+			return nil, nil
+		}
+		return agentAuth, nil
+	}
+	return &ssh.PublicKeysCallback{
+		User: user,
+		Callback: func() ([]gossh.Signer, error) {
+			all := signers
+			if agentAuth != nil {
+				if agentSigners, err := agentAuth.Callback(); err == nil {
+					all = append(all[:len(all):len(all)], agentSigners...)
+				}
+			}
+			return all, nil
+		},
+	}, nil
+}
+
+// loadIdentityFile reads an unencrypted private key
+func loadIdentityFile(path string) (gossh.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return gossh.ParsePrivateKey(data)
+}
+
+// expandSSHPath expands ~ and the %d (home), %h (host), %r (user) and %% tokens of an ssh_config path
+func expandSSHPath(path, host, user string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") || (runtime.GOOS == "windows" && strings.HasPrefix(path, `~\`)) {
+		path = filepath.Join(home, path[1:])
+	}
+	return strings.NewReplacer("%d", home, "%h", host, "%r", user, "%%", "%").Replace(path)
+}
+
+// sshHost extracts the host (or ~/.ssh/config alias) from an SSH URL
+func sshHost(url string) string {
+	if rest, ok := strings.CutPrefix(url, "ssh://"); ok {
+		if idx := strings.Index(rest, "@"); idx >= 0 {
+			rest = rest[idx+1:]
+		}
+		host, _, _ := strings.Cut(rest, "/")
+		if h, _, ok := strings.Cut(host, ":"); ok {
+			host = h
+		}
+		return host
+	}
+	// scp 형식: user@host:path
+	rest := url
+	if idx := strings.Index(rest, "@"); idx >= 0 {
+		rest = rest[idx+1:]
+	}
+	host, _, _ := strings.Cut(rest, ":")
+	return host
+}
+
+// hasSSHUser returns true if the SSH URL names the user (user@host)
+func hasSSHUser(url string) bool {
+	rest := strings.TrimPrefix(url, "ssh://")
+	host, _, _ := strings.Cut(rest, "/")
+	return strings.Contains(host, "@") || (!strings.HasPrefix(url, "ssh://") && strings.Contains(url, "@"))
+}
@@ -0,0 +1,264 @@
+package git
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/skeema/knownhosts"
+	gossh "golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
+)
+
+// DefaultSSHMaxSessions is how many operations share one SSH connection at most
+// (OpenSSH servers allow 10 sessions per connection by default)
+const DefaultSSHMaxSessions = 8
+
+// SSHPool is a go-git transport for SSH remotes that shares connections: all
+// operations against the same host, user, and key use one SSH connection, each
+// in its own session, so fetching 100 repositories from one host performs one
+// SSH handshake instead of 100. When maxSessions operations are running on a
+// connection, another connection to the host is opened.
+type SSHPool struct {
+	maxSessions int
+
+	mu    sync.Mutex
+	hosts map[string]*sshHostConns // 연결 키 (user@host:port 키) -> 호스트 연결
+}
+
+// sshHostConns is the connections to one host with one user and key
+type sshHostConns struct {
+	mu    sync.Mutex // 연결 생성과 세션 수 보호 (같은 호스트의 첫 연결을 모두 기다림)
+	conns []*sshConn
+}
+
+// sshConn is a shared SSH connection
+type sshConn struct {
+	client *gossh.Client
+	active int // 열린 세션 수
+}
+
+// EnableSSHPool installs a connection-sharing transport for the ssh:// and
+// scp-style (git@host:path) URLs of go-git operations and returns it
+// maxSessions <= 0 uses DefaultSSHMaxSessions. Close the pool when the run is
+// over to close its connections and restore the default transport.
+func EnableSSHPool(maxSessions int) *SSHPool {
+	if maxSessions <= 0 {
+		maxSessions = DefaultSSHMaxSessions
+	}
+	pool := &SSHPool{
+		maxSessions: maxSessions,
+		hosts:       make(map[string]*sshHostConns),
+	}
+	client.InstallProtocol("ssh", pool)
+	return pool
+}
+
+// Close restores the default SSH transport and closes all connections
+func (p *SSHPool) Close() error {
+	client.InstallProtocol("ssh", ssh.DefaultClient)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, host := range p.hosts {
+		host.mu.Lock()
+		for _, conn := range host.conns {
+			_ = conn.client.Close()
+		}
+		host.conns = nil
+		host.mu.Unlock()
+	}
+	return nil
+}
+
+// Connections returns the number of open SSH connections
+func (p *SSHPool) Connections() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	count := 0
+	for _, host := range p.hosts {
+		host.mu.Lock()
+		count += len(host.conns)
+		host.mu.Unlock()
+	}
+	return count
+}
+
+// NewUploadPackSession starts git-upload-pack (fetch, clone, ls-remote) on a shared connection
+func (p *SSHPool) NewUploadPackSession(ep *transport.Endpoint, auth transport.AuthMethod) (transport.UploadPackSession, error) {
+	if ep.Proxy.URL != "" {
+		return ssh.DefaultClient.NewUploadPackSession(ep, auth)
+	}
+	return p.newSession(transport.UploadPackServiceName, ep, auth)
+}
+
+// NewReceivePackSession starts git-receive-pack (push) on a shared connection
+func (p *SSHPool) NewReceivePackSession(ep *transport.Endpoint, auth transport.AuthMethod) (transport.ReceivePackSession, error) {
+	if ep.Proxy.URL != "" {
+		return ssh.DefaultClient.NewReceivePackSession(ep, auth)
+	}
+	return p.newSession(transport.ReceivePackServiceName, ep, auth)
+}
+
+// newSession opens a session on a shared connection and starts the git service in it
+func (p *SSHPool) newSession(service string, ep *transport.Endpoint, auth transport.AuthMethod) (*sshSession, error) {
+	// 1. 인증 방식 (없으면 go-git 기본값: ssh-agent)
+	var sshAuth ssh.AuthMethod
+	if auth != nil {
+		a, ok := auth.(ssh.AuthMethod)
+		if !ok {
+			return nil, transport.ErrInvalidAuthMethod
+		}
+		sshAuth = a
+	} else {
+		a, err := ssh.DefaultAuthBuilder(ep.User)
+		if err != nil {
+			return nil, err
+		}
+		sshAuth = a
+	}
+
+	// 2. 공유 연결에서 세션 열기 (끊긴 연결이면 한 번 다시 연결)
+	addr := sshAddress(ep)
+	host := p.host(sshConnKey(addr, sshAuth))
+	for attempt := 0; ; attempt++ {
+		conn, err := host.acquire(p.maxSessions, func() (*gossh.Client, error) {
+			return dialSSH(addr, sshAuth)
+		})
+		if err != nil {
+			return nil, err
+		}
+		session, err := conn.client.NewSession()
+		if err != nil {
+			host.discard(conn)
+			if attempt == 0 {
+				continue
+			}
+			return nil, err
+		}
+
+		s, err := startSSHSession(session, service, ep, func() { host.release(conn) })
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	}
+}
+
+// host returns the connections for the key, creating the entry if needed
+func (p *SSHPool) host(key string) *sshHostConns {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	host, ok := p.hosts[key]
+	if !ok {
+		host = &sshHostConns{}
+		p.hosts[key] = host
+	}
+	return host
+}
+
+// acquire returns a connection with a free session, dialing a new one if all are busy
+func (h *sshHostConns) acquire(maxSessions int, dial func() (*gossh.Client, error)) (*sshConn, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, conn := range h.conns {
+		if conn.active < maxSessions {
+			conn.active++
+			return conn, nil
+		}
+	}
+
+	sshClient, err := dial()
+	if err != nil {
+		return nil, err
+	}
+	conn := &sshConn{client: sshClient, active: 1}
+	h.conns = append(h.conns, conn)
+	return conn, nil
+}
+
+// release frees the session slot of the connection
+func (h *sshHostConns) release(conn *sshConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conn.active--
+}
+
+// discard closes a connection that cannot open sessions anymore
+func (h *sshHostConns) discard(conn *sshConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for i, c := range h.conns {
+		if c == conn {
+			h.conns = append(h.conns[:i], h.conns[i+1:]...)
+			break
+		}
+	}
+	_ = conn.client.Close()
+}
+
+// sshConnKey identifies the connections that can be shared: same address, user, and key
+func sshConnKey(addr string, auth ssh.AuthMethod) string {
+	key := addr + " " + auth.String() // "user: git, name: ssh-public-keys"
+	if keys, ok := auth.(*ssh.PublicKeys); ok {
+		key += " " + gossh.FingerprintSHA256(keys.Signer.PublicKey())
+	}
+	return key
+}
+
+// sshAddress returns host:port of the endpoint, applying HostName and Port of ~/.ssh/config like go-git
+func sshAddress(ep *transport.Endpoint) string {
+	host, port := ep.Host, ep.Port
+	if ssh.DefaultSSHConfig != nil {
+		if configHost := ssh.DefaultSSHConfig.Get(ep.Host, "Hostname"); configHost != "" {
+			host = configHost
+			if configPort, err := strconv.Atoi(ssh.DefaultSSHConfig.Get(ep.Host, "Port")); err == nil {
+				port = configPort
+			}
+		}
+	}
+	if port <= 0 {
+		port = ssh.DefaultPort
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// dialSSH connects and authenticates to the address, verifying the host key with known_hosts
+func dialSSH(addr string, auth ssh.AuthMethod) (*gossh.Client, error) {
+	config, err := auth.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	if config.HostKeyCallback == nil {
+		callback, err := ssh.NewKnownHostsCallback()
+		if err != nil {
+			return nil, err
+		}
+		config.HostKeyCallback = callback
+	}
+	if len(config.HostKeyAlgorithms) == 0 {
+		// known_hosts에 있는 키 종류로 협상 (없는 종류를 받으면 검증 실패)
+		config.HostKeyAlgorithms = knownhosts.HostKeyAlgorithms(config.HostKeyCallback, addr)
+	}
+
+	ctx := context.Background()
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+	conn, err := proxy.Dial(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	c, chans, reqs, err := gossh.NewClientConn(conn, addr, config)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return gossh.NewClient(c, chans, reqs), nil
+}
@@ -0,0 +1,329 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-git/go-git/v5/plumbing/format/pktline"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/capability"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp/sideband"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/utils/ioutil"
+	gossh "golang.org/x/crypto/ssh"
+)
+
+// sshSession runs git-upload-pack or git-receive-pack in a session of a shared
+// SSH connection. The protocol handling follows go-git's own SSH transport,
+// whose session type is internal to go-git.
+type sshSession struct {
+	session *gossh.Session
+	stdin   io.WriteCloser
+	stdout  io.Reader
+	release func() // 연결의 세션 자리 반환
+
+	isReceivePack bool
+	advRefs       *packp.AdvRefs
+	packRun       bool
+	finished      bool
+	closeOnce     sync.Once
+	firstErrLine  chan string
+}
+
+// stderrSkipPattern matches the empty progress lines of the remote's stderr
+var stderrSkipPattern = regexp.MustCompile("^remote:( =*){0,1}$")
+
+// repoNotFoundMessages are the messages hosting services print for a missing repository
+var repoNotFoundMessages = []string{
+	"Repository not found.",
+	"repository does not exist.",
+	"does not appear to be a git repository",
+	"no such repository",
+	"access denied",
+	"Repository does not exist or you do not have access",
+	"The project you were looking for could not be found",
+}
+
+// startSSHSession starts the git service for the endpoint's path in the session
+func startSSHSession(session *gossh.Session, service string, ep *transport.Endpoint, release func()) (*sshSession, error) {
+	fail := func(err error) (*sshSession, error) {
+		_ = session.Close()
+		release()
+		return nil, err
+	}
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return fail(err)
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return fail(err)
+	}
+	stderr, err := session.StderrPipe()
+	if err != nil {
+		return fail(err)
+	}
+	if err := session.Start(fmt.Sprintf("%s '%s'", service, ep.Path)); err != nil {
+		return fail(err)
+	}
+
+	return &sshSession{
+		session:       session,
+		stdin:         stdin,
+		stdout:        stdout,
+		release:       release,
+		isReceivePack: service == transport.ReceivePackServiceName,
+		firstErrLine:  listenFirstError(stderr),
+	}, nil
+}
+
+// listenFirstError returns the first meaningful line the remote writes to stderr
+func listenFirstError(r io.Reader) chan string {
+	errLine := make(chan string, 1)
+	go func() {
+		scanner := bufio.NewScanner(r)
+		for scanner.Scan() {
+			if line := scanner.Text(); !stderrSkipPattern.MatchString(line) {
+				errLine <- line
+				_, _ = io.Copy(io.Discard, r)
+				return
+			}
+		}
+		close(errLine)
+	}()
+	return errLine
+}
+
+// AdvertisedReferences retrieves the advertised references from the server
+func (s *sshSession) AdvertisedReferences() (*packp.AdvRefs, error) {
+	return s.AdvertisedReferencesContext(context.TODO())
+}
+
+// AdvertisedReferencesContext retrieves the advertised references from the server
+func (s *sshSession) AdvertisedReferencesContext(ctx context.Context) (*packp.AdvRefs, error) {
+	if s.advRefs != nil {
+		return s.advRefs, nil
+	}
+
+	ar := packp.NewAdvRefs()
+	if err := ar.Decode(s.stdoutContext(ctx)); err != nil {
+		if err := s.handleAdvRefDecodeError(err); err != nil {
+			return nil, err
+		}
+	}
+
+	// 일부 서버는 빈 저장소에 flush 대신 capability만 보냄
+	if !s.isReceivePack && ar.IsEmpty() {
+		return nil, transport.ErrEmptyRemoteRepository
+	}
+
+	transport.FilterUnsupportedCapabilities(ar.Capabilities)
+	s.advRefs = ar
+	return ar, nil
+}
+
+// handleAdvRefDecodeError turns a failure to read the advertised references into
+// the go-git error for it (repository not found, empty repository)
+func (s *sshSession) handleAdvRefDecodeError(err error) error {
+	var errLine *pktline.ErrorLine
+	if errors.As(err, &errLine) {
+		if isRepoNotFoundMessage(errLine.Text) {
+			return transport.ErrRepositoryNotFound
+		}
+		return errLine
+	}
+
+	// 저장소가 없으면 stdout은 비어있고 에러는 stderr로 옴
+	if errors.Is(err, packp.ErrEmptyInput) {
+		s.finished = true
+		if err := s.checkNotFoundError(); err != nil {
+			return err
+		}
+		return io.ErrUnexpectedEOF
+	}
+
+	// 빈 저장소 (push는 가능)
+	if err == packp.ErrEmptyAdvRefs {
+		if s.isReceivePack {
+			return nil
+		}
+		if err := s.finish(); err != nil {
+			return err
+		}
+		return transport.ErrEmptyRemoteRepository
+	}
+
+	var unexpected *packp.ErrUnexpectedData
+	if errors.As(err, &unexpected) && isRepoNotFoundMessage(string(unexpected.Data)) {
+		return transport.ErrRepositoryNotFound
+	}
+	return err
+}
+
+// UploadPack requests a packfile from the server; the returned reader must be closed
+func (s *sshSession) UploadPack(ctx context.Context, req *packp.UploadPackRequest) (*packp.UploadPackResponse, error) {
+	if req.IsEmpty() {
+		// 원하는 것을 이미 모두 가지고 있음
+		if err := s.finish(); err != nil {
+			return nil, err
+		}
+		return nil, transport.ErrEmptyUploadPackRequest
+	}
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := s.AdvertisedReferencesContext(ctx); err != nil {
+		return nil, err
+	}
+
+	s.packRun = true
+	in := s.stdinContext(ctx)
+	out := s.stdoutContext(ctx)
+
+	// upload-request, haves, done 전송
+	if err := req.UploadRequest.Encode(in); err != nil {
+		return nil, fmt.Errorf("sending upload-req message: %s", err)
+	}
+	if err := req.UploadHaves.Encode(in, true); err != nil {
+		return nil, fmt.Errorf("sending haves message: %s", err)
+	}
+	if err := pktline.NewEncoder(in).Encodef("done\n"); err != nil {
+		return nil, fmt.Errorf("sending done message: %s", err)
+	}
+	if err := in.Close(); err != nil {
+		return nil, fmt.Errorf("closing input: %s", err)
+	}
+
+	r, err := ioutil.NonEmptyReader(out)
+	if err == ioutil.ErrEmptyReader {
+		return nil, transport.ErrEmptyUploadPackRequest
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res := packp.NewUploadPackResponse(req)
+	if err := res.Decode(ioutil.NewReadCloser(r, s)); err != nil {
+		return nil, fmt.Errorf("error decoding upload-pack response: %s", err)
+	}
+	return res, nil
+}
+
+// ReceivePack sends the reference updates and packfile to the server
+func (s *sshSession) ReceivePack(ctx context.Context, req *packp.ReferenceUpdateRequest) (*packp.ReportStatus, error) {
+	if _, err := s.AdvertisedReferences(); err != nil {
+		return nil, err
+	}
+
+	s.packRun = true
+	w := s.stdinContext(ctx)
+	if err := req.Encode(w); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	if !req.Capabilities.Supports(capability.ReportStatus) {
+		// report-status가 없으면 결과를 알 수 없음
+		return nil, s.Close()
+	}
+
+	r := s.stdoutContext(ctx)
+	var d *sideband.Demuxer
+	if req.Capabilities.Supports(capability.Sideband64k) {
+		d = sideband.NewDemuxer(sideband.Sideband64k, r)
+	} else if req.Capabilities.Supports(capability.Sideband) {
+		d = sideband.NewDemuxer(sideband.Sideband, r)
+	}
+	if d != nil {
+		d.Progress = req.Progress
+		r = d
+	}
+
+	report := packp.NewReportStatus()
+	if err := report.Decode(r); err != nil {
+		return nil, err
+	}
+	if err := report.Error(); err != nil {
+		defer s.Close()
+		return report, err
+	}
+	return report, s.Close()
+}
+
+// stdinContext returns stdin cancelled with ctx; errors close the session
+func (s *sshSession) stdinContext(ctx context.Context) io.WriteCloser {
+	return ioutil.NewWriteCloserOnError(ioutil.NewContextWriteCloser(ctx, s.stdin), s.onError)
+}
+
+// stdoutContext returns stdout cancelled with ctx; errors close the session
+func (s *sshSession) stdoutContext(ctx context.Context) io.Reader {
+	return ioutil.NewReaderOnError(ioutil.NewContextReader(ctx, s.stdout), s.onError)
+}
+
+// onError closes the session after a read or write failed
+func (s *sshSession) onError(error) {
+	_ = s.Close()
+}
+
+// finish ends the protocol: without a pack run, a flush tells the server to exit
+func (s *sshSession) finish() error {
+	if s.finished {
+		return nil
+	}
+	s.finished = true
+	if !s.packRun {
+		_, err := s.stdin.Write(pktline.FlushPkt)
+		return err
+	}
+	return nil
+}
+
+// Close ends the session and returns its slot to the shared connection, which stays open
+func (s *sshSession) Close() error {
+	var err error
+	s.closeOnce.Do(func() {
+		err = s.finish()
+		_ = s.session.Close()
+		s.release()
+	})
+	return err
+}
+
+// checkNotFoundError waits for the remote's error message when it sent no references
+func (s *sshSession) checkNotFoundError() error {
+	timer := time.NewTimer(10 * time.Second)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return errors.New("timeout exceeded")
+	case line, ok := <-s.firstErrLine:
+		if !ok || line == "" {
+			return nil
+		}
+		if isRepoNotFoundMessage(line) {
+			return transport.ErrRepositoryNotFound
+		}
+		return fmt.Errorf("unknown error: %s", line)
+	}
+}
+
+// isRepoNotFoundMessage returns true if the remote's message says the repository does not exist
+func isRepoNotFoundMessage(message string) bool {
+	for _, notFound := range repoNotFoundMessages {
+		if strings.Contains(message, notFound) {
+			return true
+		}
+	}
+	return false
+}
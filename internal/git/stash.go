@@ -0,0 +1,103 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNoStash is returned when no stash entry matches
+var ErrNoStash = errors.New("no matching stash")
+
+// StashEntry represents an entry of the stash list
+type StashEntry struct {
+	Ref     string // stash 참조 (예: stash@{0})
+	Branch  string // stash를 만든 브랜치 (detached HEAD면 "(no branch)")
+	Message string // stash 메시지
+}
+
+// Stash stashes local changes including untracked files using the git binary
+// Returns false if there was nothing to stash
+func (c *Client) Stash(message string) (bool, error) {
+	hasChanges, err := c.HasLocalChanges()
+	if err != nil {
+		return false, fmt.Errorf("failed to check local changes: %w", err)
+	}
+	if !hasChanges {
+		return false, nil
+	}
+
+	if _, err := c.runGit("stash", "push", "--include-untracked", "-m", message); err != nil {
+		return false, fmt.Errorf("failed to stash local changes: %w", err)
+	}
+	return true, nil
+}
+
+// StashPop restores the most recently stashed changes using the git binary
+func (c *Client) StashPop() error {
+	return c.StashPopRef("")
+}
+
+// StashPopRef restores the given stash entry (e.g. stash@{2}) using the git binary
+// An empty ref restores the most recent entry. On conflicts the entry is kept.
+func (c *Client) StashPopRef(ref string) error {
+	args := []string{"stash", "pop"}
+	if ref != "" {
+		args = append(args, ref)
+	}
+	if _, err := c.runGit(args...); err != nil {
+		return fmt.Errorf("failed to restore stashed changes: %w", err)
+	}
+	return nil
+}
+
+// ListStashes returns the stash entries, most recent first, using the git binary
+func (c *Client) ListStashes() ([]StashEntry, error) {
+	output, err := c.runGit("stash", "list", "--format=%gd%x00%gs")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stashes: %w", err)
+	}
+
+	var entries []StashEntry
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		ref, subject, ok := strings.Cut(line, "\x00")
+		if !ok {
+			continue
+		}
+		entries = append(entries, parseStashSubject(ref, subject))
+	}
+	return entries, nil
+}
+
+// FindStash returns the most recent stash entry whose message contains substr
+// Returns ErrNoStash if no entry matches
+func (c *Client) FindStash(substr string) (StashEntry, error) {
+	entries, err := c.ListStashes()
+	if err != nil {
+		return StashEntry{}, err
+	}
+	for _, entry := range entries {
+		if strings.Contains(entry.Message, substr) {
+			return entry, nil
+		}
+	}
+	return StashEntry{}, ErrNoStash
+}
+
+// parseStashSubject splits a stash reflog subject ("On main: message" or
+// "WIP on main: abc1234 subject") into branch and message
+func parseStashSubject(ref, subject string) StashEntry {
+	entry := StashEntry{Ref: ref, Message: subject}
+	prefix, message, ok := strings.Cut(subject, ": ")
+	if !ok {
+		return entry
+	}
+	for _, p := range []string{"On ", "WIP on "} {
+		if branch, found := strings.CutPrefix(prefix, p); found {
+			entry.Branch = branch
+			entry.Message = message
+			break
+		}
+	}
+	return entry
+}
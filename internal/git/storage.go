@@ -0,0 +1,45 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// CloneInMemory clones a repository into memory without a working tree
+// Nothing is written to disk; the clone is gone once the client is released.
+// Read-only operations (commits, branches, tags, graph) work on the returned client.
+func CloneInMemory(url string, opts *CloneOptions) (*Client, error) {
+	return CloneToStorage(url, memory.NewStorage(), nil, opts)
+}
+
+// CloneToStorage clones a repository into a go-git storage backend
+// worktree is the filesystem files are checked out to; nil creates a bare clone.
+// Filter and SparsePaths need the git binary and are not supported.
+func CloneToStorage(url string, storer storage.Storer, worktree billy.Filesystem, opts *CloneOptions) (*Client, error) {
+	// 옵션이 nil이면 기본값 사용
+	if opts == nil {
+		opts = &CloneOptions{}
+	}
+	if opts.Filter != "" || len(opts.SparsePaths) > 0 {
+		return nil, fmt.Errorf("partial clone and sparse checkout are not supported for storage clones: %w", ErrNotOnDisk)
+	}
+
+	cloneOpts, err := goGitCloneOptions(url, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 클론 실행
+	repo, err := git.Clone(storer, worktree, cloneOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone repository: %w", err)
+	}
+
+	client := NewStorageClient(repo)
+	client.SetAuth(opts.Auth)
+	return client, nil
+}
@@ -0,0 +1,154 @@
+package git
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// FastForward moves the current branch to its remote-tracking branch
+// without contacting the remote (fetch first). Returns true if the branch moved.
+// Fails if the local branch has diverged from the remote branch.
+func (c *Client) FastForward(remoteName, branch string) (bool, error) {
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return false, err
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return false, fmt.Errorf("failed to get HEAD: %w", err)
+	}
+
+	remoteRef, err := repo.Reference(plumbing.NewRemoteReferenceName(remoteName, branch), true)
+	if err != nil {
+		return false, fmt.Errorf("remote branch '%s/%s' not found: %w", remoteName, branch, err)
+	}
+
+	if head.Hash() == remoteRef.Hash() {
+		return false, nil
+	}
+
+	local, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return false, fmt.Errorf("failed to get commit: %w", err)
+	}
+	remote, err := repo.CommitObject(remoteRef.Hash())
+	if err != nil {
+		return false, fmt.Errorf("failed to get commit: %w", err)
+	}
+
+	// 로컬이 원격보다 앞서 있으면 변경 없음
+	if ahead, err := remote.IsAncestor(local); err == nil && ahead {
+		return false, nil
+	}
+
+	isFF, err := local.IsAncestor(remote)
+	if err != nil {
+		return false, fmt.Errorf("failed to compare commits: %w", err)
+	}
+	if !isFF {
+		return false, fmt.Errorf("branch '%s' has diverged from '%s/%s', cannot fast-forward", branch, remoteName, branch)
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return false, fmt.Errorf("failed to get worktree: %w", err)
+	}
+	if err := worktree.Reset(&git.ResetOptions{Commit: remote.Hash, Mode: git.HardReset}); err != nil {
+		return false, fmt.Errorf("failed to fast-forward: %w", err)
+	}
+
+	return true, nil
+}
+
+// HasRemoteTrackingBranch checks if refs/remotes/<remote>/<branch> exists locally
+// Unlike RemoteBranchExists, this does not contact the remote
+func (c *Client) HasRemoteTrackingBranch(remoteName, branch string) bool {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return false
+	}
+	_, err = repo.Reference(plumbing.NewRemoteReferenceName(remoteName, branch), true)
+	return err == nil
+}
+
+// ============================================================================
+// git CLI 기반 작업 (go-git이 지원하지 않는 rebase, stash는 stash.go)
+// ============================================================================
+
+// Rebase rebases the current branch onto its remote-tracking branch using the git binary
+// The rebase is aborted on conflicts so the repository is left unchanged
+func (c *Client) Rebase(remoteName, branch string) error {
+	if remoteName == "" {
+		remoteName = "origin"
+	}
+
+	upstream := fmt.Sprintf("%s/%s", remoteName, branch)
+	if _, err := c.runGit("rebase", upstream); err != nil {
+		_, _ = c.runGit("rebase", "--abort")
+		return fmt.Errorf("failed to rebase onto '%s' (rebase aborted): %w", upstream, err)
+	}
+	return nil
+}
+
+// runGit runs the git binary in the repository directory
+// The repository opened by the client is reopened on next use, since git may
+// have repacked objects (e.g. gc --auto) behind the cached pack index.
+func (c *Client) runGit(args ...string) (string, error) {
+	if c.repo != nil {
+		return "", ErrNotOnDisk
+	}
+	defer c.release()
+	return runGitCommand(c.path, nil, args...)
+}
+
+// runGitCommand runs the git binary in dir with extra environment variables
+// Returns stdout, or stderr as the error message if git fails. On Windows, long
+// paths are enabled (core.longpaths) since repositories nested under a deep
+// base_dir easily exceed MAX_PATH; go-git gets prefixed paths instead (see longPath).
+func runGitCommand(dir string, env []string, args ...string) (string, error) {
+	return runGitCommandContext(context.Background(), dir, env, args...)
+}
+
+// runGitCommandContext runs the git binary like runGitCommand and kills it when ctx is cancelled
+func runGitCommandContext(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	gitPath, err := exec.LookPath("git")
+	if err != nil {
+		return "", fmt.Errorf("git binary not found in PATH")
+	}
+
+	// Windows: MAX_PATH(260자)보다 긴 경로의 파일도 다룰 수 있도록
+	if runtime.GOOS == "windows" {
+		args = append([]string{"-c", "core.longpaths=true"}, args...)
+	}
+
+	cmd := exec.CommandContext(ctx, gitPath, args...)
+	cmd.Dir = dir
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s", msg)
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
@@ -1,7 +1,9 @@
 package git
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/go-git/go-git/v5"
@@ -21,6 +23,22 @@ func (c *Client) CreateTag(opts *TagOptions) error {
 		return err
 	}
 
+	// Resolve the target commit before an existing tag is deleted (--ref may name it)
+	var target plumbing.Hash
+	if opts.Ref != "" {
+		commit, err := c.GetCommitAtRevision(opts.Ref)
+		if err != nil {
+			return err
+		}
+		target = commit.Hash
+	} else {
+		head, err := repo.Head()
+		if err != nil {
+			return fmt.Errorf("failed to get HEAD: %w", err)
+		}
+		target = head.Hash()
+	}
+
 	// Check if tag already exists
 	exists, err := c.TagExists(opts.Name)
 	if err != nil {
@@ -37,26 +55,25 @@ func (c *Client) CreateTag(opts *TagOptions) error {
 		}
 	}
 
-	// Get HEAD commit
-	head, err := repo.Head()
-	if err != nil {
-		return fmt.Errorf("failed to get HEAD: %w", err)
-	}
-
 	// Create tag
 	tagRef := plumbing.NewTagReferenceName(opts.Name)
 
 	if opts.Annotated || opts.Message != "" {
 		// Create annotated tag
-		commit, err := repo.CommitObject(head.Hash())
+		commit, err := repo.CommitObject(target)
 		if err != nil {
 			return fmt.Errorf("failed to get commit: %w", err)
 		}
 
+		tagger := defaultSignature()
+		if opts.Tagger != nil {
+			tagger = *withTime(opts.Tagger)
+		}
+
 		tag := &object.Tag{
 			Name:       opts.Name,
 			Message:    opts.Message,
-			Tagger:     defaultSignature(),
+			Tagger:     tagger,
 			Target:     commit.Hash,
 			TargetType: plumbing.CommitObject,
 		}
@@ -77,7 +94,7 @@ func (c *Client) CreateTag(opts *TagOptions) error {
 		}
 	} else {
 		// Create lightweight tag
-		ref := plumbing.NewHashReference(tagRef, head.Hash())
+		ref := plumbing.NewHashReference(tagRef, target)
 		if err := repo.Storer.SetReference(ref); err != nil {
 			return fmt.Errorf("failed to create tag: %w", err)
 		}
@@ -146,6 +163,82 @@ func (c *Client) ListTags() ([]string, error) {
 	return tagNames, err
 }
 
+// ErrRevisionNotFound is returned when a revision (--contains, --ref) does not exist
+var ErrRevisionNotFound = errors.New("revision not found")
+
+// TagInfo describes a tag and the commit it points to
+type TagInfo struct {
+	Name      string // 태그 이름
+	Commit    string // 태그가 가리키는 커밋 해시 (annotated tag는 peel된 커밋)
+	Annotated bool   // annotated tag 여부
+}
+
+// ListTagInfo returns all tags sorted by name with the commit they point to
+// If contains is set, only tags whose commit contains that revision
+// (a branch, tag, or commit) in its history are returned
+func (c *Client) ListTagInfo(contains string) ([]TagInfo, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	var target *object.Commit
+	if contains != "" {
+		hash, err := repo.ResolveRevision(plumbing.Revision(contains))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrRevisionNotFound, contains)
+		}
+		target, err = repo.CommitObject(*hash)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a commit: %w", contains, err)
+		}
+	}
+
+	tags, err := repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+
+	var infos []TagInfo
+	err = tags.ForEach(func(ref *plumbing.Reference) error {
+		info := TagInfo{Name: ref.Name().Short()}
+
+		// annotated tag는 가리키는 커밋까지 따라감
+		commit, err := repo.CommitObject(ref.Hash())
+		if err != nil {
+			tagObj, tagErr := repo.TagObject(ref.Hash())
+			if tagErr != nil {
+				return nil // 커밋이 아닌 객체를 가리키는 태그는 무시
+			}
+			info.Annotated = true
+			commit, err = tagObj.Commit()
+			if err != nil {
+				return nil
+			}
+		}
+		info.Commit = commit.Hash.String()
+
+		if target != nil && commit.Hash != target.Hash {
+			isAncestor, err := target.IsAncestor(commit)
+			if err != nil {
+				return fmt.Errorf("failed to check history of tag '%s': %w", info.Name, err)
+			}
+			if !isAncestor {
+				return nil
+			}
+		}
+
+		infos = append(infos, info)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Name < infos[j].Name })
+	return infos, nil
+}
+
 // PushTag pushes a tag to the remote
 func (c *Client) PushTag(tagName, remoteName string) error {
 	repo, err := c.OpenRepository()
@@ -161,9 +254,15 @@ func (c *Client) PushTag(tagName, remoteName string) error {
 	tagRef := plumbing.NewTagReferenceName(tagName)
 	refSpec := config.RefSpec(fmt.Sprintf("%s:%s", tagRef, tagRef))
 
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
 	err = repo.Push(&git.PushOptions{
 		RemoteName: remoteName,
 		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       auth,
 	})
 
 	if err != nil && err != git.NoErrAlreadyUpToDate {
@@ -188,9 +287,15 @@ func (c *Client) DeleteRemoteTag(tagName, remoteName string) error {
 	tagRef := plumbing.NewTagReferenceName(tagName)
 	refSpec := config.RefSpec(fmt.Sprintf(":%s", tagRef))
 
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
 	err = repo.Push(&git.PushOptions{
 		RemoteName: remoteName,
 		RefSpecs:   []config.RefSpec{refSpec},
+		Auth:       auth,
 	})
 
 	if err != nil && err != git.NoErrAlreadyUpToDate {
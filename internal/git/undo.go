@@ -0,0 +1,144 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// KeepRefPrefix is where references to overwritten objects are kept for undo, so
+// that they are not garbage collected before the operation is reverted
+const KeepRefPrefix = "refs/multi-git/snapshots/"
+
+// RefHash returns the hash a local reference points to without peeling annotated
+// tags, or "" if the reference does not exist
+func (c *Client) RefHash(ref string) (string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return "", err
+	}
+	resolved, err := repo.Reference(plumbing.ReferenceName(ref), true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read '%s': %w", ref, err)
+	}
+	return resolved.Hash().String(), nil
+}
+
+// RemoteRefHash asks the remote where a reference points (like git ls-remote),
+// or "" if the remote does not have it
+func (c *Client) RemoteRefHash(remoteName, ref string) (string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return "", err
+	}
+	remote, err := repo.Remote(remoteName)
+	if err != nil {
+		return "", fmt.Errorf("remote '%s' not found: %w", remoteName, err)
+	}
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return "", err
+	}
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
+	if err != nil {
+		return "", fmt.Errorf("failed to list remote references: %w", err)
+	}
+	for _, r := range refs {
+		if r.Name().String() == ref {
+			return r.Hash().String(), nil
+		}
+	}
+	return "", nil
+}
+
+// KeepRef points keepRef at hash so the object survives until the operation is undone
+// If the object is not in the clone (a remote commit never fetched), remoteRef is
+// fetched from the remote into keepRef first.
+func (c *Client) KeepRef(keepRef, hash, remoteName, remoteRef string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+
+	if err := repo.Storer.HasEncodedObject(plumbing.NewHash(hash)); err != nil {
+		if remoteName == "" {
+			return fmt.Errorf("object %s of '%s' not found: %w", hash, remoteRef, err)
+		}
+		auth, err := c.remoteAuth(repo, remoteName)
+		if err != nil {
+			return err
+		}
+		err = repo.Fetch(&git.FetchOptions{
+			RemoteName: remoteName,
+			RefSpecs:   []config.RefSpec{config.RefSpec(fmt.Sprintf("+%s:%s", remoteRef, keepRef))},
+			Auth:       auth,
+			Tags:       git.NoTags,
+		})
+		if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return fmt.Errorf("failed to fetch '%s' from '%s': %w", remoteRef, remoteName, err)
+		}
+		// fetch 사이에 원격이 바뀌었으면 기록한 값과 다름
+		if got, err := c.RefHash(keepRef); err != nil || got != hash {
+			return fmt.Errorf("'%s' on '%s' changed while it was being recorded", remoteRef, remoteName)
+		}
+		return nil
+	}
+
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(keepRef), plumbing.NewHash(hash))); err != nil {
+		return fmt.Errorf("failed to keep %s: %w", hash, err)
+	}
+	return nil
+}
+
+// SetRef points a local reference at hash, or deletes it if hash is ""
+func (c *Client) SetRef(ref, hash string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+	name := plumbing.ReferenceName(ref)
+	if hash == "" {
+		if err := repo.Storer.RemoveReference(name); err != nil {
+			return fmt.Errorf("failed to delete '%s': %w", ref, err)
+		}
+		return nil
+	}
+	if err := repo.Storer.SetReference(plumbing.NewHashReference(name, plumbing.NewHash(hash))); err != nil {
+		return fmt.Errorf("failed to set '%s': %w", ref, err)
+	}
+	return nil
+}
+
+// ForcePushRef overwrites a reference on the remote with a local reference, or
+// deletes it on the remote if localRef is ""
+func (c *Client) ForcePushRef(remoteName, localRef, remoteRef string) error {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return err
+	}
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return err
+	}
+
+	refSpec := config.RefSpec(fmt.Sprintf("+%s:%s", localRef, remoteRef))
+	if localRef == "" {
+		refSpec = config.RefSpec(":" + remoteRef)
+	}
+	err = repo.Push(&git.PushOptions{
+		RemoteName: remoteName,
+		RefSpecs:   []config.RefSpec{refSpec},
+		Force:      true,
+		Auth:       auth,
+	})
+	if err != nil && !errors.Is(err, git.NoErrAlreadyUpToDate) {
+		return fmt.Errorf("failed to push '%s' to '%s': %w", remoteRef, remoteName, err)
+	}
+	return nil
+}
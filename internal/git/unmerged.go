@@ -0,0 +1,67 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// UnmergedBranch is a branch with commits that are not merged into a target branch
+type UnmergedBranch struct {
+	Name       string    `json:"branch"`      // 브랜치 이름 (원격 이름 제외, 예: release/v1.3)
+	Commits    int       `json:"commits"`     // 대상 브랜치에 없는 커밋 수
+	LastCommit time.Time `json:"last_commit"` // 브랜치 끝 커밋 시각 (committer date)
+	Author     string    `json:"author"`      // 브랜치 끝 커밋 작성자
+}
+
+// UnmergedBranches returns the branches under prefix (refs/heads/ or
+// refs/remotes/<remote>/) whose tips are not reachable from target, oldest last
+// commit first, with the number of commits target does not have
+// Uses the git binary (git for-each-ref --no-merged, git rev-list)
+func (c *Client) UnmergedBranches(prefix, target string) ([]UnmergedBranch, error) {
+	// 필드는 0x1f로 구분: 참조, 커밋 시각 (unix), 작성자
+	output, err := c.runGit("for-each-ref", "--sort=committerdate", "--no-merged="+target,
+		"--format=%(refname)%1f%(committerdate:unix)%1f%(authorname)", prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches not merged into '%s': %w", target, err)
+	}
+
+	var branches []UnmergedBranch
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected for-each-ref output: %q", line)
+		}
+		ref := fields[0]
+		name := strings.TrimPrefix(ref, prefix)
+		if name == "HEAD" {
+			// refs/remotes/<remote>/HEAD는 원격의 기본 브랜치를 가리키는 심볼릭 참조
+			continue
+		}
+		unix, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected for-each-ref output: %q", line)
+		}
+
+		count, err := c.runGit("rev-list", "--count", target+".."+ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count commits of '%s' not in '%s': %w", name, target, err)
+		}
+		commits, err := strconv.Atoi(strings.TrimSpace(count))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected rev-list output: %q", count)
+		}
+
+		branches = append(branches, UnmergedBranch{
+			Name:       name,
+			Commits:    commits,
+			LastCommit: time.Unix(unix, 0),
+			Author:     fields[2],
+		})
+	}
+	return branches, nil
+}
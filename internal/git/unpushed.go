@@ -0,0 +1,92 @@
+package git
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// UnpushedBranch is a local branch with commits that are not on its upstream
+type UnpushedBranch struct {
+	Branch   string // 로컬 브랜치 이름
+	Upstream string // 업스트림 (예: origin/main, 설정되지 않았으면 빈 문자열)
+	Gone     bool   // 업스트림이 설정되어 있지만 원격에서 삭제됨
+	Commits  int    // 업스트림에 없는 커밋 수 (업스트림이 없으면 어느 원격 브랜치에도 없는 커밋 수)
+}
+
+// UnpushedBranches returns the local branches with commits that are not on their
+// upstream, in branch name order. Branches without an upstream, or whose upstream
+// was deleted, count the commits that are on no remote-tracking branch at all.
+// Remote-tracking branches are compared as of the last fetch.
+// Uses the git binary (git for-each-ref, git rev-list)
+func (c *Client) UnpushedBranches() ([]UnpushedBranch, error) {
+	// 필드는 0x1f로 구분: 브랜치, 업스트림, 추적 상태 (예: "[ahead 2, behind 1]", "[gone]")
+	output, err := c.runGit("for-each-ref", "--format=%(refname:short)%1f%(upstream:short)%1f%(upstream:track)", "refs/heads")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list branches: %w", err)
+	}
+
+	var branches []UnpushedBranch
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("unexpected for-each-ref output: %q", line)
+		}
+		branch := UnpushedBranch{Branch: fields[0], Upstream: fields[1]}
+
+		ahead, gone, err := parseTrack(fields[2])
+		if err != nil {
+			return nil, err
+		}
+		branch.Gone = gone
+		if branch.Upstream != "" && !gone {
+			branch.Commits = ahead
+		} else if branch.Commits, err = c.commitsOnNoRemote(branch.Branch); err != nil {
+			return nil, err
+		}
+
+		if branch.Commits > 0 {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+// commitsOnNoRemote counts the commits of a local branch that no remote-tracking branch contains
+func (c *Client) commitsOnNoRemote(branch string) (int, error) {
+	output, err := c.runGit("rev-list", "--count", "refs/heads/"+branch, "--not", "--remotes")
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unpushed commits on '%s': %w", branch, err)
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+	return count, nil
+}
+
+// parseTrack parses %(upstream:track), e.g. "[ahead 2, behind 1]" or "[gone]"
+func parseTrack(track string) (ahead int, gone bool, err error) {
+	track = strings.TrimSuffix(strings.TrimPrefix(track, "["), "]")
+	if track == "" {
+		return 0, false, nil
+	}
+	for _, part := range strings.Split(track, ", ") {
+		switch {
+		case part == "gone":
+			gone = true
+		case strings.HasPrefix(part, "ahead "):
+			if ahead, err = strconv.Atoi(strings.TrimPrefix(part, "ahead ")); err != nil {
+				return 0, false, fmt.Errorf("unexpected upstream track: %q", track)
+			}
+		case strings.HasPrefix(part, "behind "):
+			// 업스트림에만 있는 커밋은 pull 대상이므로 무시
+		default:
+			return 0, false, fmt.Errorf("unexpected upstream track: %q", track)
+		}
+	}
+	return ahead, gone, nil
+}
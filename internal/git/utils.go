@@ -2,6 +2,8 @@ package git
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -59,6 +61,27 @@ func (c *Client) GetCommitOnBranch(branchName string) (*object.Commit, error) {
 	return commit, nil
 }
 
+// GetCommitAtRevision returns the commit a revision (hash, short hash, tag, or branch) points to
+// Annotated tags are peeled to their commit. Returns ErrRevisionNotFound if it does not exist.
+func (c *Client) GetCommitAtRevision(rev string) (*object.Commit, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := repo.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrRevisionNotFound, rev)
+	}
+
+	commit, err := repo.CommitObject(*hash)
+	if err != nil {
+		return nil, fmt.Errorf("'%s' is not a commit: %w", rev, err)
+	}
+
+	return commit, nil
+}
+
 // ============================================================================
 // 저장소 정보
 // ============================================================================
@@ -125,7 +148,12 @@ func (c *Client) ListRemoteBranches(remoteName string) ([]string, error) {
 		return nil, fmt.Errorf("remote '%s' not found: %w", remoteName, err)
 	}
 
-	refs, err := remote.List(&git.ListOptions{})
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list remote references: %w", err)
 	}
@@ -186,3 +214,25 @@ func (c *Client) StatusString() (string, error) {
 
 	return status, nil
 }
+
+// ChangeStats returns the number of commits reachable from to but not from from,
+// and the number of files that differ between them, using the git binary
+func (c *Client) ChangeStats(from, to string) (commits, files int, err error) {
+	output, err := c.runGit("rev-list", "--count", from+".."+to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count commits between '%s' and '%s': %w", from, to, err)
+	}
+	commits, err = strconv.Atoi(strings.TrimSpace(output))
+	if err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", output)
+	}
+
+	output, err = c.runGit("diff", "--name-only", from, to)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to diff '%s' and '%s': %w", from, to, err)
+	}
+	if output = strings.TrimSpace(output); output != "" {
+		files = len(strings.Split(output, "\n"))
+	}
+	return commits, files, nil
+}
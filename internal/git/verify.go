@@ -0,0 +1,78 @@
+package git
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// ReleaseRefs are the commits a release tag and its branch point to, in the
+// repository and on a remote. Annotated tags are peeled to their commit; an
+// empty hash means the reference does not exist.
+type ReleaseRefs struct {
+	LocalTag     string // 로컬 태그가 가리키는 커밋
+	RemoteTag    string // 원격 태그가 가리키는 커밋
+	LocalBranch  string // 로컬 브랜치 끝 커밋
+	RemoteBranch string // 원격 브랜치 끝 커밋 (원격 추적 참조가 아닌 원격의 현재 값)
+}
+
+// ReleaseRefs reads where the tag and the branch point locally and on the remote
+// The remote is asked directly (like git ls-remote), so nothing needs to be fetched first.
+func (c *Client) ReleaseRefs(tag, branch, remoteName string) (*ReleaseRefs, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := &ReleaseRefs{}
+	if refs.LocalTag, err = c.localCommit(plumbing.NewTagReferenceName(tag)); err != nil {
+		return nil, err
+	}
+	if refs.LocalBranch, err = c.localCommit(plumbing.NewBranchReferenceName(branch)); err != nil {
+		return nil, err
+	}
+
+	remote, err := repo.Remote(remoteName)
+	if err != nil {
+		return nil, fmt.Errorf("remote '%s' not found: %w", remoteName, err)
+	}
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return nil, err
+	}
+	remoteRefs, err := remote.List(&git.ListOptions{Auth: auth, PeelingOption: git.AppendPeeled})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote references: %w", err)
+	}
+
+	// annotated tag는 peel된 참조(<tag>^{})의 커밋 사용
+	tagRef := plumbing.NewTagReferenceName(tag).String()
+	branchRef := plumbing.NewBranchReferenceName(branch).String()
+	for _, ref := range remoteRefs {
+		switch ref.Name().String() {
+		case tagRef:
+			if refs.RemoteTag == "" {
+				refs.RemoteTag = ref.Hash().String()
+			}
+		case tagRef + "^{}":
+			refs.RemoteTag = ref.Hash().String()
+		case branchRef:
+			refs.RemoteBranch = ref.Hash().String()
+		}
+	}
+	return refs, nil
+}
+
+// localCommit returns the commit a local reference points to, or "" if it does not exist
+func (c *Client) localCommit(name plumbing.ReferenceName) (string, error) {
+	commit, err := c.GetCommitAtRevision(name.String())
+	if errors.Is(err, ErrRevisionNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return commit.Hash.String(), nil
+}
@@ -0,0 +1,50 @@
+package git
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// RemoteChanges lists the branches whose tip on the remote differs from the
+// local remote-tracking reference (new or updated branches), without fetching
+// Branches deleted on the remote are not reported. Returns nil if the local
+// references are up to date.
+func (c *Client) RemoteChanges(remoteName string) ([]string, error) {
+	repo, err := c.OpenRepository()
+	if err != nil {
+		return nil, err
+	}
+
+	remote, err := repo.Remote(remoteName)
+	if err != nil {
+		return nil, fmt.Errorf("remote '%s' not found: %w", remoteName, err)
+	}
+
+	auth, err := c.remoteAuth(repo, remoteName)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := remote.List(&git.ListOptions{Auth: auth})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remote references: %w", err)
+	}
+
+	var changed []string
+	for _, ref := range refs {
+		if !ref.Name().IsBranch() {
+			continue
+		}
+		branch := ref.Name().Short()
+		local, err := repo.Reference(plumbing.NewRemoteReferenceName(remoteName, branch), true)
+		if err != nil || local.Hash() != ref.Hash() {
+			changed = append(changed, branch)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed, nil
+}
@@ -0,0 +1,211 @@
+package guard
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Snapshot maps repository-relative file paths to their content hash
+type Snapshot map[string]string
+
+// Match reports whether a slash-separated relative path matches the pattern
+// Patterns use path.Match syntax per segment, plus '**' for any number of segments
+// A pattern matching a directory also matches everything below it
+// e.g. "deploy/**" and "deploy" both match "deploy/prod/app.yaml"
+func Match(pattern, name string) bool {
+	patternSegments := splitPath(pattern)
+	nameSegments := splitPath(name)
+	for i := 1; i <= len(nameSegments); i++ {
+		if matchSegments(patternSegments, nameSegments[:i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchAny reports whether the path matches any of the patterns
+func MatchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if Match(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePattern checks that the pattern has valid glob syntax
+func ValidatePattern(pattern string) error {
+	for _, segment := range splitPath(pattern) {
+		if segment == "**" {
+			continue
+		}
+		if _, err := path.Match(segment, ""); err != nil {
+			return fmt.Errorf("invalid pattern '%s': %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// Take hashes every file under repoPath that matches one of the patterns
+// The .git directory is always skipped
+func Take(repoPath string, patterns []string) (Snapshot, error) {
+	snapshot := make(Snapshot)
+	if len(patterns) == 0 {
+		return snapshot, nil
+	}
+
+	for _, root := range walkRoots(patterns) {
+		start := filepath.Join(repoPath, filepath.FromSlash(root))
+		if _, err := os.Stat(start); os.IsNotExist(err) {
+			continue
+		}
+
+		err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				if d.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if d.Name() == ".git" {
+				// 링크된 worktree / 서브모듈의 gitdir 파일
+				return nil
+			}
+
+			rel, err := filepath.Rel(repoPath, p)
+			if err != nil {
+				return err
+			}
+			rel = filepath.ToSlash(rel)
+			if _, seen := snapshot[rel]; seen || !MatchAny(patterns, rel) {
+				return nil
+			}
+
+			hash, err := hashFile(p)
+			if err != nil {
+				return err
+			}
+			snapshot[rel] = hash
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan protected paths: %w", err)
+		}
+	}
+
+	return snapshot, nil
+}
+
+// Diff returns the paths that were added, removed, or modified between two snapshots
+func Diff(before, after Snapshot) []string {
+	var changed []string
+	for name, hash := range before {
+		if afterHash, ok := after[name]; !ok || afterHash != hash {
+			changed = append(changed, name)
+		}
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// walkRoots returns the literal directory prefixes of the patterns
+// so that only the relevant parts of the tree are scanned
+func walkRoots(patterns []string) []string {
+	var roots []string
+	for _, pattern := range patterns {
+		var literal []string
+		segments := splitPath(pattern)
+		for i, segment := range segments {
+			// 마지막 세그먼트는 파일일 수 있으므로 디렉토리 접두사에서 제외
+			if i == len(segments)-1 || strings.ContainsAny(segment, "*?[") {
+				break
+			}
+			literal = append(literal, segment)
+		}
+		roots = append(roots, strings.Join(literal, "/"))
+	}
+
+	// 다른 root에 포함되는 root 제거
+	sort.Strings(roots)
+	var result []string
+	for _, root := range roots {
+		covered := false
+		for _, existing := range result {
+			if existing == "" || root == existing || strings.HasPrefix(root, existing+"/") {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			result = append(result, root)
+		}
+	}
+	return result
+}
+
+// matchSegments matches path segments against pattern segments
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			// '**'는 0개 이상의 세그먼트와 일치
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		matched, err := path.Match(pattern[0], name[0])
+		if err != nil || !matched {
+			return false
+		}
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+	return len(name) == 0
+}
+
+// splitPath splits a slash-separated path into non-empty segments
+func splitPath(p string) []string {
+	var segments []string
+	for _, segment := range strings.Split(filepath.ToSlash(p), "/") {
+		if segment != "" && segment != "." {
+			segments = append(segments, segment)
+		}
+	}
+	return segments
+}
+
+// hashFile returns the SHA-256 hash of a file's content
+func hashFile(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
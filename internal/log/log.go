@@ -0,0 +1,154 @@
+package log
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a log entry
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+// String returns the level name used in log lines
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(l))
+	}
+}
+
+// ParseLevel parses a level name (debug, info, warn, error)
+func ParseLevel(name string) (Level, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "debug":
+		return LevelDebug, nil
+	case "info", "":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return LevelInfo, fmt.Errorf("invalid log level '%s' (expected: debug, info, warn, error)", name)
+	}
+}
+
+// Logger writes leveled log lines. Safe for concurrent use.
+type Logger struct {
+	mu     sync.Mutex
+	out    io.Writer
+	level  Level
+	closer io.Closer // 파일 로거인 경우 닫을 대상
+}
+
+// New creates a logger writing entries at or above level to out
+func New(out io.Writer, level Level) *Logger {
+	return &Logger{out: out, level: level}
+}
+
+// Open creates a logger appending to the file at path, creating parent directories
+func Open(path string, level Level) (*Logger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log file: %w", err)
+	}
+	return &Logger{out: file, level: level, closer: file}, nil
+}
+
+// Close closes the underlying file, if any
+func (l *Logger) Close() error {
+	if l == nil || l.closer == nil {
+		return nil
+	}
+	return l.closer.Close()
+}
+
+// Enabled reports whether entries at level are written
+func (l *Logger) Enabled(level Level) bool {
+	return l != nil && level >= l.level
+}
+
+// Debugf logs a debug message
+func (l *Logger) Debugf(format string, args ...any) { l.logf(LevelDebug, format, args...) }
+
+// Infof logs an informational message
+func (l *Logger) Infof(format string, args ...any) { l.logf(LevelInfo, format, args...) }
+
+// Warnf logs a warning
+func (l *Logger) Warnf(format string, args ...any) { l.logf(LevelWarn, format, args...) }
+
+// Errorf logs an error
+func (l *Logger) Errorf(format string, args ...any) { l.logf(LevelError, format, args...) }
+
+// logf writes a single entry; continuation lines (e.g. hints) are indented
+func (l *Logger) logf(level Level, format string, args ...any) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	message := strings.TrimRight(fmt.Sprintf(format, args...), "\n")
+	message = strings.ReplaceAll(message, "\n", "\n    ")
+	line := fmt.Sprintf("%s %-5s %s\n", time.Now().Format("2006-01-02T15:04:05.000Z07:00"), level, message)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = io.WriteString(l.out, line)
+}
+
+// ============================================================================
+// 기본 로거 (--log-file)
+// ============================================================================
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger = New(io.Discard, LevelInfo)
+)
+
+// SetDefault replaces the logger used by the package-level functions
+func SetDefault(l *Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
+
+// Default returns the logger used by the package-level functions
+func Default() *Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// Debugf logs a debug message to the default logger
+func Debugf(format string, args ...any) { Default().Debugf(format, args...) }
+
+// Infof logs an informational message to the default logger
+func Infof(format string, args ...any) { Default().Infof(format, args...) }
+
+// Warnf logs a warning to the default logger
+func Warnf(format string, args ...any) { Default().Warnf(format, args...) }
+
+// Errorf logs an error to the default logger
+func Errorf(format string, args ...any) { Default().Errorf(format, args...) }
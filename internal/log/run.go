@@ -0,0 +1,82 @@
+package log
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DirName is the directory next to the config file that holds run logs
+const DirName = "logs"
+
+// DefaultKeepRuns is the number of run log directories kept by StartRun
+const DefaultKeepRuns = 20
+
+// Run is the log directory of a single command run, holding one log file per repository
+type Run struct {
+	dir   string
+	level Level
+}
+
+// StartRun creates a new run log directory under baseDir named after the
+// start time and operation, removing the oldest runs beyond keep
+func StartRun(baseDir, operation string, level Level, keep int) (*Run, error) {
+	name := fmt.Sprintf("%s-%s", time.Now().Format("20060102-150405.000"), sanitizeName(operation))
+	dir := filepath.Join(baseDir, name)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	if keep > 0 {
+		pruneRuns(baseDir, keep)
+	}
+	return &Run{dir: dir, level: level}, nil
+}
+
+// Dir returns the directory of the run
+func (r *Run) Dir() string {
+	return r.dir
+}
+
+// Repo opens the log file of a repository in this run
+func (r *Run) Repo(name string) (*Logger, error) {
+	return Open(filepath.Join(r.dir, sanitizeName(name)+".log"), r.level)
+}
+
+// pruneRuns removes the oldest run directories so that at most keep remain
+// Directory names start with the start time, so name order is age order
+func pruneRuns(baseDir string, keep int) {
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		return
+	}
+
+	var runs []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			runs = append(runs, entry.Name())
+		}
+	}
+	if len(runs) <= keep {
+		return
+	}
+
+	sort.Strings(runs)
+	for _, name := range runs[:len(runs)-keep] {
+		_ = os.RemoveAll(filepath.Join(baseDir, name))
+	}
+}
+
+// sanitizeName makes a repository or operation name safe for use as a file name
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '/', '\\', ' ', ':':
+			return '_'
+		}
+		return r
+	}, name)
+}
@@ -0,0 +1,167 @@
+// Package mailmap maps author names and emails to canonical identities
+// using the .mailmap format of git
+package mailmap
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FileName is the mailmap file at the top of a repository's working tree
+const FileName = ".mailmap"
+
+// identity is the canonical name and email of an author (empty fields are kept)
+type identity struct {
+	name  string
+	email string
+}
+
+// entry holds the mappings of one commit email
+type entry struct {
+	identity                     // 이름과 관계없이 적용되는 매핑
+	byName   map[string]identity // 커밋 이름(소문자) -> 매핑 (이름까지 일치할 때 우선)
+}
+
+// Mailmap is a parsed mailmap
+// A nil Mailmap maps every identity to itself.
+type Mailmap struct {
+	entries map[string]*entry // 커밋 이메일(소문자) -> 매핑
+	next    *Mailmap          // 이 매핑 결과에 이어서 적용할 mailmap
+}
+
+// Parse parses mailmap text. Each non-comment line has one of the forms
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+func Parse(text string) (*Mailmap, error) {
+	m := &Mailmap{entries: make(map[string]*entry)}
+	for i, line := range strings.Split(text, "\n") {
+		if err := m.addLine(line); err != nil {
+			return nil, fmt.Errorf("mailmap line %d: %w", i+1, err)
+		}
+	}
+	return m, nil
+}
+
+// ParseLines parses mailmap entries given one per element (e.g. from the config)
+func ParseLines(lines []string) (*Mailmap, error) {
+	m := &Mailmap{entries: make(map[string]*entry)}
+	for _, line := range lines {
+		if err := m.addLine(line); err != nil {
+			return nil, fmt.Errorf("invalid mailmap entry '%s': %w", line, err)
+		}
+	}
+	return m, nil
+}
+
+// Load reads a mailmap file. A missing file is an empty mailmap.
+func Load(path string) (*Mailmap, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &Mailmap{entries: make(map[string]*entry)}, nil
+		}
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	m, err := Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// Chain returns a mailmap that applies m and then next to its result
+// (e.g. a repository's .mailmap, then the fleet-level mailmap of the config).
+func (m *Mailmap) Chain(next *Mailmap) *Mailmap {
+	if m == nil {
+		return next
+	}
+	if next == nil {
+		return m
+	}
+	chained := *m
+	chained.next = m.next.Chain(next)
+	return &chained
+}
+
+// Resolve returns the canonical name and email of an author
+// An entry matching both the commit name and email takes precedence over one
+// matching the email only. Emails and names are compared case-insensitively.
+func (m *Mailmap) Resolve(name, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+
+	if e, ok := m.entries[strings.ToLower(email)]; ok {
+		mapped := e.identity
+		if byName, ok := e.byName[strings.ToLower(name)]; ok {
+			mapped = byName
+		}
+		if mapped.name != "" {
+			name = mapped.name
+		}
+		if mapped.email != "" {
+			email = mapped.email
+		}
+	}
+	return m.next.Resolve(name, email)
+}
+
+// addLine parses one mailmap line into m
+func (m *Mailmap) addLine(line string) error {
+	if i := strings.Index(line, "#"); i >= 0 {
+		line = line[:i]
+	}
+	if strings.TrimSpace(line) == "" {
+		return nil
+	}
+
+	// "이름 <이메일>" 쌍을 최대 두 개까지 읽음
+	var names, emails []string
+	rest := line
+	for strings.TrimSpace(rest) != "" {
+		open := strings.Index(rest, "<")
+		closing := strings.Index(rest, ">")
+		if open < 0 || closing < open {
+			return fmt.Errorf("expected 'Name <email>'")
+		}
+		names = append(names, strings.TrimSpace(rest[:open]))
+		emails = append(emails, strings.TrimSpace(rest[open+1:closing]))
+		rest = rest[closing+1:]
+	}
+	if len(emails) > 2 {
+		return fmt.Errorf("more than two emails")
+	}
+
+	proper := identity{name: names[0], email: emails[0]}
+	commitName, commitEmail := "", emails[0]
+	if len(emails) == 2 {
+		commitName, commitEmail = names[1], emails[1]
+	} else {
+		// "Proper Name <commit@email>": 이메일은 그대로 두고 이름만 매핑
+		proper.email = ""
+	}
+	if commitEmail == "" {
+		return fmt.Errorf("commit email is empty")
+	}
+	if proper.name == "" && proper.email == "" {
+		return fmt.Errorf("no proper name or email")
+	}
+
+	key := strings.ToLower(commitEmail)
+	e, ok := m.entries[key]
+	if !ok {
+		e = &entry{byName: make(map[string]identity)}
+		m.entries[key] = e
+	}
+	if commitName != "" {
+		e.byName[strings.ToLower(commitName)] = proper
+	} else {
+		e.identity = proper
+	}
+	return nil
+}
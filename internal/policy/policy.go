@@ -0,0 +1,156 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+)
+
+// Block markers surrounding the managed section of a file
+const (
+	blockBegin = "BEGIN multi-git policy"
+	blockEnd   = "END multi-git policy"
+)
+
+// FileStatus represents the policy status of a single managed file
+type FileStatus struct {
+	Path    string // 저장소 내 경로
+	Drifted bool   // 정책과 다른지 여부
+	Missing bool   // 파일이 존재하지 않는지 여부
+}
+
+// Check compares each managed file in the repository with the policy
+// Returns the status of every policy file
+func Check(repoPath string, files []config.PolicyFile) ([]FileStatus, error) {
+	statuses := make([]FileStatus, 0, len(files))
+	for _, file := range files {
+		current, exists, err := readTarget(repoPath, file.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		desired, err := Render(current, file)
+		if err != nil {
+			return nil, err
+		}
+
+		statuses = append(statuses, FileStatus{
+			Path:    file.Path,
+			Drifted: desired != current,
+			Missing: !exists,
+		})
+	}
+	return statuses, nil
+}
+
+// Sync writes the managed files into the repository
+// Files that already match the policy are left untouched
+// Returns the paths of files that were changed (or would be, in dry-run mode)
+func Sync(repoPath string, files []config.PolicyFile, dryRun bool) ([]string, error) {
+	var changed []string
+	for _, file := range files {
+		current, _, err := readTarget(repoPath, file.Path)
+		if err != nil {
+			return changed, err
+		}
+
+		desired, err := Render(current, file)
+		if err != nil {
+			return changed, err
+		}
+
+		if desired == current {
+			continue
+		}
+		changed = append(changed, file.Path)
+
+		if dryRun {
+			continue
+		}
+
+		target := filepath.Join(repoPath, file.Path)
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return changed, fmt.Errorf("failed to create directory for %s: %w", file.Path, err)
+		}
+		if err := os.WriteFile(target, []byte(desired), 0644); err != nil {
+			return changed, fmt.Errorf("failed to write %s: %w", file.Path, err)
+		}
+	}
+	return changed, nil
+}
+
+// Render returns the desired content of a file given its current content
+// In block mode, only the section between the markers is managed
+func Render(current string, file config.PolicyFile) (string, error) {
+	content, err := loadContent(file)
+	if err != nil {
+		return "", err
+	}
+
+	if file.Mode == config.PolicyModeReplace {
+		return content, nil
+	}
+
+	comment := file.Comment
+	if comment == "" {
+		comment = "#"
+	}
+	begin := fmt.Sprintf("%s %s", comment, blockBegin)
+	end := fmt.Sprintf("%s %s", comment, blockEnd)
+	block := begin + "\n" + content + end + "\n"
+
+	startIdx := strings.Index(current, begin)
+	if startIdx >= 0 {
+		endIdx := strings.Index(current[startIdx:], end)
+		if endIdx < 0 {
+			return "", fmt.Errorf("%s: found '%s' without matching '%s'", file.Path, begin, end)
+		}
+		endIdx += startIdx + len(end)
+		// 블록 뒤의 줄바꿈까지 포함하여 교체
+		if endIdx < len(current) && current[endIdx] == '\n' {
+			endIdx++
+		}
+		return current[:startIdx] + block + current[endIdx:], nil
+	}
+
+	// 블록이 없으면 파일 끝에 추가
+	if current == "" {
+		return block, nil
+	}
+	if !strings.HasSuffix(current, "\n") {
+		current += "\n"
+	}
+	return current + "\n" + block, nil
+}
+
+// loadContent returns the policy content, always ending with a newline
+func loadContent(file config.PolicyFile) (string, error) {
+	content := file.Content
+	if file.Source != "" {
+		data, err := os.ReadFile(file.Source)
+		if err != nil {
+			return "", fmt.Errorf("failed to read policy source for %s: %w", file.Path, err)
+		}
+		content = string(data)
+	}
+
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		content += "\n"
+	}
+	return content, nil
+}
+
+// readTarget reads the current content of a file in the repository
+func readTarget(repoPath, path string) (string, bool, error) {
+	data, err := os.ReadFile(filepath.Join(repoPath, path))
+	if os.IsNotExist(err) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return string(data), true, nil
+}
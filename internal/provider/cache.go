@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CacheDirName is the directory next to the config file that holds cached API responses
+const CacheDirName = "provider_cache"
+
+// cacheEntry is an API response kept with its ETag, so that the next request for the
+// same URL can be answered with 304 Not Modified (which does not count against the
+// GitHub rate limit)
+type cacheEntry struct {
+	ETag   string          `json:"etag"`   // 응답의 ETag
+	Header http.Header     `json:"header"` // 응답 헤더 (페이지 정보)
+	Body   json.RawMessage `json:"body"`   // 응답 본문
+}
+
+// cachePath returns the cache file of a request; the credentials are part of the key
+// since they decide which repositories the response lists
+func cachePath(dir, url string, header http.Header) string {
+	sum := sha256.Sum256([]byte(url + "\n" + header.Get("Authorization") + "\n" + header.Get("PRIVATE-TOKEN")))
+	return filepath.Join(dir, hex.EncodeToString(sum[:16])+".json")
+}
+
+// loadCacheEntry returns the cached response at path, or nil if there is none
+func loadCacheEntry(path string) *cacheEntry {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.ETag == "" {
+		return nil
+	}
+	// 사용한 응답은 보존 기간이 다시 시작되도록 수정 시각 갱신
+	now := time.Now()
+	_ = os.Chtimes(path, now, now)
+	return &entry
+}
+
+// saveCacheEntry writes a response to the cache; failures only lose the cached copy
+func saveCacheEntry(path string, entry cacheEntry) {
+	data, err := json.Marshal(&entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+	}
+}
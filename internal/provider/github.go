@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GitHubAPI is the API address of github.com
+const GitHubAPI = "https://api.github.com"
+
+// githubRepo holds the fields of the GitHub repository API used for importing
+type githubRepo struct {
+	Name          string   `json:"name"`
+	CloneURL      string   `json:"clone_url"`
+	SSHURL        string   `json:"ssh_url"`
+	DefaultBranch string   `json:"default_branch"`
+	Archived      bool     `json:"archived"`
+	Fork          bool     `json:"fork"`
+	Topics        []string `json:"topics"`
+}
+
+// ListGitHubOrg lists all repositories of a GitHub organization
+// For GitHub Enterprise, set opts.BaseURL to the API address (https://host/api/v3).
+func ListGitHubOrg(ctx context.Context, org string, opts Options) ([]Repository, error) {
+	base := strings.TrimSuffix(opts.BaseURL, "/")
+	if base == "" {
+		base = GitHubAPI
+	}
+
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github+json")
+	header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if opts.Token != "" {
+		header.Set("Authorization", "Bearer "+opts.Token)
+	}
+
+	var repos []Repository
+	for page := 1; ; page++ {
+		pageURL := fmt.Sprintf("%s/orgs/%s/repos?type=all&per_page=%d&page=%d", base, url.PathEscape(org), perPage, page)
+
+		var batch []githubRepo
+		if _, err := getJSON(ctx, pageURL, header, opts.CacheDir, &batch); err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("GitHub organization '%s' not found (private organizations need a token): %w", org, err)
+			}
+			return nil, fmt.Errorf("failed to list repositories of '%s': %w", org, err)
+		}
+
+		for _, r := range batch {
+			repos = append(repos, Repository{
+				Name:          r.Name,
+				CloneURL:      r.CloneURL,
+				SSHURL:        r.SSHURL,
+				DefaultBranch: r.DefaultBranch,
+				Archived:      r.Archived,
+				Fork:          r.Fork,
+				Topics:        r.Topics,
+			})
+		}
+		if len(batch) < perPage {
+			return repos, nil
+		}
+	}
+}
+
+// GetGitHubRepository returns a single GitHub repository ("owner/name")
+// For GitHub Enterprise, set opts.BaseURL to the API address (https://host/api/v3).
+func GetGitHubRepository(ctx context.Context, fullName string, opts Options) (*Repository, error) {
+	base := strings.TrimSuffix(opts.BaseURL, "/")
+	if base == "" {
+		base = GitHubAPI
+	}
+
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github+json")
+	header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if opts.Token != "" {
+		header.Set("Authorization", "Bearer "+opts.Token)
+	}
+
+	var r githubRepo
+	if _, err := getJSON(ctx, fmt.Sprintf("%s/repos/%s", base, strings.Trim(fullName, "/")), header, opts.CacheDir, &r); err != nil {
+		return nil, fmt.Errorf("failed to get repository '%s': %w", fullName, err)
+	}
+	return &Repository{
+		Name:          r.Name,
+		CloneURL:      r.CloneURL,
+		SSHURL:        r.SSHURL,
+		DefaultBranch: r.DefaultBranch,
+		Archived:      r.Archived,
+		Fork:          r.Fork,
+		Topics:        r.Topics,
+	}, nil
+}
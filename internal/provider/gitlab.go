@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GitLabURL is the address of gitlab.com
+const GitLabURL = "https://gitlab.com"
+
+// gitlabProject holds the fields of the GitLab project API used for importing
+type gitlabProject struct {
+	Path              string    `json:"path"`
+	PathWithNamespace string    `json:"path_with_namespace"`
+	HTTPURL           string    `json:"http_url_to_repo"`
+	SSHURL            string    `json:"ssh_url_to_repo"`
+	DefaultBranch     string    `json:"default_branch"`
+	Archived          bool      `json:"archived"`
+	ForkedFrom        *struct{} `json:"forked_from_project"`
+	Topics            []string  `json:"topics"`
+}
+
+// ListGitLabGroup lists all projects of a GitLab group including its subgroups
+// Projects in subgroups are named after their path below the group ("sub-name").
+// For self-hosted GitLab, set opts.BaseURL to the instance address (https://host).
+func ListGitLabGroup(ctx context.Context, group string, opts Options) ([]Repository, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(opts.BaseURL, "/"), "/api/v4")
+	if base == "" {
+		base = GitLabURL
+	}
+	group = strings.Trim(group, "/")
+
+	header := http.Header{}
+	if opts.Token != "" {
+		header.Set("PRIVATE-TOKEN", opts.Token)
+	}
+
+	var repos []Repository
+	page := "1"
+	for page != "" {
+		pageURL := fmt.Sprintf("%s/api/v4/groups/%s/projects?include_subgroups=true&per_page=%d&page=%s",
+			base, url.PathEscape(group), perPage, page)
+
+		var batch []gitlabProject
+		resHeader, err := getJSON(ctx, pageURL, header, opts.CacheDir, &batch)
+		if err != nil {
+			var apiErr *APIError
+			if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+				return nil, fmt.Errorf("GitLab group '%s' not found (private groups need a token): %w", group, err)
+			}
+			return nil, fmt.Errorf("failed to list projects of '%s': %w", group, err)
+		}
+
+		for _, p := range batch {
+			repos = append(repos, Repository{
+				Name:          gitlabName(group, p),
+				CloneURL:      p.HTTPURL,
+				SSHURL:        p.SSHURL,
+				DefaultBranch: p.DefaultBranch,
+				Archived:      p.Archived,
+				Fork:          p.ForkedFrom != nil,
+				Topics:        p.Topics,
+			})
+		}
+		page = resHeader.Get("X-Next-Page")
+	}
+	return repos, nil
+}
+
+// gitlabName returns the project path below the group with "/" replaced by "-"
+func gitlabName(group string, p gitlabProject) string {
+	relative, ok := strings.CutPrefix(p.PathWithNamespace, group+"/")
+	if !ok {
+		return p.Path
+	}
+	return strings.ReplaceAll(relative, "/", "-")
+}
+
+// GetGitLabProject returns a single GitLab project by its full path ("group/sub/name")
+// For self-hosted GitLab, set opts.BaseURL to the instance address (https://host).
+func GetGitLabProject(ctx context.Context, fullPath string, opts Options) (*Repository, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(opts.BaseURL, "/"), "/api/v4")
+	if base == "" {
+		base = GitLabURL
+	}
+	fullPath = strings.Trim(fullPath, "/")
+
+	header := http.Header{}
+	if opts.Token != "" {
+		header.Set("PRIVATE-TOKEN", opts.Token)
+	}
+
+	var p gitlabProject
+	if _, err := getJSON(ctx, fmt.Sprintf("%s/api/v4/projects/%s", base, url.PathEscape(fullPath)), header, opts.CacheDir, &p); err != nil {
+		return nil, fmt.Errorf("failed to get project '%s': %w", fullPath, err)
+	}
+	return &Repository{
+		Name:          p.Path,
+		CloneURL:      p.HTTPURL,
+		SSHURL:        p.SSHURL,
+		DefaultBranch: p.DefaultBranch,
+		Archived:      p.Archived,
+		Fork:          p.ForkedFrom != nil,
+		Topics:        p.Topics,
+	}, nil
+}
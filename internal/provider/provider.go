@@ -0,0 +1,164 @@
+// Package provider lists the repositories of a GitHub organization or a GitLab group
+// so they can be imported into the multi-git configuration, looks up single
+// repositories to check the configured ones, and opens pull requests.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// requestTimeout limits how long a single API request may take
+const requestTimeout = 30 * time.Second
+
+// perPage is the page size requested from the provider APIs (the maximum for both)
+const perPage = 100
+
+// Repository is a repository listed by a provider
+type Repository struct {
+	Name          string   // 저장소 이름 (GitLab 하위 그룹은 "sub-name")
+	CloneURL      string   // HTTPS 클론 URL
+	SSHURL        string   // SSH 클론 URL
+	DefaultBranch string   // 기본 브랜치 (빈 저장소면 "")
+	Archived      bool     // 보관된 저장소
+	Fork          bool     // 포크
+	Topics        []string // 토픽
+}
+
+// Options controls a listing
+type Options struct {
+	BaseURL  string // API 주소 (비어있으면 github.com / gitlab.com)
+	Token    string // 액세스 토큰 (비공개 저장소용, 선택적)
+	CacheDir string // 응답 캐시 디렉토리 (ETag로 재검증, 비어있으면 캐시 안 함)
+}
+
+// Filter selects the repositories to import
+type Filter struct {
+	IncludeArchived bool     // 보관된 저장소 포함
+	IncludeForks    bool     // 포크 포함
+	Topics          []string // 이 토픽 중 하나가 있는 저장소만 (비어있으면 전체)
+}
+
+// Apply returns the repositories matching the filter
+func (f Filter) Apply(repos []Repository) []Repository {
+	var matched []Repository
+	for _, repo := range repos {
+		if repo.Archived && !f.IncludeArchived {
+			continue
+		}
+		if repo.Fork && !f.IncludeForks {
+			continue
+		}
+		if len(f.Topics) > 0 && !hasAnyTopic(repo.Topics, f.Topics) {
+			continue
+		}
+		matched = append(matched, repo)
+	}
+	return matched
+}
+
+// hasAnyTopic returns true if topics contains any of wanted (case-insensitive)
+func hasAnyTopic(topics, wanted []string) bool {
+	for _, topic := range topics {
+		for _, w := range wanted {
+			if strings.EqualFold(topic, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getJSON requests url and decodes the JSON response into v
+// With a cache directory, responses with an ETag are kept and revalidated with
+// If-None-Match. Returns the response headers for pagination.
+func getJSON(ctx context.Context, url string, header http.Header, cacheDir string, v any) (http.Header, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API URL: %w", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	// 캐시된 응답이 있으면 조건부 요청
+	var path string
+	var cached *cacheEntry
+	if cacheDir != "" {
+		path = cachePath(cacheDir, url, header)
+		if cached = loadCacheEntry(path); cached != nil {
+			req.Header.Set("If-None-Match", cached.ETag)
+		}
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		if err := json.Unmarshal(cached.Body, v); err != nil {
+			return nil, fmt.Errorf("invalid cached API response: %w", err)
+		}
+		return cached.Header, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return nil, &APIError{StatusCode: res.StatusCode, Message: apiErrorMessage(body)}
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return nil, fmt.Errorf("invalid API response: %w", err)
+	}
+	if etag := res.Header.Get("ETag"); path != "" && etag != "" {
+		saveCacheEntry(path, cacheEntry{ETag: etag, Header: res.Header, Body: body})
+	}
+	return res.Header, nil
+}
+
+// APIError is returned when the provider API answers with an error status
+type APIError struct {
+	StatusCode int    // HTTP 상태 코드
+	Message    string // API 에러 메시지
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("API returned %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("API returned %d", e.StatusCode)
+}
+
+// apiErrorMessage extracts the "message" field of a GitHub or GitLab error body,
+// with the messages of GitHub validation errors (e.g. "A pull request already exists")
+func apiErrorMessage(body []byte) string {
+	var parsed struct {
+		Message any `json:"message"`
+		Errors  []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &parsed); err == nil && parsed.Message != nil {
+		message := fmt.Sprint(parsed.Message)
+		for _, e := range parsed.Errors {
+			if e.Message != "" {
+				message += ": " + e.Message
+			}
+		}
+		return message
+	}
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// PullRequest is a pull request (GitLab: merge request) to open
+type PullRequest struct {
+	Title string // 제목
+	Body  string // 설명
+	Head  string // 병합할 브랜치
+	Base  string // 병합 대상 브랜치
+}
+
+// CreateGitHubPullRequest opens a pull request in a GitHub repository ("owner/name")
+// and returns its web URL. Requires a token with write access to the repository.
+// For GitHub Enterprise, set opts.BaseURL to the API address (https://host/api/v3).
+func CreateGitHubPullRequest(ctx context.Context, fullName string, pr PullRequest, opts Options) (string, error) {
+	base := strings.TrimSuffix(opts.BaseURL, "/")
+	if base == "" {
+		base = GitHubAPI
+	}
+
+	header := http.Header{}
+	header.Set("Accept", "application/vnd.github+json")
+	header.Set("X-GitHub-Api-Version", "2022-11-28")
+	header.Set("Authorization", "Bearer "+opts.Token)
+
+	request := map[string]string{"title": pr.Title, "body": pr.Body, "head": pr.Head, "base": pr.Base}
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := postJSON(ctx, fmt.Sprintf("%s/repos/%s/pulls", base, strings.Trim(fullName, "/")), header, request, &created); err != nil {
+		return "", fmt.Errorf("failed to open pull request in '%s': %w", fullName, err)
+	}
+	return created.HTMLURL, nil
+}
+
+// CreateGitLabMergeRequest opens a merge request in a GitLab project ("group/sub/name")
+// and returns its web URL. Requires a token with the api scope.
+// For self-hosted GitLab, set opts.BaseURL to the instance address (https://host).
+func CreateGitLabMergeRequest(ctx context.Context, fullPath string, pr PullRequest, opts Options) (string, error) {
+	base := strings.TrimSuffix(strings.TrimSuffix(opts.BaseURL, "/"), "/api/v4")
+	if base == "" {
+		base = GitLabURL
+	}
+	fullPath = strings.Trim(fullPath, "/")
+
+	header := http.Header{}
+	header.Set("PRIVATE-TOKEN", opts.Token)
+
+	request := map[string]string{"title": pr.Title, "description": pr.Body, "source_branch": pr.Head, "target_branch": pr.Base}
+	var created struct {
+		WebURL string `json:"web_url"`
+	}
+	if err := postJSON(ctx, fmt.Sprintf("%s/api/v4/projects/%s/merge_requests", base, url.PathEscape(fullPath)), header, request, &created); err != nil {
+		return "", fmt.Errorf("failed to open merge request in '%s': %w", fullPath, err)
+	}
+	return created.WebURL, nil
+}
+
+// postJSON sends body as JSON to url and decodes the JSON response into v
+func postJSON(ctx context.Context, url string, header http.Header, body, v any) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("invalid API URL: %w", err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return &APIError{StatusCode: res.StatusCode, Message: apiErrorMessage(body)}
+	}
+	if err := json.NewDecoder(res.Body).Decode(v); err != nil {
+		return fmt.Errorf("invalid API response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"sort"
+	"time"
+)
+
+// StepTiming is the duration of one step (e.g. fetch, push) across the repositories of a run
+type StepTiming struct {
+	Name    string        // 단계 이름
+	Count   int           // 단계를 실행한 저장소 수
+	P50     time.Duration // 중앙값
+	P95     time.Duration // 95번째 백분위
+	Max     time.Duration // 가장 오래 걸린 시간
+	MaxRepo string        // 가장 오래 걸린 저장소
+}
+
+// TimedResults returns the results of the repositories the task ran on, i.e.
+// without skipped and cancelled ones, whose durations are meaningful
+func (s *Summary) TimedResults() []Result {
+	var results []Result
+	for _, r := range s.Results {
+		if r.Status != StatusCancelled && r.Status != StatusSkipped {
+			results = append(results, r)
+		}
+	}
+	return results
+}
+
+// Slowest returns up to n of the timed results, slowest first
+func (s *Summary) Slowest(n int) []Result {
+	results := s.TimedResults()
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Duration > results[j].Duration
+	})
+	if len(results) > n {
+		results = results[:n]
+	}
+	return results
+}
+
+// StepTimings aggregates the durations of the steps that were run (not skipped),
+// in the order the steps first appear
+func (s *Summary) StepTimings() []StepTiming {
+	var names []string
+	durations := make(map[string][]time.Duration)
+	timings := make(map[string]*StepTiming)
+	for _, r := range s.TimedResults() {
+		for _, step := range r.Steps {
+			if step.Status == StepSkipped {
+				continue
+			}
+			timing, ok := timings[step.Name]
+			if !ok {
+				timing = &StepTiming{Name: step.Name}
+				timings[step.Name] = timing
+				names = append(names, step.Name)
+			}
+			timing.Count++
+			if step.Duration > timing.Max {
+				timing.Max = step.Duration
+				timing.MaxRepo = r.RepoName
+			}
+			durations[step.Name] = append(durations[step.Name], step.Duration)
+		}
+	}
+
+	result := make([]StepTiming, 0, len(names))
+	for _, name := range names {
+		timing := timings[name]
+		timing.P50, timing.P95 = durationPercentiles(durations[name])
+		result = append(result, *timing)
+	}
+	return result
+}
+
+// durationPercentiles returns the median and the 95th percentile of the durations
+// (nearest rank), or zero if there are none
+func durationPercentiles(durations []time.Duration) (p50, p95 time.Duration) {
+	if len(durations) == 0 {
+		return 0, 0
+	}
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return percentile(sorted, 50), percentile(sorted, 95)
+}
+
+// percentile returns the p-th percentile of sorted durations by the nearest-rank method
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100 // ceil(p/100 * n)
+	return sorted[max(rank, 1)-1]
+}
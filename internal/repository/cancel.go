@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrTaskCancelled is the error of a repository whose running task was cancelled with CancelTask
+var ErrTaskCancelled = errors.New("cancelled while running")
+
+// runningTasks tracks the contexts of the tasks that are running, by repository name
+type runningTasks struct {
+	mu      sync.Mutex
+	cancels map[string]context.CancelCauseFunc // 저장소 이름 -> 작업 취소 함수
+	ctxs    map[string]context.Context         // 저장소 이름 -> 작업 컨텍스트
+}
+
+// start registers the running task of a repository and returns its context
+// The context is cancelled by CancelTask (cause ErrTaskCancelled) and when the deadline
+// passes (cause timeout), but not when the run is cancelled, so repositories already
+// running finish on fail-fast and Ctrl-C. A zero deadline means no time limit.
+func (t *runningTasks) start(parent context.Context, name string, deadline time.Time, timeout error) context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancels == nil {
+		t.cancels = make(map[string]context.CancelCauseFunc)
+		t.ctxs = make(map[string]context.Context)
+	}
+
+	// 실행 취소는 전달하지 않고 값만 유지
+	ctx := context.WithoutCancel(parent)
+	var stop context.CancelFunc = func() {}
+	if !deadline.IsZero() {
+		ctx, stop = context.WithDeadlineCause(ctx, deadline, timeout)
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	t.cancels[name] = func(cause error) {
+		cancel(cause)
+		stop()
+	}
+	t.ctxs[name] = ctx
+	return ctx
+}
+
+// finish unregisters the task of a repository and cancels its context, which
+// interrupts what a task abandoned after a timeout or CancelTask is still running
+func (t *runningTasks) finish(name string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if cancel, ok := t.cancels[name]; ok {
+		cancel(context.Canceled)
+	}
+	delete(t.cancels, name)
+	delete(t.ctxs, name)
+}
+
+// TaskContext returns the context of the repository's running task, cancelled when
+// the task is cancelled with CancelTask or runs out of time (repository timeout or the
+// deadline of the run, with a timeout RepoError as cause). Tasks pass it to operations that can be
+// interrupted (shell commands, clones). Returns context.Background() if the
+// repository's task is not running.
+func (m *Manager) TaskContext(repoName string) context.Context {
+	m.tasks.mu.Lock()
+	defer m.tasks.mu.Unlock()
+	if ctx, ok := m.tasks.ctxs[repoName]; ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+// CancelTask cancels the running task of a repository while the rest of the run
+// continues. The repository is reported as cancelled with ErrTaskCancelled.
+// Returns false if the repository's task is not running.
+func (m *Manager) CancelTask(repoName string) bool {
+	m.tasks.mu.Lock()
+	defer m.tasks.mu.Unlock()
+	cancel, ok := m.tasks.cancels[repoName]
+	if ok {
+		cancel(ErrTaskCancelled)
+	}
+	return ok
+}
+
+// RunningTasks returns the names of the repositories whose task is running, sorted
+func (m *Manager) RunningTasks() []string {
+	m.tasks.mu.Lock()
+	defer m.tasks.mu.Unlock()
+	names := make([]string, 0, len(m.tasks.cancels))
+	for name := range m.tasks.cancels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
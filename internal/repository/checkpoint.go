@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CheckpointDirName is the directory next to the config file that holds checkpoints
+const CheckpointDirName = "checkpoints"
+
+// CheckpointInterval is the minimum time between two writes of a checkpoint
+const CheckpointInterval = 5 * time.Second
+
+// CheckpointEntry is the recorded result of a repository that completed successfully
+type CheckpointEntry struct {
+	Message string         `json:"message,omitempty"` // 결과 메시지
+	Skipped bool           `json:"skipped,omitempty"` // 할 일이 없어 스킵됨
+	Seconds float64        `json:"seconds"`           // 소요 시간
+	Details map[string]any `json:"details,omitempty"` // 구조화된 추가 정보
+}
+
+// Checkpoint records the repositories a run has completed, so an interrupted run
+// can be resumed without repeating them
+type Checkpoint struct {
+	path      string                     // 저장 파일 경로
+	mu        sync.Mutex                 // Completed 및 저장 보호
+	savedAt   time.Time                  // 마지막 저장 시각
+	dirty     bool                       // 저장하지 않은 결과가 있는지 여부
+	Operation string                     `json:"operation"`  // 명령어 (예: "tag create")
+	Args      []string                   `json:"args"`       // 명령줄 인자 (같은 실행인지 확인용)
+	StartedAt time.Time                  `json:"started_at"` // 처음 시작한 시각
+	Completed map[string]CheckpointEntry `json:"completed"`  // 성공한 저장소 -> 결과
+}
+
+// CheckpointPath returns the checkpoint file of an operation in the state directory
+func (m *Manager) CheckpointPath(operation string) string {
+	name := strings.NewReplacer(" ", "-", "/", "_", "\\", "_").Replace(operation)
+	return m.StatePath(filepath.Join(CheckpointDirName, name+".json"))
+}
+
+// NewCheckpoint creates an empty checkpoint for a run of the operation
+// Nothing is written until results are added
+func NewCheckpoint(path, operation string, args []string) *Checkpoint {
+	return &Checkpoint{
+		path:      path,
+		Operation: operation,
+		Args:      args,
+		StartedAt: time.Now(),
+		Completed: make(map[string]CheckpointEntry),
+	}
+}
+
+// LoadCheckpoint loads the checkpoint at path
+// Returns nil without an error if there is no checkpoint
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	cp := &Checkpoint{path: path}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint %s: %w", path, err)
+	}
+	if cp.Completed == nil {
+		cp.Completed = make(map[string]CheckpointEntry)
+	}
+	return cp, nil
+}
+
+// SameRun returns true if the checkpoint was written by a run with the same arguments
+func (c *Checkpoint) SameRun(operation string, args []string) bool {
+	if c.Operation != operation || len(c.Args) != len(args) {
+		return false
+	}
+	for i := range args {
+		if c.Args[i] != args[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Has returns true if the repository completed in the checkpointed run
+func (c *Checkpoint) Has(repoName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.Completed[repoName]
+	return ok
+}
+
+// Count returns the number of completed repositories
+func (c *Checkpoint) Count() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.Completed)
+}
+
+// Results returns the recorded results of the named repositories, in the given order
+func (c *Checkpoint) Results(repoNames []string) []Result {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var results []Result
+	for _, name := range repoNames {
+		entry, ok := c.Completed[name]
+		if !ok {
+			continue
+		}
+		result := Result{
+			RepoName: name,
+			Status:   StatusSuccess,
+			Success:  true,
+			Message:  entry.Message,
+			Duration: time.Duration(entry.Seconds * float64(time.Second)),
+			Details:  entry.Details,
+		}
+		if entry.Skipped {
+			result.Status = StatusSkipped
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// Add records a result and writes the checkpoint if CheckpointInterval has passed
+// since the last write. Only successful results are recorded; failed repositories
+// run again on resume. Safe for concurrent use.
+func (c *Checkpoint) Add(result Result) error {
+	if !result.Success {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Completed[result.RepoName] = CheckpointEntry{
+		Message: result.Message,
+		Skipped: result.IsSkipped(),
+		Seconds: result.Duration.Seconds(),
+		Details: result.Details,
+	}
+	c.dirty = true
+	if time.Since(c.savedAt) < CheckpointInterval {
+		return nil
+	}
+	return c.save()
+}
+
+// Flush writes results not written yet
+func (c *Checkpoint) Flush() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	return c.save()
+}
+
+// Remove deletes the checkpoint file, e.g. after the run completed without failures
+func (c *Checkpoint) Remove() error {
+	if err := os.Remove(c.path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove checkpoint: %w", err)
+	}
+	return nil
+}
+
+// save writes the checkpoint atomically; the caller holds c.mu
+func (c *Checkpoint) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode checkpoint: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("failed to create checkpoint directory: %w", err)
+	}
+	// 임시 파일에 쓴 후 교체 (저장 중 중단되어도 이전 체크포인트 유지)
+	tmpPath := c.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmpPath, c.path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	c.savedAt = time.Now()
+	c.dirty = false
+	return nil
+}
@@ -1,6 +1,11 @@
 package repository
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
 
 // ErrorType represents the type of repository operation error
 type ErrorType string
@@ -18,6 +23,7 @@ const (
 	ErrCheckoutFailed  ErrorType = "CHECKOUT_FAILED"
 	ErrPushFailed      ErrorType = "PUSH_FAILED"
 	ErrOperationFailed ErrorType = "OPERATION_FAILED"
+	ErrTimeout         ErrorType = "TIMEOUT"
 )
 
 // RepoError represents an error that occurred during a repository operation
@@ -133,6 +139,53 @@ func ErrLocalChangesError(repoName string) *RepoError {
 	}
 }
 
+// ErrTimeoutError creates a "timed out" error
+func ErrTimeoutError(repoName string, after time.Duration) *RepoError {
+	return &RepoError{
+		Type:     ErrTimeout,
+		RepoName: repoName,
+		Message:  fmt.Sprintf("timed out after %s", after.Round(time.Second)),
+	}
+}
+
+// transientPatterns are error message fragments of failures that may succeed on retry
+var transientPatterns = []string{
+	"timeout",
+	"timed out",
+	"connection refused",
+	"connection reset",
+	"broken pipe",
+	"could not resolve host",
+	"no such host",
+	"temporary failure",
+	"network is unreachable",
+	"tls handshake",
+	"unexpected eof",
+	"502 bad gateway",
+	"503 service unavailable",
+	"504 gateway timeout",
+}
+
+// IsTransientError returns true if the error is likely transient (network problems,
+// timeouts) so that retrying later may succeed. Other errors are hard failures.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var repoErr *RepoError
+	if errors.As(err, &repoErr) && (repoErr.Type == ErrNetworkError || repoErr.Type == ErrTimeout) {
+		return true
+	}
+
+	msg := strings.ToLower(err.Error())
+	for _, pattern := range transientPatterns {
+		if strings.Contains(msg, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsRepoError checks if the error is a RepoError of a specific type
 func IsRepoError(err error, errType ErrorType) bool {
 	if repoErr, ok := err.(*RepoError); ok {
@@ -0,0 +1,143 @@
+package repository
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+)
+
+// Estimate represents the predicted duration of a batch operation
+type Estimate struct {
+	Operation    string        // 작업 이름
+	Repositories int           // 대상 저장소 수
+	Workers      int           // 병렬 작업 수
+	Total        time.Duration // 예상 총 소요 시간 (병렬 처리 반영)
+	Sequential   time.Duration // 순차 처리 시 예상 시간
+	Known        int           // 기록된 시간으로 추정한 저장소 수
+	SizeBased    int           // 저장소 크기로 추정한 저장소 수
+	Unknown      int           // 평균값으로 대체했거나 추정하지 못한 저장소 수
+}
+
+// HasData returns true if any recorded timing contributed to the estimate
+func (e *Estimate) HasData() bool {
+	return e.Known > 0
+}
+
+// String returns a human readable description of the estimate
+func (e *Estimate) String() string {
+	if !e.HasData() {
+		return fmt.Sprintf("No timing history for '%s' yet; run it once to enable estimates", e.Operation)
+	}
+	return fmt.Sprintf("Estimated time for '%s' across %d repositories with %d workers: %s (sequential: %s)\n  based on: %d recorded, %d by size, %d by average",
+		e.Operation, e.Repositories, e.Workers, e.Total.Round(100*time.Millisecond), e.Sequential.Round(100*time.Millisecond),
+		e.Known, e.SizeBased, e.Unknown)
+}
+
+// EstimateDuration predicts how long the operation will take across all repositories
+// Repositories without recorded timings are estimated from their size relative to
+// recorded ones, falling back to the average of recorded timings
+func (m *Manager) EstimateDuration(store *TimingStore, operation string, workers int) *Estimate {
+	if workers < 1 {
+		workers = 1
+	}
+
+	repos := m.config.Repositories
+	estimate := &Estimate{Operation: operation, Repositories: len(repos), Workers: workers}
+	durations := make([]float64, len(repos))
+	known := make([]bool, len(repos))
+	sizes := make([]int64, len(repos))
+
+	// 1. 기록된 시간 및 저장소 크기 수집
+	var knownSeconds float64
+	var knownSecondsWithSize float64
+	var knownBytes int64
+	for i, repo := range repos {
+		sizes[i] = m.RepositorySize(repo)
+		if entry, ok := store.Get(operation, repo.Name); ok {
+			durations[i] = entry.AvgSeconds
+			known[i] = true
+			knownSeconds += entry.AvgSeconds
+			estimate.Known++
+			if sizes[i] > 0 {
+				knownSecondsWithSize += entry.AvgSeconds
+				knownBytes += sizes[i]
+			}
+		}
+	}
+
+	// 2. 기록이 없는 저장소 추정
+	var average float64
+	if estimate.Known > 0 {
+		average = knownSeconds / float64(estimate.Known)
+	}
+	var secondsPerByte float64
+	if knownBytes > 0 {
+		secondsPerByte = knownSecondsWithSize / float64(knownBytes)
+	}
+
+	for i := range repos {
+		if known[i] {
+			continue
+		}
+		if sizes[i] > 0 && secondsPerByte > 0 {
+			durations[i] = float64(sizes[i]) * secondsPerByte
+			estimate.SizeBased++
+		} else {
+			durations[i] = average
+			estimate.Unknown++
+		}
+	}
+
+	// 3. 병렬 스케줄링 시뮬레이션 (설정 순서대로 가장 먼저 비는 worker에 할당)
+	loads := make([]float64, workers)
+	var sequential float64
+	for _, d := range durations {
+		sequential += d
+		minIdx := 0
+		for w := 1; w < workers; w++ {
+			if loads[w] < loads[minIdx] {
+				minIdx = w
+			}
+		}
+		loads[minIdx] += d
+	}
+
+	var makespan float64
+	for _, load := range loads {
+		if load > makespan {
+			makespan = load
+		}
+	}
+
+	estimate.Total = time.Duration(makespan * float64(time.Second))
+	estimate.Sequential = time.Duration(sequential * float64(time.Second))
+	return estimate
+}
+
+// RepositorySize returns the size in bytes of the repository's git directory
+// For a linked worktree, the shared git directory of the main working tree is measured.
+// Returns 0 if the repository does not exist
+func (m *Manager) RepositorySize(repo config.Repository) int64 {
+	gitDir := GitDir(m.GetRepositoryPath(repo))
+	if gitDir == "" {
+		return 0
+	}
+	gitDir = GitCommonDir(gitDir)
+
+	var size int64
+	_ = filepath.WalkDir(gitDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !d.IsDir() {
+			if info, err := d.Info(); err == nil {
+				size += info.Size()
+			}
+		}
+		return nil
+	})
+	return size
+}
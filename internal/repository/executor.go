@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
@@ -9,8 +10,31 @@ import (
 )
 
 // TaskFunc represents a function that performs an operation on a single repository
-// It receives the repository config and returns a Result
-type TaskFunc func(repo config.Repository) Result
+// It receives the repository config and returns a Result. A non-nil error marks the
+// result failed with that error, so a task can return its partial result (e.g. the
+// steps completed so far) together with the error that stopped it.
+type TaskFunc func(repo config.Repository) (Result, error)
+
+// Run runs the task on a repository and returns its result with the error merged in
+// A failed result gets the repository name and the elapsed time if the task left them
+// unset, and Status is filled in from Success if the task did not set it.
+func (task TaskFunc) Run(repo config.Repository) Result {
+	startTime := time.Now()
+	result, err := task(repo)
+	if result.RepoName == "" {
+		result.RepoName = repo.Name
+	}
+	if err != nil {
+		result.Success = false
+		result.Status = StatusFailed
+		result.Error = err
+	}
+	result.normalize()
+	if !result.Success && result.Duration == 0 {
+		result.Duration = time.Since(startTime)
+	}
+	return result
+}
 
 // Execute runs the task on all repositories
 // It automatically chooses parallel or sequential execution based on ParallelWorkers config
@@ -21,20 +45,33 @@ func (m *Manager) Execute(ctx context.Context, task TaskFunc, onProgress func())
 	return m.ExecuteSequential(ctx, task, onProgress)
 }
 
+// ErrFailFast is the cancellation cause of repositories skipped after a failure with fail-fast enabled
+var ErrFailFast = errors.New("skipped after an earlier failure (fail-fast)")
+
 // ExecuteSequential runs the task on all repositories sequentially
 func (m *Manager) ExecuteSequential(ctx context.Context, task TaskFunc, onProgress func()) *Summary {
 	startTime := time.Now()
 	results := make([]Result, 0, len(m.config.Repositories))
 
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	for _, repo := range m.config.Repositories {
 		// Check for context cancellation before processing each repository
-		// If context is cancelled, stop processing immediately
+		// If context is cancelled, the remaining repositories are reported as cancelled
 		if ctx.Err() != nil {
-			break
+			results = append(results, cancelledResult(ctx, repo))
+			if onProgress != nil {
+				onProgress()
+			}
+			continue
 		}
 
-		result := task(repo)
+		result := m.runTask(ctx, task, repo)
 		results = append(results, result)
+		if m.failFast && !result.Success && !result.IsCancelled() {
+			cancel(ErrFailFast)
+		}
 
 		if onProgress != nil {
 			onProgress()
@@ -55,6 +92,9 @@ func (m *Manager) ExecuteParallel(ctx context.Context, task TaskFunc, onProgress
 		return NewSummary([]Result{}, time.Since(startTime))
 	}
 
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
 	// Create channels
 	jobs := make(chan config.Repository, numRepos)
 	resultsChan := make(chan Result, numRepos)
@@ -75,17 +115,19 @@ func (m *Manager) ExecuteParallel(ctx context.Context, task TaskFunc, onProgress
 				// Check for context cancellation
 				select {
 				case <-ctx.Done():
-					resultsChan <- Result{
-						RepoName: repo.Name,
-						Success:  false,
-						Error:    ctx.Err(),
+					resultsChan <- cancelledResult(ctx, repo)
+					if onProgress != nil {
+						onProgress()
 					}
 					continue
 				default:
 				}
 
-				result := task(repo)
+				result := m.runTask(ctx, task, repo)
 				resultsChan <- result
+				if m.failFast && !result.Success && !result.IsCancelled() {
+					cancel(ErrFailFast)
+				}
 
 				if onProgress != nil {
 					onProgress()
@@ -114,3 +156,69 @@ func (m *Manager) ExecuteParallel(ctx context.Context, task TaskFunc, onProgress
 
 	return NewSummary(results, time.Since(startTime))
 }
+
+// abandonWait is how long a task that ran out of time or was cancelled gets to stop
+// after its context is cancelled, before its worker moves on to the next repository
+const abandonWait = 5 * time.Second
+
+// runTask runs the task on a repository until it finishes, the repository timeout or the
+// deadline of ctx passes, or the task is cancelled with CancelTask. A repository that runs
+// out of time is reported with an ErrTimeout error, a cancelled one as cancelled. Either way
+// the task context (TaskContext) is cancelled, interrupting its shell commands and clones,
+// and the task gets up to abandonWait to stop; a task that does not watch its context keeps
+// running in the background and its result is discarded. Cancellation without a deadline
+// (e.g. fail-fast) waits for the task to finish. With a rate limit, the task starts when the
+// limiter allows it; the wait does not count towards the repository timeout.
+func (m *Manager) runTask(ctx context.Context, task TaskFunc, repo config.Repository) Result {
+	if err := m.limiter.wait(ctx); err != nil {
+		return cancelledResult(ctx, repo)
+	}
+
+	// 저장소별 제한 시간과 실행 전체의 deadline 중 이른 쪽
+	startTime := time.Now()
+	deadline, _ := ctx.Deadline()
+	if m.repoTimeout > 0 && (deadline.IsZero() || startTime.Add(m.repoTimeout).Before(deadline)) {
+		deadline = startTime.Add(m.repoTimeout)
+	}
+	timeout := ErrTimeoutError(repo.Name, deadline.Sub(startTime))
+
+	taskCtx := m.tasks.start(ctx, repo.Name, deadline, timeout)
+	defer m.tasks.finish(repo.Name)
+
+	done := make(chan Result, 1)
+	go func() {
+		done <- task.Run(repo)
+	}()
+
+	var result Result
+	select {
+	case result = <-done:
+		return result
+	case <-taskCtx.Done():
+	}
+
+	elapsed := time.Since(startTime)
+	if cause := context.Cause(taskCtx); errors.Is(cause, ErrTaskCancelled) {
+		result = Result{RepoName: repo.Name, Status: StatusCancelled, Error: cause, Duration: elapsed}
+	} else {
+		result = Result{RepoName: repo.Name, Status: StatusTimedOut, Error: ErrTimeoutError(repo.Name, elapsed), Duration: elapsed}
+	}
+
+	// 중단된 작업이 끝날 때까지 잠시 대기 (동시 실행 수가 --parallel을 넘지 않도록)
+	select {
+	case <-done:
+	case <-time.After(abandonWait):
+	}
+	return result
+}
+
+// cancelledResult builds the result of a repository that was not started
+// because the run was cancelled
+func cancelledResult(ctx context.Context, repo config.Repository) Result {
+	return Result{
+		RepoName: repo.Name,
+		Status:   StatusCancelled,
+		Success:  false,
+		Error:    context.Cause(ctx),
+	}
+}
@@ -3,13 +3,19 @@ package repository
 import (
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/alexgim961101/multi-git/internal/config"
 )
 
 // Manager handles operations across multiple repositories
 type Manager struct {
-	config *config.Config // 설정 정보
+	config      *config.Config // 설정 정보
+	failFast    bool           // 첫 실패 후 나머지 저장소 취소
+	repoTimeout time.Duration  // 저장소별 제한 시간 (0 = 제한 없음)
+	tasks       runningTasks   // 실행 중인 저장소 작업 (개별 취소용)
+	limiter     *rateLimiter   // 초당 작업 시작 수 제한 (nil = 제한 없음)
 }
 
 // NewManager creates a new repository manager with the given configuration
@@ -24,6 +30,18 @@ func (m *Manager) Config() *config.Config {
 	return m.config
 }
 
+// SetFailFast makes the executors stop after the first failed repository
+// Repositories that were not started yet are reported as cancelled
+func (m *Manager) SetFailFast(failFast bool) {
+	m.failFast = failFast
+}
+
+// SetRepoTimeout limits how long the executors wait for a single repository
+// A repository that exceeds it is reported with an ErrTimeout error; 0 disables the limit
+func (m *Manager) SetRepoTimeout(timeout time.Duration) {
+	m.repoTimeout = timeout
+}
+
 // Repositories returns the list of repositories from configuration
 func (m *Manager) Repositories() []config.Repository {
 	return m.config.Repositories
@@ -66,9 +84,56 @@ func (m *Manager) RepositoryExists(repo config.Repository) bool {
 
 // IsGitRepository checks if the path is a valid Git repository
 func (m *Manager) IsGitRepository(repo config.Repository) bool {
-	path := m.GetRepositoryPath(repo)
-	gitDir := filepath.Join(path, ".git")
-	return DirectoryExists(gitDir)
+	return GitDir(m.GetRepositoryPath(repo)) != ""
+}
+
+// GitDir returns the git directory of the working tree at path: its .git directory, or
+// the directory a .git file points to ("gitdir: <path>", as in linked worktrees and
+// submodules). Returns "" if path is not a git working tree.
+func GitDir(path string) string {
+	dotGit := filepath.Join(path, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return ""
+	}
+	if info.IsDir() {
+		return dotGit
+	}
+
+	data, err := os.ReadFile(dotGit)
+	if err != nil {
+		return ""
+	}
+	target, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return ""
+	}
+	target = filepath.FromSlash(strings.TrimSpace(target))
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(path, target)
+	}
+	if !DirectoryExists(target) {
+		return ""
+	}
+	return target
+}
+
+// GitCommonDir returns the directory holding the objects and refs shared by all
+// worktrees of the repository (the main .git directory for a linked worktree)
+// Returns gitDir itself if it has no commondir file.
+func GitCommonDir(gitDir string) string {
+	data, err := os.ReadFile(filepath.Join(gitDir, "commondir"))
+	if err != nil {
+		return gitDir
+	}
+	common := filepath.FromSlash(strings.TrimSpace(string(data)))
+	if !filepath.IsAbs(common) {
+		common = filepath.Join(gitDir, common)
+	}
+	if !DirectoryExists(common) {
+		return gitDir
+	}
+	return filepath.Clean(common)
 }
 
 // DirectoryExists checks if a directory exists
@@ -80,8 +145,26 @@ func DirectoryExists(path string) bool {
 	return info.IsDir()
 }
 
-// EnsureBaseDir creates the base directory if it doesn't exist
-func (m *Manager) EnsureBaseDir() error {
-	return os.MkdirAll(m.config.BaseDir, 0755)
+// StatePath returns the path of a state file stored next to the config file
+func (m *Manager) StatePath(name string) string {
+	return filepath.Join(m.config.ConfigDir, name)
+}
+
+// FindRepository returns the repository with the given name
+func (m *Manager) FindRepository(name string) (config.Repository, bool) {
+	for _, repo := range m.config.Repositories {
+		if repo.Name == name {
+			return repo, true
+		}
+	}
+	return config.Repository{}, false
 }
 
+// RepositoryNames returns the names of all repositories
+func (m *Manager) RepositoryNames() []string {
+	names := make([]string, 0, len(m.config.Repositories))
+	for _, repo := range m.config.Repositories {
+		names = append(names, repo.Name)
+	}
+	return names
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces the starts of tasks evenly at a maximum rate
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration // 작업 시작 간격 (1초 / 초당 작업 수)
+	next     time.Time     // 다음 작업을 시작할 수 있는 시각
+}
+
+// newRateLimiter returns a limiter for the rate, or nil if the rate is not positive
+func newRateLimiter(opsPerSecond float64) *rateLimiter {
+	if opsPerSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / opsPerSecond)}
+}
+
+// wait blocks until the next task may start or ctx is done
+// A nil limiter never waits.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	// 시작 시각 예약 (동시에 기다리는 작업은 간격만큼 차례로 밀림)
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SetRateLimit limits how many tasks the executors start per second, spaced evenly
+// (e.g. 2 starts a task every 500ms), so network operations do not trip the abuse
+// protection of a Git server. 0 disables the limit.
+func (m *Manager) SetRateLimit(opsPerSecond float64) {
+	m.limiter = newRateLimiter(opsPerSecond)
+}
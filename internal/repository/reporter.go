@@ -5,20 +5,84 @@ import (
 	"io"
 	"os"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/schollz/progressbar/v3"
 )
 
 // Reporter handles formatting and printing of operation results
 type Reporter struct {
-	out     io.Writer // 출력 대상 (기본: os.Stdout)
-	verbose bool      // 상세 출력 여부
+	out      io.Writer                // 출력 대상 (기본: os.Stdout)
+	verbose  bool                     // 상세 출력 여부
+	progress bool                     // 진행 표시줄 사용 여부 (기본: true)
+	bar      *progressbar.ProgressBar // 진행 중인 표시줄 (없으면 nil)
+	barLabel string                   // 진행 표시줄 설명
+	barMu    sync.Mutex               // bar 보호 (제한 시간을 넘긴 작업이 늦게 Tick할 수 있음)
+	stream   *streamState             // 실시간 출력 (비활성화 시 nil)
+	timing   bool                     // 요약에 소요 시간 분석 포함 (--timing)
 }
 
 // NewReporter creates a new reporter with default settings
 func NewReporter() *Reporter {
 	return &Reporter{
-		out:     os.Stdout,
-		verbose: false,
+		out:      os.Stdout,
+		verbose:  false,
+		progress: true,
+	}
+}
+
+// SetProgress enables or disables the progress bar
+func (r *Reporter) SetProgress(enabled bool) {
+	r.progress = enabled
+}
+
+// SetTiming adds the timing breakdown (percentiles, slowest repositories, steps) to the summary
+func (r *Reporter) SetTiming(enabled bool) {
+	r.timing = enabled
+}
+
+// StartProgress shows a progress bar on stderr for total repositories
+// Does nothing if progress is disabled
+func (r *Reporter) StartProgress(total int, description string) {
+	if !r.progress || total <= 0 {
+		return
 	}
+	r.barLabel = description
+	r.bar = progressbar.NewOptions64(
+		int64(total),
+		progressbar.OptionSetDescription(description),
+		progressbar.OptionSetWriter(os.Stderr),
+		progressbar.OptionShowCount(),
+		progressbar.OptionSetWidth(10),
+		progressbar.OptionThrottle(65*time.Millisecond),
+		progressbar.OptionSpinnerType(14),
+		progressbar.OptionFullWidth(),
+		progressbar.OptionClearOnFinish(),
+	)
+}
+
+// Tick advances the progress bar after a repository has finished
+// Safe for concurrent use
+func (r *Reporter) Tick(repo string) {
+	r.barMu.Lock()
+	defer r.barMu.Unlock()
+	if r.bar == nil {
+		return
+	}
+	r.bar.Describe(fmt.Sprintf("%s %s", r.barLabel, repo))
+	_ = r.bar.Add(1)
+}
+
+// FinishProgress removes the progress bar before results are printed
+func (r *Reporter) FinishProgress() {
+	r.barMu.Lock()
+	defer r.barMu.Unlock()
+	if r.bar == nil {
+		return
+	}
+	_ = r.bar.Finish()
+	r.bar = nil
 }
 
 // SetOutput sets the output writer
@@ -34,6 +98,13 @@ func (r *Reporter) SetVerbose(verbose bool) {
 // PrintResult prints a single result
 func (r *Reporter) PrintResult(result Result) {
 	fmt.Fprintln(r.out, "  "+result.String())
+	if details := result.DetailsString(); details != "" {
+		fmt.Fprintln(r.out, "    "+details)
+	}
+	// 단계는 실패했거나 verbose일 때만 표시
+	if steps := result.StepsString(); steps != "" && (!result.Success || r.verbose) {
+		fmt.Fprintln(r.out, "    steps: "+steps)
+	}
 }
 
 // PrintResults prints all results
@@ -48,11 +119,74 @@ func (r *Reporter) PrintSummary(summary *Summary) {
 	fmt.Fprintln(r.out)
 	fmt.Fprintln(r.out, "Summary:")
 	fmt.Fprintf(r.out, "  Success: %d\n", summary.SuccessCount)
-	fmt.Fprintf(r.out, "  Failed:  %d\n", summary.FailedCount)
+	failed := fmt.Sprintf("  Failed:  %d", summary.FailedCount)
+	switch {
+	case summary.TimedOutCount > 0 && summary.TransientCount > 0:
+		failed += fmt.Sprintf(" (%d transient, %d timed out)", summary.TransientCount, summary.TimedOutCount)
+	case summary.TransientCount > 0:
+		failed += fmt.Sprintf(" (%d transient)", summary.TransientCount)
+	}
+	fmt.Fprintln(r.out, failed)
 	if summary.SkippedCount > 0 {
 		fmt.Fprintf(r.out, "  Skipped: %d\n", summary.SkippedCount)
 	}
+	if summary.CancelledCount > 0 {
+		fmt.Fprintf(r.out, "  Cancelled: %d\n", summary.CancelledCount)
+	}
 	fmt.Fprintf(r.out, "  Total time: %.2fs\n", summary.TotalDuration.Seconds())
+	if r.timing {
+		r.printTiming(summary)
+	}
+}
+
+// slowestShown is the number of slowest repositories in the timing breakdown
+const slowestShown = 5
+
+// printTiming prints the duration percentiles of the repositories, the slowest
+// repositories with their steps, and the percentiles of each step
+func (r *Reporter) printTiming(summary *Summary) {
+	slowest := summary.Slowest(slowestShown)
+	if len(slowest) == 0 {
+		return
+	}
+
+	fmt.Fprintln(r.out)
+	fmt.Fprintln(r.out, "Timing:")
+	fmt.Fprintf(r.out, "  Repositories: p50 %.2fs, p95 %.2fs, max %.2fs\n",
+		summary.P50Duration.Seconds(), summary.P95Duration.Seconds(), slowest[0].Duration.Seconds())
+
+	width := 0
+	for _, result := range slowest {
+		width = max(width, len(result.RepoName))
+	}
+	fmt.Fprintln(r.out, "  Slowest:")
+	for _, result := range slowest {
+		line := fmt.Sprintf("    %-*s  %7.2fs", width, result.RepoName, result.Duration.Seconds())
+		var steps []string
+		for _, step := range result.Steps {
+			if step.Status != StepSkipped {
+				steps = append(steps, fmt.Sprintf("%s %.2fs", step.Name, step.Duration.Seconds()))
+			}
+		}
+		if len(steps) > 0 {
+			line += "  (" + strings.Join(steps, ", ") + ")"
+		}
+		fmt.Fprintln(r.out, line)
+	}
+
+	timings := summary.StepTimings()
+	if len(timings) == 0 {
+		return
+	}
+	width = 0
+	for _, timing := range timings {
+		width = max(width, len(timing.Name))
+	}
+	fmt.Fprintln(r.out, "  Steps:")
+	for _, timing := range timings {
+		fmt.Fprintf(r.out, "    %-*s  p50 %.2fs, p95 %.2fs, max %.2fs (%s)\n", width, timing.Name,
+			timing.P50.Seconds(), timing.P95.Seconds(), timing.Max.Seconds(), timing.MaxRepo)
+	}
 }
 
 // PrintFailedDetails prints detailed information about failed operations
@@ -66,6 +200,9 @@ func (r *Reporter) PrintFailedDetails(summary *Summary) {
 	fmt.Fprintln(r.out, "Failed repositories:")
 	for _, result := range failed {
 		fmt.Fprintf(r.out, "  ✗ %s\n", result.RepoName)
+		if step := result.FailedStep(); step != nil {
+			fmt.Fprintf(r.out, "    Failed step: %s\n", step.Name)
+		}
 		if result.Error != nil {
 			fmt.Fprintf(r.out, "    Error: %v\n", result.Error)
 		}
@@ -82,8 +219,10 @@ func (r *Reporter) PrintHeader(operation string, details ...string) {
 
 // PrintFullReport prints results, summary, and failed details
 func (r *Reporter) PrintFullReport(summary *Summary) {
-	// Print individual results
-	r.PrintResults(summary.Results)
+	// Print individual results (already printed as they finished when streaming)
+	if r.stream == nil {
+		r.PrintResults(summary.Results)
+	}
 
 	// Print summary
 	r.PrintSummary(summary)
@@ -96,13 +235,28 @@ func (r *Reporter) PrintFullReport(summary *Summary) {
 
 // PrintFullReportWithOutput prints results with detailed output for exec command
 func (r *Reporter) PrintFullReportWithOutput(summary *Summary) {
-	for _, result := range summary.Results {
+	r.PrintResultsWithOutput(summary.Results)
+
+	// Print summary
+	r.PrintSummary(summary)
+}
+
+// PrintResultsWithOutput prints each result under a "=== repo ===" heading with its full output
+func (r *Reporter) PrintResultsWithOutput(results []Result) {
+	for _, result := range results {
 		fmt.Fprintf(r.out, "\n=== %s ===\n", result.RepoName)
+		if result.IsCancelled() || result.IsSkipped() || result.Status == StatusTimedOut {
+			fmt.Fprintf(r.out, "  %s\n", result.String())
+			continue
+		}
 		if result.Message != "" {
 			fmt.Fprintln(r.out, result.Message)
 		}
 		if result.Success {
 			fmt.Fprintf(r.out, "  ✓ %s (%.2fs)\n", result.RepoName, result.Duration.Seconds())
+			if details := result.DetailsString(); details != "" {
+				fmt.Fprintf(r.out, "    %s\n", details)
+			}
 		} else {
 			fmt.Fprintf(r.out, "  ✗ %s (%.2fs)\n", result.RepoName, result.Duration.Seconds())
 			if result.Error != nil {
@@ -110,9 +264,6 @@ func (r *Reporter) PrintFullReportWithOutput(summary *Summary) {
 			}
 		}
 	}
-
-	// Print summary
-	r.PrintSummary(summary)
 }
 
 // PrintProgress prints progress information (for real-time updates)
@@ -2,35 +2,128 @@ package repository
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 )
 
+// Status is the outcome of a single repository operation
+type Status string
+
+const (
+	StatusSuccess   Status = "success"   // 작업 성공
+	StatusFailed    Status = "failed"    // 작업 실패 (Error에 원인)
+	StatusSkipped   Status = "skipped"   // 할 일이 없어 건너뜀 (Message에 이유)
+	StatusCancelled Status = "cancelled" // 취소됨 (fail-fast, 중단 등, Error에 원인, 실패에 포함하지 않음)
+	StatusTimedOut  Status = "timed_out" // 저장소별 제한 시간 초과 (일시적 실패로 실패에 포함)
+)
+
 // Result represents the result of a single repository operation
+// Tasks set Status for outcomes other than success and failure (see Skip); when it is
+// empty, TaskFunc.Run and NewSummary fill it in from Success.
 type Result struct {
-	RepoName  string        // 저장소 이름
-	Success   bool          // 성공 여부
-	Error     error         // 에러 (실패 시)
-	Duration  time.Duration // 소요 시간
-	Message   string        // 추가 메시지 (선택적)
+	RepoName string         // 저장소 이름
+	Status   Status         // 결과 상태
+	Success  bool           // 성공 여부 (성공 또는 스킵)
+	Error    error          // 에러 (실패 시)
+	Duration time.Duration  // 소요 시간
+	Message  string         // 추가 메시지 (선택적)
+	Details  map[string]any // 구조화된 추가 정보 (예: commits, files_changed, tag_sha)
+	Steps    []Step         // 여러 단계 작업의 단계별 결과 (예: checkout, create-tag, push)
 }
 
 // Summary represents the aggregated results of operations across all repositories
 type Summary struct {
-	TotalCount   int           // 전체 저장소 개수
-	SuccessCount int           // 성공한 저장소 개수
-	FailedCount  int           // 실패한 저장소 개수
-	SkippedCount int           // 스킵된 저장소 개수
-	TotalDuration time.Duration // 총 소요 시간
-	Results      []Result      // 개별 결과 목록
+	TotalCount     int           // 전체 저장소 개수
+	SuccessCount   int           // 성공한 저장소 개수
+	FailedCount    int           // 실패한 저장소 개수
+	SkippedCount   int           // 스킵된 저장소 개수
+	CancelledCount int           // 취소된 저장소 개수 (실패에 포함하지 않음)
+	TimedOutCount  int           // 실패 중 제한 시간을 넘긴 저장소 개수
+	TransientCount int           // 실패 중 일시적 실패 개수 (네트워크, 제한 시간)
+	TotalDuration  time.Duration // 총 소요 시간
+	P50Duration    time.Duration // 실행된 저장소 소요 시간의 중앙값 (스킵, 취소 제외)
+	P95Duration    time.Duration // 실행된 저장소 소요 시간의 95번째 백분위
+	Results        []Result      // 개별 결과 목록
+}
+
+// Skip marks the result as skipped because there was nothing to do, with the reason
+func (r *Result) Skip(message string) {
+	r.Status = StatusSkipped
+	r.Success = true
+	r.Message = message
+}
+
+// normalize fills in Status from Success if the task did not set it, and keeps
+// Success consistent with an explicit Status
+func (r *Result) normalize() {
+	switch r.Status {
+	case "":
+		r.Status = StatusFailed
+		if r.Success {
+			r.Status = StatusSuccess
+		}
+	case StatusSuccess, StatusSkipped:
+		r.Success = true
+	default:
+		r.Success = false
+	}
 }
 
 // IsSkipped returns true if this result represents a skipped operation
 func (r *Result) IsSkipped() bool {
-	return r.Success && r.Duration == 0 && r.Message != ""
+	return r.Status == StatusSkipped
+}
+
+// IsCancelled returns true if the repository was cancelled before or while running
+func (r *Result) IsCancelled() bool {
+	return r.Status == StatusCancelled
+}
+
+// IsTransientFailure returns true if the result failed with a transient error
+// (network problems, timeouts) that may succeed on retry
+func (r *Result) IsTransientFailure() bool {
+	return !r.Success && !r.IsCancelled() && (r.Status == StatusTimedOut || IsTransientError(r.Error))
+}
+
+// SetDetail records a structured detail of the result (e.g. "commits": 3)
+// Details are shown by the reporter and serialized by the HTTP API
+func (r *Result) SetDetail(key string, value any) {
+	if r.Details == nil {
+		r.Details = make(map[string]any)
+	}
+	r.Details[key] = value
+}
+
+// DetailsString returns the details as "key: value" pairs sorted by key
+// Returns "" if the result has no details
+func (r *Result) DetailsString() string {
+	if len(r.Details) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(r.Details))
+	for key := range r.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s: %v", key, r.Details[key]))
+	}
+	return strings.Join(pairs, ", ")
 }
 
 // String returns a string representation of the result
 func (r *Result) String() string {
+	switch r.Status {
+	case StatusCancelled:
+		return fmt.Sprintf("⊘ %s - %v", r.RepoName, r.Error)
+	case StatusSkipped:
+		return fmt.Sprintf("↷ %s: %s", r.RepoName, r.Message)
+	case StatusTimedOut:
+		return fmt.Sprintf("⏱ %s (%.2fs) - %v", r.RepoName, r.Duration.Seconds(), r.Error)
+	}
 	if r.Success {
 		if r.Message != "" {
 			return fmt.Sprintf("✓ %s: %s (%.2fs)", r.RepoName, r.Message, r.Duration.Seconds())
@@ -48,26 +141,80 @@ func NewSummary(results []Result, totalDuration time.Duration) *Summary {
 		Results:       results,
 	}
 
-	for _, r := range results {
-		if r.Success {
-			if r.IsSkipped() {
-				summary.SkippedCount++
-			} else {
-				summary.SuccessCount++
-			}
-		} else {
+	for i := range results {
+		r := &results[i]
+		r.normalize()
+		switch r.Status {
+		case StatusSuccess:
+			summary.SuccessCount++
+		case StatusSkipped:
+			summary.SkippedCount++
+		case StatusCancelled:
+			summary.CancelledCount++
+		default:
 			summary.FailedCount++
+			if r.Status == StatusTimedOut {
+				summary.TimedOutCount++
+			}
+			if r.IsTransientFailure() {
+				summary.TransientCount++
+			}
 		}
 	}
 
+	var durations []time.Duration
+	for _, r := range summary.TimedResults() {
+		durations = append(durations, r.Duration)
+	}
+	summary.P50Duration, summary.P95Duration = durationPercentiles(durations)
+
 	return summary
 }
 
-// FailedResults returns only the failed results
+// Exit codes of batch commands
+const (
+	ExitFailure     = 1  // 하드 실패 (에러 예산 초과)
+	ExitConfigError = 2  // 설정 파일을 불러오지 못함 (저장소 작업 실행 안 함)
+	ExitCancelled   = 3  // 실패 없이 일부 저장소가 취소됨 (중단, 제한 시간)
+	ExitTransient   = 75 // 일시적 실패만 (EX_TEMPFAIL, 다시 시도하면 성공할 수 있음)
+)
+
+// HardFailureCount returns the number of failures that are not transient
+func (s *Summary) HardFailureCount() int {
+	return s.FailedCount - s.TransientCount
+}
+
+// ExitCode returns the process exit code for the summary
+// With an error budget (budget >= 0), the run fails only if the hard failures exceed
+// the budget; transient failures never fail it. Without a budget (budget < 0), any hard
+// failure returns ExitFailure and transient failures alone return ExitTransient.
+// A run without (counted) failures whose remaining repositories were cancelled
+// returns ExitCancelled, since it did not cover every repository.
+func (s *Summary) ExitCode(budget int) int {
+	hard := s.HardFailureCount()
+	switch {
+	case !s.HasFailures():
+	case budget >= 0:
+		if hard > budget {
+			return ExitFailure
+		}
+	case hard > 0:
+		return ExitFailure
+	default:
+		return ExitTransient
+	}
+
+	if s.CancelledCount > 0 {
+		return ExitCancelled
+	}
+	return 0
+}
+
+// FailedResults returns only the failed results (excluding cancelled)
 func (s *Summary) FailedResults() []Result {
 	var failed []Result
 	for _, r := range s.Results {
-		if !r.Success {
+		if !r.Success && !r.IsCancelled() {
 			failed = append(failed, r)
 		}
 	}
@@ -78,7 +225,7 @@ func (s *Summary) FailedResults() []Result {
 func (s *Summary) SuccessfulResults() []Result {
 	var successful []Result
 	for _, r := range s.Results {
-		if r.Success && !r.IsSkipped() {
+		if r.Status == StatusSuccess {
 			successful = append(successful, r)
 		}
 	}
@@ -96,6 +243,17 @@ func (s *Summary) SkippedResults() []Result {
 	return skipped
 }
 
+// CancelledResults returns only the results of repositories that were never started
+func (s *Summary) CancelledResults() []Result {
+	var cancelled []Result
+	for _, r := range s.Results {
+		if r.IsCancelled() {
+			cancelled = append(cancelled, r)
+		}
+	}
+	return cancelled
+}
+
 // HasFailures returns true if there are any failed results
 func (s *Summary) HasFailures() bool {
 	return s.FailedCount > 0
@@ -103,7 +261,6 @@ func (s *Summary) HasFailures() bool {
 
 // String returns a string representation of the summary
 func (s *Summary) String() string {
-	return fmt.Sprintf("Summary:\n  Success: %d\n  Failed: %d\n  Skipped: %d\n  Total time: %.2fs",
-		s.SuccessCount, s.FailedCount, s.SkippedCount, s.TotalDuration.Seconds())
+	return fmt.Sprintf("Summary:\n  Success: %d\n  Failed: %d\n  Timed out: %d\n  Skipped: %d\n  Cancelled: %d\n  Total time: %.2fs",
+		s.SuccessCount, s.FailedCount, s.TimedOutCount, s.SkippedCount, s.CancelledCount, s.TotalDuration.Seconds())
 }
-
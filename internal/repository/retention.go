@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// StateArtifact is a file or directory of run state (e.g. a run log, run report,
+// snapshot, checkpoint, or cached API response) that retention may remove
+type StateArtifact struct {
+	Kind    string    // 종류 (예: logs, reports, snapshots, checkpoints, provider_cache)
+	Name    string    // 상태 디렉토리 기준 경로 (예: logs/20240612-153012.000-sync)
+	Path    string    // 절대 경로
+	ModTime time.Time // 마지막 수정 시각 (디렉토리면 가장 최근 파일)
+	Size    int64     // 크기 (디렉토리면 포함된 파일 합계)
+}
+
+// ListStateArtifacts returns the entries of a state directory accepted by match,
+// oldest first. Returns an empty list if the directory does not exist.
+func (m *Manager) ListStateArtifacts(kind string, match func(entry fs.DirEntry) bool) ([]StateArtifact, error) {
+	dir := m.StatePath(kind)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var artifacts []StateArtifact
+	for _, entry := range entries {
+		if !match(entry) {
+			continue
+		}
+		artifact := StateArtifact{
+			Kind: kind,
+			Name: filepath.Join(kind, entry.Name()),
+			Path: filepath.Join(dir, entry.Name()),
+		}
+		// 디렉토리는 포함된 파일의 크기 합계와 가장 최근 수정 시각 사용
+		err := filepath.WalkDir(artifact.Path, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() {
+				artifact.Size += info.Size()
+			}
+			if info.ModTime().After(artifact.ModTime) {
+				artifact.ModTime = info.ModTime()
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, artifact)
+	}
+
+	sort.SliceStable(artifacts, func(i, j int) bool {
+		return artifacts[i].ModTime.Before(artifacts[j].ModTime)
+	})
+	return artifacts, nil
+}
+
+// ExpiredArtifacts returns the artifacts retention removes: those last modified
+// before cutoff, then the oldest of the rest until they fit in maxSize.
+// A zero cutoff or maxSize disables that limit.
+func ExpiredArtifacts(artifacts []StateArtifact, cutoff time.Time, maxSize int64) []StateArtifact {
+	sorted := append([]StateArtifact(nil), artifacts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ModTime.Before(sorted[j].ModTime)
+	})
+
+	var total int64
+	for _, artifact := range sorted {
+		total += artifact.Size
+	}
+
+	var expired []StateArtifact
+	for _, artifact := range sorted {
+		tooOld := !cutoff.IsZero() && artifact.ModTime.Before(cutoff)
+		tooBig := maxSize > 0 && total > maxSize
+		if !tooOld && !tooBig {
+			break
+		}
+		expired = append(expired, artifact)
+		total -= artifact.Size
+	}
+	return expired
+}
+
+// Prune removes the timings last updated before cutoff
+// Returns the number of removed entries.
+func (s *TimingStore) Prune(cutoff time.Time) int {
+	removed := 0
+	for operation, repos := range s.Operations {
+		for name, entry := range repos {
+			if entry.UpdatedAt.Before(cutoff) {
+				delete(repos, name)
+				removed++
+			}
+		}
+		if len(repos) == 0 {
+			delete(s.Operations, operation)
+		}
+	}
+	return removed
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SnapshotDirName is the directory next to the config file that holds snapshots
+const SnapshotDirName = "snapshots"
+
+// AutoSnapshotPrefix starts the names of the snapshots recorded automatically
+// before destructive operations (e.g. auto-20240612-153012)
+const AutoSnapshotPrefix = "auto-"
+
+// SnapshotEntry is the recorded state of one repository
+type SnapshotEntry struct {
+	Branch string        `json:"branch,omitempty"` // 체크아웃된 브랜치 (detached HEAD면 비어있음)
+	Commit string        `json:"commit"`           // HEAD 커밋 SHA
+	Refs   []SnapshotRef `json:"refs,omitempty"`   // 작업이 덮어쓰기 전의 참조 값 (자동 스냅샷)
+}
+
+// SnapshotRef is the value a reference had before a destructive operation overwrote it
+type SnapshotRef struct {
+	Remote string `json:"remote,omitempty"` // 원격 이름 (로컬 참조면 비어있음)
+	Ref    string `json:"ref"`              // 참조 이름 (예: refs/heads/release, refs/tags/v1.2.0)
+	Hash   string `json:"hash,omitempty"`   // 이전 값 (참조가 없었으면 비어있음)
+	Keep   string `json:"keep,omitempty"`   // 이전 객체를 보존하는 로컬 참조 (gc 방지)
+}
+
+// Snapshot is a lockfile of the commit and branch every repository was at, so
+// the workspace can be restored to exactly that state later
+type Snapshot struct {
+	Name         string                   `json:"name"`                // 스냅샷 이름
+	CreatedAt    time.Time                `json:"created_at"`          // 생성 시각
+	Operation    string                   `json:"operation,omitempty"` // 자동 스냅샷을 남긴 명령 (예: push --branch main --force)
+	Repositories map[string]SnapshotEntry `json:"repositories"`        // 저장소 이름 -> 상태
+}
+
+// SnapshotPath returns the file of a named snapshot in the state directory
+func (m *Manager) SnapshotPath(name string) string {
+	return m.StatePath(filepath.Join(SnapshotDirName, name+".json"))
+}
+
+// ValidateSnapshotName checks that a snapshot name can be used as a file name
+func ValidateSnapshotName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, "-") {
+		return fmt.Errorf("invalid snapshot name '%s'\n  hint: use a name like 'before-sync' or 'release-1.4'", name)
+	}
+	return nil
+}
+
+// NewSnapshot creates an empty snapshot
+func NewSnapshot(name string) *Snapshot {
+	return &Snapshot{
+		Name:         name,
+		CreatedAt:    time.Now(),
+		Repositories: make(map[string]SnapshotEntry),
+	}
+}
+
+// LoadSnapshot loads the snapshot at path
+func LoadSnapshot(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %s: %w", path, err)
+	}
+	if snapshot.Repositories == nil {
+		snapshot.Repositories = make(map[string]SnapshotEntry)
+	}
+	return snapshot, nil
+}
+
+// ListSnapshots loads all snapshots in the directory, sorted by name
+// Returns an empty list if the directory does not exist.
+func ListSnapshots(dir string) ([]*Snapshot, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	snapshots := make([]*Snapshot, 0, len(files))
+	for _, file := range files {
+		snapshot, err := LoadSnapshot(file)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// Save writes the snapshot to path atomically
+func (s *Snapshot) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create snapshot directory: %w", err)
+	}
+	// 임시 파일에 쓴 후 교체 (저장 중 중단되어도 이전 스냅샷 유지)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
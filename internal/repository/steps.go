@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Step status values
+const (
+	StepSucceeded = "succeeded"
+	StepFailed    = "failed"
+	StepSkipped   = "skipped"
+)
+
+// Step is the outcome of one step of a multi-step task (e.g. checkout, create-tag, push)
+type Step struct {
+	Name     string        // 단계 이름 (예: checkout)
+	Status   string        // succeeded, failed, skipped
+	Error    error         // 에러 (실패 시)
+	Message  string        // 추가 메시지 (선택적, 스킵 사유 등)
+	Duration time.Duration // 소요 시간
+}
+
+// Steps records the steps of a multi-step task into its result
+//
+//	steps := repository.NewSteps(&result)
+//	if err := steps.Run("checkout", func() error { return client.Checkout(opts) }); err != nil {
+//		return result, err
+//	}
+type Steps struct {
+	result *Result
+}
+
+// NewSteps returns a recorder that appends the steps to result.Steps
+func NewSteps(result *Result) *Steps {
+	return &Steps{result: result}
+}
+
+// Run runs a step and records its outcome. The error of a failed step is returned
+// unchanged, so the task can return it as its own error.
+func (s *Steps) Run(name string, fn func() error) error {
+	startTime := time.Now()
+	err := fn()
+	step := Step{Name: name, Status: StepSucceeded, Duration: time.Since(startTime)}
+	if err != nil {
+		step.Status = StepFailed
+		step.Error = err
+	}
+	s.result.Steps = append(s.result.Steps, step)
+	return err
+}
+
+// Skip records a step that was not run and why (e.g. "--push not set")
+func (s *Steps) Skip(name, reason string) {
+	s.result.Steps = append(s.result.Steps, Step{Name: name, Status: StepSkipped, Message: reason})
+}
+
+// FailedStep returns the step that failed, or nil if no step failed
+func (r *Result) FailedStep() *Step {
+	for i := range r.Steps {
+		if r.Steps[i].Status == StepFailed {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// StepsString returns the steps with their status (e.g. "checkout ✓ → create-tag ✓ → push ✗")
+// Returns "" if the result has no steps
+func (r *Result) StepsString() string {
+	if len(r.Steps) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, len(r.Steps))
+	for _, step := range r.Steps {
+		switch step.Status {
+		case StepSucceeded:
+			parts = append(parts, step.Name+" ✓")
+		case StepFailed:
+			parts = append(parts, step.Name+" ✗")
+		case StepSkipped:
+			if step.Message != "" {
+				parts = append(parts, fmt.Sprintf("%s (skipped: %s)", step.Name, step.Message))
+			} else {
+				parts = append(parts, step.Name+" (skipped)")
+			}
+		}
+	}
+	return strings.Join(parts, " → ")
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// streamState holds the per-repository writers of a streaming reporter
+type streamState struct {
+	mu      sync.Mutex               // 출력 및 writers 보호 (한 줄씩 섞이지 않게 출력)
+	width   int                      // 접두사 너비 (가장 긴 저장소 이름 기준)
+	writers map[string]*prefixWriter // 저장소 이름 -> writer
+}
+
+// SetStream enables streaming: output written to StreamWriter is printed as it
+// arrives, one line at a time with a "[repo-name]" prefix, instead of after the run
+func (r *Reporter) SetStream(enabled bool) {
+	if !enabled {
+		r.stream = nil
+		return
+	}
+	r.stream = &streamState{writers: make(map[string]*prefixWriter)}
+}
+
+// Streaming returns true if streaming is enabled
+func (r *Reporter) Streaming() bool {
+	return r.stream != nil
+}
+
+// StartStream aligns the prefixes of the given repositories
+func (r *Reporter) StartStream(repoNames []string) {
+	if r.stream == nil {
+		return
+	}
+	r.stream.mu.Lock()
+	defer r.stream.mu.Unlock()
+	for _, name := range repoNames {
+		if len(name) > r.stream.width {
+			r.stream.width = len(name)
+		}
+	}
+}
+
+// StreamWriter returns the writer for the live output of a repository
+// Returns io.Discard if streaming is disabled. Safe for concurrent use.
+func (r *Reporter) StreamWriter(repoName string) io.Writer {
+	if r.stream == nil {
+		return io.Discard
+	}
+	r.stream.mu.Lock()
+	defer r.stream.mu.Unlock()
+	w, ok := r.stream.writers[repoName]
+	if !ok {
+		prefix := fmt.Sprintf("%-*s | ", r.stream.width+2, "["+repoName+"]")
+		w = &prefixWriter{out: r.out, mu: &r.stream.mu, prefix: prefix}
+		r.stream.writers[repoName] = w
+	}
+	return w
+}
+
+// StreamResult prints the rest of the repository's output and its result line
+// Does nothing if streaming is disabled
+func (r *Reporter) StreamResult(result Result) {
+	if r.stream == nil {
+		return
+	}
+	w := r.StreamWriter(result.RepoName).(*prefixWriter)
+
+	var status string
+	switch {
+	case result.IsCancelled():
+		status = fmt.Sprintf("⊘ %v", result.Error)
+	case result.IsSkipped():
+		status = fmt.Sprintf("↷ %s", result.Message)
+	case result.Status == StatusTimedOut:
+		status = fmt.Sprintf("⏱ timed out (%.2fs): %v", result.Duration.Seconds(), result.Error)
+	case result.Success && result.Message != "" && !strings.Contains(result.Message, "\n"):
+		status = fmt.Sprintf("✓ %s (%.2fs)", result.Message, result.Duration.Seconds())
+	case result.Success:
+		status = fmt.Sprintf("✓ done (%.2fs)", result.Duration.Seconds())
+	case result.FailedStep() != nil:
+		status = fmt.Sprintf("✗ failed at %s (%.2fs): %v", result.FailedStep().Name, result.Duration.Seconds(), result.Error)
+	default:
+		status = fmt.Sprintf("✗ failed (%.2fs): %v", result.Duration.Seconds(), result.Error)
+	}
+	if details := result.DetailsString(); details != "" {
+		status += " - " + details
+	}
+
+	r.stream.mu.Lock()
+	defer r.stream.mu.Unlock()
+	w.flush()
+	for _, line := range strings.Split(status, "\n") {
+		w.line = append(w.line[:0], line...)
+		w.writeLine()
+	}
+	w.closed = true
+	delete(r.stream.writers, result.RepoName)
+}
+
+// prefixWriter writes complete lines with a prefix
+// A carriage return not followed by a newline (progress updates) replaces the
+// pending line, so only the final state of a progress line is printed.
+type prefixWriter struct {
+	out    io.Writer   // 출력 대상
+	mu     *sync.Mutex // 모든 저장소가 공유하는 출력 잠금
+	prefix string      // "[repo-name] | "
+	line   []byte      // 아직 끝나지 않은 줄
+	cr     bool        // 직전 바이트가 '\r' (다음이 '\n'이 아니면 줄을 덮어씀)
+	closed bool        // 결과 줄 출력 후 (취소된 작업의 남은 출력은 버림)
+}
+
+// Write implements io.Writer
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return len(p), nil
+	}
+
+	for _, b := range p {
+		if w.cr && b != '\n' {
+			w.line = w.line[:0]
+		}
+		w.cr = false
+		switch b {
+		case '\n':
+			w.writeLine()
+		case '\r':
+			w.cr = true
+		default:
+			w.line = append(w.line, b)
+		}
+	}
+	return len(p), nil
+}
+
+// flush prints the pending line, if any; the caller holds w.mu
+func (w *prefixWriter) flush() {
+	if len(bytes.TrimSpace(w.line)) > 0 {
+		w.writeLine()
+	}
+	w.line = w.line[:0]
+}
+
+// writeLine prints the pending line with the prefix; the caller holds w.mu
+func (w *prefixWriter) writeLine() {
+	fmt.Fprintf(w.out, "%s%s\n", w.prefix, w.line)
+	w.line = w.line[:0]
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TimingsFileName is the name of the file storing per-repository timings
+const TimingsFileName = "timings.json"
+
+// TimingEntry represents the recorded duration of an operation on one repository
+type TimingEntry struct {
+	AvgSeconds float64   `json:"avg_seconds"` // 평균 소요 시간 (이동 평균)
+	Runs       int       `json:"runs"`        // 기록된 실행 횟수
+	UpdatedAt  time.Time `json:"updated_at"`  // 마지막 기록 시각
+}
+
+// TimingStore holds recorded timings per operation and repository
+type TimingStore struct {
+	path       string                            // 저장 파일 경로
+	Operations map[string]map[string]TimingEntry `json:"operations"` // operation -> repo -> timing
+}
+
+// LoadTimingStore loads timings from the given file
+// A missing file results in an empty store
+func LoadTimingStore(path string) (*TimingStore, error) {
+	store := &TimingStore{
+		path:       path,
+		Operations: make(map[string]map[string]TimingEntry),
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read timings: %w", err)
+	}
+
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("failed to parse timings: %w", err)
+	}
+	if store.Operations == nil {
+		store.Operations = make(map[string]map[string]TimingEntry)
+	}
+	return store, nil
+}
+
+// Get returns the recorded timing for an operation on a repository
+func (s *TimingStore) Get(operation, repoName string) (TimingEntry, bool) {
+	entry, ok := s.Operations[operation][repoName]
+	return entry, ok
+}
+
+// Record adds the successful, non-skipped results of a run to the store
+func (s *TimingStore) Record(operation string, summary *Summary) {
+	repos, ok := s.Operations[operation]
+	if !ok {
+		repos = make(map[string]TimingEntry)
+		s.Operations[operation] = repos
+	}
+
+	now := time.Now()
+	for _, result := range summary.SuccessfulResults() {
+		seconds := result.Duration.Seconds()
+		entry, exists := repos[result.RepoName]
+		if !exists {
+			entry.AvgSeconds = seconds
+		} else {
+			// 최근 실행에 더 높은 가중치를 주는 이동 평균
+			entry.AvgSeconds = entry.AvgSeconds*0.7 + seconds*0.3
+		}
+		entry.Runs++
+		entry.UpdatedAt = now
+		repos[result.RepoName] = entry
+	}
+}
+
+// Save writes the store to disk
+func (s *TimingStore) Save() error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode timings: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create timings directory: %w", err)
+	}
+	if err := os.WriteFile(s.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write timings: %w", err)
+	}
+	return nil
+}
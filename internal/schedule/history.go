@@ -0,0 +1,153 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/repository"
+)
+
+// HistoryFileName is the name of the file storing the run history of scheduled operations
+const HistoryFileName = "schedule_history.json"
+
+// DefaultKeepRuns is the number of runs kept per scheduled operation
+const DefaultKeepRuns = 50
+
+// Run is the outcome of one run of a scheduled operation
+type Run struct {
+	Name      string    `json:"name"`             // 예약 작업 이름
+	Command   string    `json:"command"`          // 실행한 multi-git 명령어
+	StartedAt time.Time `json:"started_at"`       // 시작 시각
+	Seconds   float64   `json:"seconds"`          // 소요 시간
+	ExitCode  int       `json:"exit_code"`        // 종료 코드 (-1: 시작 실패 또는 제한 시간 초과)
+	Error     string    `json:"error,omitempty"`  // 실패 사유
+	Output    string    `json:"output,omitempty"` // 출력의 마지막 부분
+}
+
+// Success returns true if the command exited with status 0
+func (r Run) Success() bool {
+	return r.ExitCode == 0 && r.Error == ""
+}
+
+// Transient returns true if the command failed only with transient errors
+// (network problems, timeouts); such runs are recorded but not notified
+func (r Run) Transient() bool {
+	return r.ExitCode == repository.ExitTransient
+}
+
+// Duration returns the duration of the run
+func (r Run) Duration() time.Duration {
+	return time.Duration(r.Seconds * float64(time.Second))
+}
+
+// History holds the most recent runs of each scheduled operation
+type History struct {
+	path string           // 저장 파일 경로
+	Runs map[string][]Run `json:"runs"` // 이름 -> 실행 기록 (오래된 순)
+}
+
+// LoadHistory loads the run history from the given file
+// A missing file results in an empty history
+func LoadHistory(path string) (*History, error) {
+	history := &History{
+		path: path,
+		Runs: make(map[string][]Run),
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return history, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedule history: %w", err)
+	}
+
+	if err := json.Unmarshal(data, history); err != nil {
+		return nil, fmt.Errorf("failed to parse schedule history: %w", err)
+	}
+	if history.Runs == nil {
+		history.Runs = make(map[string][]Run)
+	}
+	return history, nil
+}
+
+// Record appends a run, keeping at most keep runs of the operation
+func (h *History) Record(run Run, keep int) {
+	runs := append(h.Runs[run.Name], run)
+	if keep > 0 && len(runs) > keep {
+		runs = runs[len(runs)-keep:]
+	}
+	h.Runs[run.Name] = runs
+}
+
+// Prune removes the runs started before cutoff
+// Returns the number of removed runs.
+func (h *History) Prune(cutoff time.Time) int {
+	removed := 0
+	for name, runs := range h.Runs {
+		kept := runs[:0]
+		for _, run := range runs {
+			if run.StartedAt.Before(cutoff) {
+				removed++
+				continue
+			}
+			kept = append(kept, run)
+		}
+		if len(kept) == 0 {
+			delete(h.Runs, name)
+		} else {
+			h.Runs[name] = kept
+		}
+	}
+	return removed
+}
+
+// Last returns the most recent run of the operation
+func (h *History) Last(name string) (Run, bool) {
+	runs := h.Runs[name]
+	if len(runs) == 0 {
+		return Run{}, false
+	}
+	return runs[len(runs)-1], true
+}
+
+// Recent returns up to limit runs, most recent first
+// An empty name returns the runs of all operations
+func (h *History) Recent(name string, limit int) []Run {
+	var runs []Run
+	if name != "" {
+		runs = append(runs, h.Runs[name]...)
+	} else {
+		for _, r := range h.Runs {
+			runs = append(runs, r...)
+		}
+	}
+
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].StartedAt.After(runs[j].StartedAt)
+	})
+	if limit > 0 && len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return runs
+}
+
+// Save writes the history to disk
+func (h *History) Save() error {
+	data, err := json.MarshalIndent(h, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode schedule history: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return fmt.Errorf("failed to create schedule history directory: %w", err)
+	}
+	if err := os.WriteFile(h.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write schedule history: %w", err)
+	}
+	return nil
+}
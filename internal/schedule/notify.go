@@ -0,0 +1,95 @@
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/shell"
+)
+
+// notifyOutputLines limits the output included in notification messages
+const notifyOutputLines = 20
+
+// NotifyFailure reports a failed run to the configured webhook and command
+// Both targets are attempted; the returned error joins their failures
+func (r *Runner) NotifyFailure(run Run) error {
+	var errs []error
+	if r.Notify.Webhook != "" {
+		if err := postWebhook(r.Notify.Webhook, failureMessage(run)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if r.Notify.Command != "" {
+		if err := r.runNotifyCommand(run); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// failureMessage formats a failed run for chat webhooks
+func failureMessage(run Run) string {
+	message := fmt.Sprintf("multi-git scheduled operation `%s` failed at %s: %s\nCommand: `%s`",
+		run.Name, run.StartedAt.Format("2006-01-02 15:04"), run.Error, run.Command)
+	if output := lastLines(run.Output, notifyOutputLines); output != "" {
+		message += "\n```\n" + output + "\n```"
+	}
+	return message
+}
+
+// postWebhook posts {"text": message} to the webhook (Slack incoming webhook compatible)
+func postWebhook(webhook, message string) error {
+	data, err := json.Marshal(map[string]string{"text": message})
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(webhook, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// runNotifyCommand runs the notification command with the run in MULTI_GIT_SCHEDULE_* variables
+func (r *Runner) runNotifyCommand(run Run) error {
+	shellPath := r.Shell
+	if shellPath == "" {
+		shellPath = shell.DefaultShell()
+	}
+
+	cmd := shell.Command(context.Background(), shellPath, r.Notify.Command)
+	cmd.Env = append(os.Environ(),
+		"MULTI_GIT_SCHEDULE_NAME="+run.Name,
+		"MULTI_GIT_SCHEDULE_COMMAND="+run.Command,
+		"MULTI_GIT_SCHEDULE_EXIT_CODE="+strconv.Itoa(run.ExitCode),
+		"MULTI_GIT_SCHEDULE_ERROR="+run.Error,
+		"MULTI_GIT_SCHEDULE_OUTPUT="+run.Output,
+	)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("notification command failed: %w: %s", err, bytes.TrimSpace(output))
+	}
+	return nil
+}
+
+// lastLines returns the last n lines of s without trailing newlines
+func lastLines(s string, n int) string {
+	lines := bytes.Split(bytes.TrimRight([]byte(s), "\n"), []byte("\n"))
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return string(bytes.Join(lines, []byte("\n")))
+}
@@ -0,0 +1,251 @@
+// Package schedule runs multi-git commands on cron schedules, keeps their
+// run history, and reports failures
+package schedule
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/cron"
+	"github.com/alexgim961101/multi-git/internal/log"
+)
+
+// maxOutput limits the command output kept in the history (the end is kept)
+const maxOutput = 4096
+
+// Runner runs scheduled operations as multi-git child processes
+type Runner struct {
+	Executable string              // multi-git 실행 파일 경로
+	GlobalArgs []string            // 모든 명령어에 전달할 전역 플래그 (--config, --profile 등)
+	Notify     config.NotifyConfig // 실패 알림 대상
+	Shell      string              // 알림 명령어에 사용할 셸
+	Output     io.Writer           // 명령어 출력을 함께 보낼 대상 (선택적)
+}
+
+// Run runs the scheduled operation once and returns its outcome
+// The command runs with the global flags and without a terminal, so it
+// cannot prompt; a timeout of 0 means no limit
+func (r *Runner) Run(ctx context.Context, name string, entry config.ScheduleEntry) Run {
+	run := Run{
+		Name:      name,
+		Command:   entry.Command,
+		StartedAt: time.Now(),
+	}
+
+	if entry.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, entry.Timeout)
+		defer cancel()
+	}
+
+	commandArgs, err := SplitArgs(entry.Command)
+	if err != nil {
+		run.ExitCode = -1
+		run.Error = err.Error()
+		return run
+	}
+
+	args := append([]string{}, r.GlobalArgs...)
+	if entry.ErrorBudget != nil {
+		args = append(args, "--error-budget", strconv.Itoa(*entry.ErrorBudget))
+	}
+	args = append(args, commandArgs...)
+	cmd := exec.CommandContext(ctx, r.Executable, args...)
+	var output bytes.Buffer
+	var out io.Writer = &output
+	if r.Output != nil {
+		out = io.MultiWriter(&output, r.Output)
+	}
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	log.Infof("schedule: running %s: %s", name, entry.Command)
+	err = cmd.Run()
+	run.Seconds = time.Since(run.StartedAt).Seconds()
+	run.Output = tail(output.String(), maxOutput)
+
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil:
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		run.ExitCode = -1
+		run.Error = fmt.Sprintf("timed out after %s", entry.Timeout)
+	case errors.As(err, &exitErr):
+		run.ExitCode = exitErr.ExitCode()
+		run.Error = fmt.Sprintf("exited with status %d", run.ExitCode)
+		if run.Transient() {
+			run.Error += " (transient failures only)"
+		}
+	default:
+		run.ExitCode = -1
+		run.Error = err.Error()
+	}
+
+	switch {
+	case run.Success():
+		log.Infof("schedule: %s succeeded in %.1fs", name, run.Seconds)
+	case run.Transient():
+		log.Warnf("schedule: %s had transient failures: %s", name, run.Error)
+	default:
+		log.Errorf("schedule: %s failed: %s", name, run.Error)
+	}
+	return run
+}
+
+// Entry is a scheduled operation with its parsed cron expression
+type Entry struct {
+	Name     string
+	Config   config.ScheduleEntry
+	Schedule *cron.Schedule
+}
+
+// Entries parses the scheduled operations of the configuration, sorted by name
+func Entries(cfg *config.Config) ([]Entry, error) {
+	entries := make([]Entry, 0, len(cfg.Schedule))
+	for _, name := range cfg.ScheduleNames() {
+		entry := cfg.Schedule[name]
+		schedule, err := cron.Parse(entry.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("schedule '%s': %w", name, err)
+		}
+		entries = append(entries, Entry{Name: name, Config: entry, Schedule: schedule})
+	}
+	return entries, nil
+}
+
+// Due returns the entries whose next run after 'after' is at or before now,
+// in name order
+func Due(entries []Entry, after, now time.Time) []Entry {
+	var due []Entry
+	for _, entry := range entries {
+		next := entry.Schedule.Next(after)
+		if !next.IsZero() && !next.After(now) {
+			due = append(due, entry)
+		}
+	}
+	return due
+}
+
+// NextRun returns the earliest next run time after t among the entries
+// Returns the zero time if no entry will ever run
+func NextRun(entries []Entry, t time.Time) time.Time {
+	var times []time.Time
+	for _, entry := range entries {
+		if next := entry.Schedule.Next(t); !next.IsZero() {
+			times = append(times, next)
+		}
+	}
+	if len(times) == 0 {
+		return time.Time{}
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times[0]
+}
+
+// Daemon runs the entries whenever they are due until the context is cancelled
+// Due operations run one at a time in name order; a run that is missed while
+// another one is still running is started once, when the running one ends
+// Each run is passed to onRun (e.g. to record history and notify)
+func (r *Runner) Daemon(ctx context.Context, entries []Entry, onRun func(Run)) error {
+	if len(entries) == 0 {
+		return fmt.Errorf("no scheduled operations configured")
+	}
+
+	last := time.Now()
+	for {
+		next := NextRun(entries, last)
+		if next.IsZero() {
+			return fmt.Errorf("no scheduled operation will ever run")
+		}
+		log.Debugf("schedule: next run at %s", next.Format(time.RFC3339))
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+
+		now := time.Now()
+		for _, entry := range Due(entries, last, now) {
+			if ctx.Err() != nil {
+				return nil
+			}
+			onRun(r.Run(ctx, entry.Name, entry.Config))
+		}
+		last = now
+	}
+}
+
+// SplitArgs splits a command line into arguments
+// Single and double quotes group words (e.g. exec "git gc --auto");
+// a backslash escapes the next character outside single quotes
+func SplitArgs(command string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+	escaped := false
+
+	for _, c := range command {
+		switch {
+		case escaped:
+			current.WriteRune(c)
+			escaped = false
+		case c == '\\' && quote != '\'':
+			escaped = true
+			inArg = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				current.WriteRune(c)
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			inArg = true
+		case c == ' ' || c == '\t' || c == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(c)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in command: %s", quote, command)
+	}
+	if escaped {
+		return nil, fmt.Errorf("trailing backslash in command: %s", command)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// tail returns the last max bytes of s, starting at a line boundary if possible
+func tail(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	s = s[len(s)-max:]
+	if i := strings.IndexByte(s, '\n'); i >= 0 && i < len(s)-1 {
+		s = s[i+1:]
+	}
+	return s
+}
@@ -0,0 +1,387 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/guard"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// RepositoryResponse describes a configured repository
+type RepositoryResponse struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Path   string   `json:"path"`
+	Groups []string `json:"groups,omitempty"`
+	Cloned bool     `json:"cloned"`
+}
+
+// handleRepositories lists the configured repositories
+// GET /api/v1/repositories?group=..&repo=..
+func (s *Server) handleRepositories(w http.ResponseWriter, r *http.Request) {
+	mgr, err := s.manager(selectionFromQuery(r))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	repos := make([]RepositoryResponse, 0, mgr.RepositoryCount())
+	for _, repo := range mgr.Repositories() {
+		repos = append(repos, RepositoryResponse{
+			Name:   repo.Name,
+			URL:    repo.URL,
+			Path:   mgr.GetRepositoryPath(repo),
+			Groups: repo.Groups,
+			Cloned: mgr.IsGitRepository(repo),
+		})
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"repositories": repos})
+}
+
+// StatusResponse is the working tree status of one repository
+type StatusResponse struct {
+	Repository string `json:"repository"`
+	Cloned     bool   `json:"cloned"`
+	Branch     string `json:"branch,omitempty"` // 비어있으면 detached HEAD
+	Dirty      bool   `json:"dirty"`
+	Commit     string `json:"commit,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// handleStatus reports branch, local changes, and HEAD commit of each repository
+// GET /api/v1/status?group=..&repo=..
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	statuses, err := s.status(r.Context(), selectionFromQuery(r))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"repositories": statuses})
+}
+
+// status collects the status of the selected repositories in config order
+func (s *Server) status(ctx context.Context, sel Selection) ([]StatusResponse, error) {
+	mgr, err := s.manager(sel)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	statuses := make(map[string]StatusResponse, mgr.RepositoryCount())
+	statusTask := func(repo config.Repository) (repository.Result, error) {
+		status := StatusResponse{Repository: repo.Name, Cloned: mgr.IsGitRepository(repo)}
+		if status.Cloned {
+			info, err := credentials.NewClient(mgr.Config(), repo).GetInfo()
+			if err != nil {
+				status.Error = err.Error()
+			} else {
+				status.Branch = info.CurrentBranch
+				status.Dirty = info.HasChanges
+				status.Commit = info.LatestCommit
+			}
+		}
+
+		mu.Lock()
+		statuses[repo.Name] = status
+		mu.Unlock()
+		return repository.Result{RepoName: repo.Name, Success: status.Error == ""}, nil
+	}
+	mgr.Execute(ctx, statusTask, nil)
+
+	// 설정 순서로 정렬
+	resp := make([]StatusResponse, 0, len(statuses))
+	for _, repo := range mgr.Repositories() {
+		if status, ok := statuses[repo.Name]; ok {
+			resp = append(resp, status)
+		}
+	}
+	return resp, nil
+}
+
+// PullRequest is the body of POST /api/v1/pull
+type PullRequest struct {
+	Selection
+}
+
+// handlePull pulls the current branch of each repository
+// Repositories with local changes fail instead of being overwritten
+func (s *Server) handlePull(w http.ResponseWriter, r *http.Request) {
+	var req PullRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	s.run(w, r, "pull", req.Selection, pullTask)
+}
+
+// pullTask pulls the current branch of a repository
+func pullTask(mgr *repository.Manager) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result := repository.Result{RepoName: repo.Name}
+		startTime := time.Now()
+
+		if !mgr.IsGitRepository(repo) {
+			return failed(result, startTime, errNotCloned(mgr, repo)), nil
+		}
+
+		client := credentials.NewClient(mgr.Config(), repo)
+		commits, files, err := client.PullWithStats(&git.PullOptions{Remote: mgr.DefaultRemote()})
+		if err != nil {
+			return failed(result, startTime, err), nil
+		}
+
+		result.Success = true
+		result.Message = "pulled"
+		if commits > 0 {
+			result.SetDetail("commits", commits)
+			result.SetDetail("files_changed", files)
+		}
+		result.Duration = time.Since(startTime)
+		return result, nil
+	}
+}
+
+// TagRequest is the body of POST /api/v1/tag
+type TagRequest struct {
+	Selection
+	Name    string `json:"name"`              // 태그 이름 (필수)
+	Branch  string `json:"branch,omitempty"`  // 태그할 브랜치 ('@default' 지원, 생성 시 필수)
+	Message string `json:"message,omitempty"` // 메시지 (annotated tag)
+	Push    bool   `json:"push,omitempty"`    // 원격에 푸시 (삭제 시 원격에서도 삭제)
+	Force   bool   `json:"force,omitempty"`   // 기존 태그 덮어쓰기
+	Delete  bool   `json:"delete,omitempty"`  // 생성 대신 삭제
+}
+
+// handleTag creates or deletes a tag in each repository, like 'multi-git tag'
+func (s *Server) handleTag(w http.ResponseWriter, r *http.Request) {
+	var req TagRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	operation, newTask, err := tagOperation(req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.run(w, r, operation, req.Selection, newTask)
+}
+
+// tagOperation validates the request and returns the operation name and task
+func tagOperation(req TagRequest) (string, taskBuilder, error) {
+	if req.Name == "" {
+		return "", nil, fmt.Errorf("'name' is required")
+	}
+
+	if req.Delete {
+		return "tag-delete", func(mgr *repository.Manager) repository.TaskFunc {
+			return func(repo config.Repository) (repository.Result, error) {
+				return deleteTag(mgr, repo, req), nil
+			}
+		}, nil
+	}
+
+	if req.Branch == "" {
+		return "", nil, fmt.Errorf("'branch' is required when creating a tag")
+	}
+	return "tag", func(mgr *repository.Manager) repository.TaskFunc {
+		return func(repo config.Repository) (repository.Result, error) {
+			return createTag(mgr, repo, req), nil
+		}
+	}, nil
+}
+
+// createTag checks out the branch and tags it
+func createTag(mgr *repository.Manager, repo config.Repository, req TagRequest) repository.Result {
+	result := repository.Result{RepoName: repo.Name}
+	startTime := time.Now()
+
+	if !mgr.IsGitRepository(repo) {
+		return failed(result, startTime, errNotCloned(mgr, repo))
+	}
+
+	branch, err := repo.ResolveBranch(req.Branch)
+	if err != nil {
+		return failed(result, startTime, err)
+	}
+
+	client := credentials.NewClient(mgr.Config(), repo)
+	if err := client.Checkout(&git.CheckoutOptions{Branch: branch, FetchFirst: true}); err != nil {
+		return failed(result, startTime, fmt.Errorf("failed to checkout branch '%s': %w", branch, err))
+	}
+	tagOpts := &git.TagOptions{
+		Name:      req.Name,
+		Message:   req.Message,
+		Annotated: req.Message != "",
+		Force:     req.Force,
+	}
+	if _, committer := mgr.Config().CommitIdentity(); !committer.IsZero() {
+		tagOpts.Tagger = &object.Signature{Name: committer.Name, Email: committer.Email}
+	}
+	err = client.CreateTag(tagOpts)
+	if err != nil {
+		return failed(result, startTime, err)
+	}
+
+	result.Message = "tag created"
+	if commit, err := client.GetLatestCommit(); err == nil {
+		result.SetDetail("commit", commit.Hash.String())
+	}
+	if req.Push {
+		if err := client.PushTag(req.Name, mgr.DefaultRemote()); err != nil {
+			return failed(result, startTime, fmt.Errorf("tag created but push failed: %w", err))
+		}
+		result.Message = "tag created and pushed"
+	}
+
+	result.Success = true
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// deleteTag deletes the tag locally and, with Push, on the remote
+func deleteTag(mgr *repository.Manager, repo config.Repository, req TagRequest) repository.Result {
+	result := repository.Result{RepoName: repo.Name}
+	startTime := time.Now()
+
+	if !mgr.IsGitRepository(repo) {
+		return failed(result, startTime, errNotCloned(mgr, repo))
+	}
+
+	// 보호 태그는 API에서 항상 보호 (--override-protection 없음)
+	if mgr.Config().IsProtectedTag(repo, req.Name) {
+		return failed(result, startTime, fmt.Errorf("tag '%s' is protected by 'protected_tags' in the config", req.Name))
+	}
+
+	client := credentials.NewClient(mgr.Config(), repo)
+	exists, err := client.TagExists(req.Name)
+	if err != nil {
+		return failed(result, startTime, fmt.Errorf("failed to check tag: %w", err))
+	}
+	if !exists {
+		result.Skip("tag not found (already deleted)")
+		return result
+	}
+
+	if err := client.DeleteTag(req.Name); err != nil {
+		return failed(result, startTime, fmt.Errorf("failed to delete local tag: %w", err))
+	}
+	result.Message = "tag deleted (local only)"
+	if req.Push {
+		if err := client.DeleteRemoteTag(req.Name, mgr.DefaultRemote()); err != nil {
+			return failed(result, startTime, fmt.Errorf("local tag deleted but remote deletion failed: %w", err))
+		}
+		result.Message = "tag deleted (local + remote)"
+	}
+
+	result.Success = true
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// ExecRequest is the body of POST /api/v1/exec
+type ExecRequest struct {
+	Selection
+	Command string `json:"command"` // 실행할 명령어 (허용 목록과 정확히 일치해야 함)
+}
+
+// handleExec runs an allow-listed command in each repository
+// The output of each repository is returned as its message
+func (s *Server) handleExec(w http.ResponseWriter, r *http.Request) {
+	var req ExecRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if err := s.checkCommand(req.Command); err != nil {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	s.run(w, r, "exec", req.Selection, s.execTask(req.Command))
+}
+
+// checkCommand returns an error unless the command is on the exec allow-list
+func (s *Server) checkCommand(command string) error {
+	if len(s.opts.AllowedCommands) == 0 {
+		return fmt.Errorf("exec is disabled (start the server with --allow-exec)")
+	}
+	if !slices.Contains(s.opts.AllowedCommands, command) {
+		return fmt.Errorf("command not allowed: %q", command)
+	}
+	return nil
+}
+
+// execTask runs the command in a repository; its output becomes the result message
+func (s *Server) execTask(command string) taskBuilder {
+	timeout := s.opts.CommandTimeout
+	if timeout <= 0 {
+		timeout = shell.DefaultTimeout
+	}
+
+	return func(mgr *repository.Manager) repository.TaskFunc {
+		return func(repo config.Repository) (repository.Result, error) {
+			result := repository.Result{RepoName: repo.Name}
+			startTime := time.Now()
+			repoPath := mgr.GetRepositoryPath(repo)
+
+			if !mgr.RepositoryExists(repo) {
+				return failed(result, startTime, errNotCloned(mgr, repo)), nil
+			}
+
+			// 보호 경로는 API에서 항상 보호 (--allow-protected 없음)
+			protected := mgr.Config().ProtectedPathsFor(repo)
+			var before guard.Snapshot
+			if len(protected) > 0 {
+				snapshot, err := guard.Take(repoPath, protected)
+				if err != nil {
+					return failed(result, startTime, err), nil
+				}
+				before = snapshot
+			}
+
+			maxOutput, keep := mgr.Config().OutputLimitFor(repo)
+			nice, maxMemory := mgr.Config().ResourceLimitsFor(repo)
+			output, err := shell.ExecuteConstrained(mgr.TaskContext(repo.Name), repoPath, s.opts.Shell, command, nil, timeout, nil,
+				shell.OutputLimit{Max: int64(maxOutput), Keep: keep}, shell.ResourceLimits{Nice: nice, MaxMemory: int64(maxMemory)})
+			result.Message = strings.TrimSpace(output)
+
+			if err == nil && len(protected) > 0 {
+				after, snapErr := guard.Take(repoPath, protected)
+				if snapErr != nil {
+					err = snapErr
+				} else if changed := guard.Diff(before, after); len(changed) > 0 {
+					err = fmt.Errorf("command modified protected paths: %s", strings.Join(changed, ", "))
+				}
+			}
+			if err != nil {
+				return failed(result, startTime, err), nil
+			}
+
+			result.Success = true
+			result.Duration = time.Since(startTime)
+			return result, nil
+		}
+	}
+}
+
+// failed completes a failed result
+func failed(result repository.Result, startTime time.Time, err error) repository.Result {
+	result.Success = false
+	result.Error = err
+	result.Duration = time.Since(startTime)
+	return result
+}
+
+// errNotCloned is the error of repositories that do not exist locally
+func errNotCloned(mgr *repository.Manager, repo config.Repository) error {
+	return fmt.Errorf("repository not cloned: %s", mgr.GetRepositoryPath(repo))
+}
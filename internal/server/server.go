@@ -0,0 +1,337 @@
+// Package server exposes fleet operations over an HTTP API for dashboards and bots
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+	"github.com/alexgim961101/multi-git/internal/repository"
+	"github.com/alexgim961101/multi-git/internal/shell"
+)
+
+// APIPrefix is the path prefix of all API endpoints
+const APIPrefix = "/api/v1"
+
+// maxBodySize limits the size of request bodies
+const maxBodySize = 1 << 20
+
+// ErrNoToken is returned by New when no API token, Slack signing secret, or webhook secret is configured
+var ErrNoToken = errors.New("an API token, Slack signing secret, or webhook secret is required")
+
+// ErrNoSlackTeam is returned by New when Slack is enabled without the workspace ID its commands must come from
+var ErrNoSlackTeam = errors.New("a Slack workspace ID is required with the Slack signing secret")
+
+// Options configures the API server
+type Options struct {
+	Token           string        // Bearer 토큰 (필수)
+	AllowedCommands []string      // exec로 실행 가능한 명령어 (비어있으면 exec 비활성화)
+	Shell           string        // exec에 사용할 셸
+	CommandTimeout  time.Duration // exec 명령어 제한 시간
+	Workers         int           // 병렬 작업 수 (0 = config 값)
+
+	SlackSigningSecret string   // Slack 앱 서명 비밀 (설정 시 SlackPath 활성화)
+	SlackTeam          string   // Slack 명령을 받을 워크스페이스 ID (T…, Slack 사용 시 필수)
+	SlackUsers         []string // Slack 명령을 실행할 수 있는 사용자 ID (U…, W…)
+
+	WebhookSecret string // push 웹훅 비밀 (설정 시 WebhookPath 활성화)
+}
+
+// Server serves the fleet operations of a configuration
+// Operations that change repositories run one at a time; concurrent requests get 409
+type Server struct {
+	cfg     *config.Config
+	opts    Options
+	busy    sync.Mutex     // 변경 작업 동시 실행 방지
+	pending sync.WaitGroup // 실행 중인 Slack 명령과 웹훅 fetch (종료 시 대기)
+
+	fetchMu  sync.Mutex      // fetching 보호
+	fetching map[string]bool // 웹훅 fetch 중인 저장소 -> 진행 중 push를 다시 받음
+}
+
+// New creates a server for the configuration
+func New(cfg *config.Config, opts Options) (*Server, error) {
+	if opts.Token == "" && opts.SlackSigningSecret == "" && opts.WebhookSecret == "" {
+		return nil, ErrNoToken
+	}
+	if opts.SlackSigningSecret != "" {
+		if opts.SlackTeam == "" {
+			return nil, ErrNoSlackTeam
+		}
+		for _, user := range opts.SlackUsers {
+			if !slackUserIDPattern.MatchString(user) {
+				return nil, fmt.Errorf("invalid Slack user ID '%s': use the member ID (U…), not the user name", user)
+			}
+		}
+	}
+	if opts.Shell == "" {
+		opts.Shell = shell.DefaultShell()
+	}
+	return &Server{cfg: cfg, opts: opts, fetching: make(map[string]bool)}, nil
+}
+
+// Handler returns the HTTP handler serving the API
+// The REST API requires the bearer token; Slack commands and push webhooks are verified by their signature
+func (s *Server) Handler() http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("GET "+APIPrefix+"/repositories", s.handleRepositories)
+	api.HandleFunc("GET "+APIPrefix+"/status", s.handleStatus)
+	api.HandleFunc("POST "+APIPrefix+"/pull", s.mutating(s.handlePull))
+	api.HandleFunc("POST "+APIPrefix+"/tag", s.mutating(s.handleTag))
+	api.HandleFunc("POST "+APIPrefix+"/exec", s.mutating(s.handleExec))
+
+	mux := http.NewServeMux()
+	mux.Handle(APIPrefix+"/", s.authenticate(api))
+	if s.opts.SlackSigningSecret != "" {
+		mux.HandleFunc("POST "+SlackPath, s.handleSlack)
+	}
+	if s.opts.WebhookSecret != "" {
+		mux.HandleFunc("POST "+WebhookPath, s.handleWebhook)
+	}
+	return mux
+}
+
+// authenticate rejects requests without the configured bearer token
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || s.opts.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.opts.Token)) != 1 {
+			log.Warnf("serve: unauthorized %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
+			writeError(w, http.StatusUnauthorized, "missing or invalid bearer token")
+			return
+		}
+		log.Infof("serve: %s %s from %s", r.Method, r.URL.Path, r.RemoteAddr)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// mutating runs the handler only if no other changing operation is in progress
+func (s *Server) mutating(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.busy.TryLock() {
+			writeError(w, http.StatusConflict, "another operation is in progress")
+			return
+		}
+		defer s.busy.Unlock()
+		handler(w, r)
+	}
+}
+
+// Selection chooses the repositories of a request (empty = all)
+type Selection struct {
+	Groups       []string `json:"groups,omitempty"`       // 이 그룹 중 하나에 속한 저장소만
+	Repositories []string `json:"repositories,omitempty"` // 이 이름(glob 패턴)의 저장소만
+}
+
+// manager returns a manager for the selected repositories
+func (s *Server) manager(sel Selection) (*repository.Manager, error) {
+	repos := s.cfg.Repositories
+	if len(sel.Groups) > 0 {
+		repos = config.FilterByGroups(repos, sel.Groups)
+	}
+	if len(sel.Repositories) > 0 {
+		filtered, err := config.FilterByNames(repos, sel.Repositories)
+		if err != nil {
+			return nil, err
+		}
+		repos = filtered
+	}
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("no repositories selected")
+	}
+
+	// 요청마다 별도 설정 사본 사용
+	cfg := *s.cfg
+	cfg.Repositories = repos
+	if s.opts.Workers > 0 {
+		cfg.ParallelWorkers = s.opts.Workers
+	}
+	mgr := repository.NewManager(&cfg)
+	mgr.SetRepoTimeout(cfg.RepoTimeout)
+	return mgr, nil
+}
+
+// ResultResponse is the outcome of an operation on one repository
+type ResultResponse struct {
+	Repository string         `json:"repository"`
+	Status     string         `json:"status"` // success, failed, skipped, cancelled, timed_out
+	Message    string         `json:"message,omitempty"`
+	Details    map[string]any `json:"details,omitempty"` // 구조화된 추가 정보 (예: commits, tag_sha)
+	Error      string         `json:"error,omitempty"`
+	DurationMS int64          `json:"duration_ms"`
+	Steps      []StepResponse `json:"steps,omitempty"` // 여러 단계 작업의 단계별 결과 (예: checkout, create-tag, push)
+}
+
+// StepResponse is the outcome of one step of a multi-step task
+type StepResponse struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"` // succeeded, failed, skipped
+	Message    string `json:"message,omitempty"`
+	Error      string `json:"error,omitempty"`
+	DurationMS int64  `json:"duration_ms"`
+}
+
+// OperationResponse is the response of an operation across repositories
+type OperationResponse struct {
+	Operation  string           `json:"operation"`
+	Success    int              `json:"success"`
+	Failed     int              `json:"failed"`
+	TimedOut   int              `json:"timed_out"` // 실패 중 제한 시간 초과
+	Skipped    int              `json:"skipped"`
+	Cancelled  int              `json:"cancelled"`
+	DurationMS int64            `json:"duration_ms"`
+	Results    []ResultResponse `json:"results"`
+}
+
+// taskBuilder builds the task of an operation for the manager of the selected repositories
+type taskBuilder func(mgr *repository.Manager) repository.TaskFunc
+
+// run runs the operation on the selected repositories and writes an OperationResponse
+// Responds 200 even if repositories failed; clients check Failed
+func (s *Server) run(w http.ResponseWriter, r *http.Request, operation string, sel Selection, newTask taskBuilder) {
+	resp, err := s.execute(r.Context(), operation, sel, newTask)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// execute runs the operation on the selected repositories
+// Returns an error only if the selection is invalid
+func (s *Server) execute(ctx context.Context, operation string, sel Selection, newTask taskBuilder) (*OperationResponse, error) {
+	mgr, err := s.manager(sel)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := mgr.Execute(ctx, newTask(mgr), nil)
+	log.Infof("serve: %s finished: %d succeeded, %d failed, %d skipped",
+		operation, summary.SuccessCount, summary.FailedCount, summary.SkippedCount)
+
+	resp := OperationResponse{
+		Operation:  operation,
+		Success:    summary.SuccessCount,
+		Failed:     summary.FailedCount,
+		TimedOut:   summary.TimedOutCount,
+		Skipped:    summary.SkippedCount,
+		Cancelled:  summary.CancelledCount,
+		DurationMS: summary.TotalDuration.Milliseconds(),
+		Results:    make([]ResultResponse, 0, len(summary.Results)),
+	}
+	for _, result := range summary.Results {
+		resp.Results = append(resp.Results, newResultResponse(result))
+	}
+	return &resp, nil
+}
+
+// newResultResponse converts a task result
+func newResultResponse(result repository.Result) ResultResponse {
+	resp := ResultResponse{
+		Repository: result.RepoName,
+		Status:     string(result.Status),
+		Message:    result.Message,
+		Details:    result.Details,
+		DurationMS: result.Duration.Milliseconds(),
+	}
+	if result.Error != nil {
+		resp.Error = result.Error.Error()
+	}
+	for _, step := range result.Steps {
+		stepResp := StepResponse{Name: step.Name, Status: step.Status, Message: step.Message, DurationMS: step.Duration.Milliseconds()}
+		if step.Error != nil {
+			stepResp.Error = step.Error.Error()
+		}
+		resp.Steps = append(resp.Steps, stepResp)
+	}
+	return resp
+}
+
+// decodeBody decodes a JSON request body, rejecting unknown fields
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+// selectionFromQuery reads group and repo query parameters (repeatable or comma-separated)
+func selectionFromQuery(r *http.Request) Selection {
+	split := func(values []string) []string {
+		var out []string
+		for _, v := range values {
+			for _, part := range strings.Split(v, ",") {
+				if part = strings.TrimSpace(part); part != "" {
+					out = append(out, part)
+				}
+			}
+		}
+		return out
+	}
+	query := r.URL.Query()
+	return Selection{
+		Groups:       split(query["group"]),
+		Repositories: split(query["repo"]),
+	}
+}
+
+// writeJSON writes v as the JSON response body
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		log.Warnf("serve: failed to write response: %v", err)
+	}
+}
+
+// writeError writes an error response
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"error": message})
+}
+
+// ListenAndServe serves the API on addr until ctx is done, then shuts down gracefully
+func (s *Server) ListenAndServe(ctx context.Context, addr string) error {
+	httpServer := &http.Server{
+		Addr:              addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		// 진행 중인 요청이 끝날 때까지 대기
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), s.shutdownTimeout())
+		defer cancel()
+		err := httpServer.Shutdown(shutdownCtx)
+		s.pending.Wait()
+		return err
+	}
+}
+
+// shutdownTimeout is how long shutdown waits for running operations
+func (s *Server) shutdownTimeout() time.Duration {
+	if s.opts.CommandTimeout > 0 {
+		return s.opts.CommandTimeout + 10*time.Second
+	}
+	return time.Minute
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/log"
+)
+
+// SlackPath is the endpoint Slack slash commands are sent to
+const SlackPath = "/slack/command"
+
+// slackMaxAge is how old a signed Slack request may be (replay protection)
+const slackMaxAge = 5 * time.Minute
+
+// slackMaxLines limits the number of per-repository lines posted back
+const slackMaxLines = 40
+
+// slackUserIDPattern matches Slack member IDs (U…, or W… in Enterprise Grid)
+// User names are not accepted: they are not unique and users can change them.
+var slackUserIDPattern = regexp.MustCompile(`^[UW][A-Z0-9]+$`)
+
+// slackHelp describes the slash command syntax
+const slackHelp = "Usage:\n" +
+	"• `status [group|repo...]` - branch and local changes\n" +
+	"• `pull [group|repo...]` - pull the current branches\n" +
+	"• `tag <name> [group|repo...] [--branch=<branch>] [--push] [--delete]` - create (default branch) or delete a tag\n" +
+	"• `exec <command>` - run an allow-listed command in every repository"
+
+// slackMessage is a Slack slash command response
+type slackMessage struct {
+	ResponseType string `json:"response_type"` // ephemeral (요청자만) 또는 in_channel
+	Text         string `json:"text"`
+}
+
+// slackCommand is a parsed slash command
+type slackCommand struct {
+	title    string                                    // 결과 메시지에 표시할 명령
+	mutating bool                                      // 저장소를 변경하는 작업 (동시 실행 불가)
+	run      func(ctx context.Context) (string, error) // 실행 후 결과 본문 반환
+}
+
+// handleSlack handles a Slack slash command
+// The request is acknowledged immediately and the result is posted to the response_url
+func (s *Server) handleSlack(w http.ResponseWriter, r *http.Request) {
+	// 1. 서명 검증
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if err := s.verifySlackSignature(r.Header, body, time.Now()); err != nil {
+		log.Warnf("serve: rejected Slack request from %s: %v", r.RemoteAddr, err)
+		writeError(w, http.StatusUnauthorized, "invalid Slack signature")
+		return
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid form body")
+		return
+	}
+	teamID, userID := form.Get("team_id"), form.Get("user_id")
+	text := strings.TrimSpace(form.Get("text"))
+	log.Infof("serve: Slack command from %s in %s: %s", userID, teamID, text)
+
+	// 2. 워크스페이스와 사용자 허용 목록 확인 (user_name은 바꿀 수 있어 사용하지 않음)
+	if teamID != s.opts.SlackTeam {
+		log.Warnf("serve: rejected Slack command from workspace %s", teamID)
+		writeSlack(w, "ephemeral", fmt.Sprintf("This workspace is not allowed to run multi-git commands (workspace %s).", teamID))
+		return
+	}
+	if !slices.Contains(s.opts.SlackUsers, userID) {
+		writeSlack(w, "ephemeral", fmt.Sprintf("You are not allowed to run multi-git commands (user %s).", userID))
+		return
+	}
+
+	// 3. 명령 해석
+	command, err := s.parseSlackCommand(text)
+	if err != nil {
+		writeSlack(w, "ephemeral", fmt.Sprintf("%v\n%s", err, slackHelp))
+		return
+	}
+	if command == nil {
+		writeSlack(w, "ephemeral", slackHelp)
+		return
+	}
+
+	responseURL := form.Get("response_url")
+	if responseURL == "" {
+		writeError(w, http.StatusBadRequest, "missing response_url")
+		return
+	}
+	if command.mutating && !s.busy.TryLock() {
+		writeSlack(w, "ephemeral", "Another operation is in progress, try again later.")
+		return
+	}
+
+	// 4. 즉시 응답 후 백그라운드 실행 (Slack은 3초 안에 응답을 요구)
+	writeSlack(w, "ephemeral", fmt.Sprintf("Running `%s`...", command.title))
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		if command.mutating {
+			defer s.busy.Unlock()
+		}
+
+		result, err := command.run(context.Background())
+		message := slackMessage{ResponseType: "in_channel"}
+		if err != nil {
+			message.ResponseType = "ephemeral"
+			message.Text = fmt.Sprintf("`%s` failed: %v", command.title, err)
+		} else {
+			message.Text = fmt.Sprintf("`%s` by <@%s>: %s", command.title, userID, result)
+		}
+		if err := s.postSlack(responseURL, message); err != nil {
+			log.Errorf("serve: failed to post Slack response: %v", err)
+		}
+	}()
+}
+
+// verifySlackSignature checks the request signature made with the Slack signing secret
+func (s *Server) verifySlackSignature(header http.Header, body []byte, now time.Time) error {
+	timestamp := header.Get("X-Slack-Request-Timestamp")
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("missing or invalid timestamp")
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > slackMaxAge || age < -slackMaxAge {
+		return fmt.Errorf("request timestamp too old")
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.opts.SlackSigningSecret))
+	fmt.Fprintf(mac, "v0:%s:", timestamp)
+	mac.Write(body)
+	expected := "v0=" + hex.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(expected), []byte(header.Get("X-Slack-Signature"))) {
+		return fmt.Errorf("signature mismatch")
+	}
+	return nil
+}
+
+// parseSlackCommand parses the text of a slash command
+// Returns nil for 'help' and empty text
+func (s *Server) parseSlackCommand(text string) (*slackCommand, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 || fields[0] == "help" {
+		return nil, nil
+	}
+
+	switch fields[0] {
+	case "status":
+		sel := s.selectionFromArgs(fields[1:])
+		return &slackCommand{
+			title: text,
+			run: func(ctx context.Context) (string, error) {
+				statuses, err := s.status(ctx, sel)
+				if err != nil {
+					return "", err
+				}
+				return formatSlackStatus(statuses), nil
+			},
+		}, nil
+
+	case "pull":
+		return s.slackOperation(text, "pull", s.selectionFromArgs(fields[1:]), pullTask), nil
+
+	case "tag":
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("tag name is required")
+		}
+		req := TagRequest{Name: fields[1], Branch: "@default"}
+		var selectors []string
+		for _, arg := range fields[2:] {
+			switch {
+			case arg == "--push":
+				req.Push = true
+			case arg == "--delete":
+				req.Delete = true
+			case strings.HasPrefix(arg, "--branch="):
+				req.Branch = strings.TrimPrefix(arg, "--branch=")
+			case strings.HasPrefix(arg, "--"):
+				return nil, fmt.Errorf("unknown option %s", arg)
+			default:
+				selectors = append(selectors, arg)
+			}
+		}
+		operation, newTask, err := tagOperation(req)
+		if err != nil {
+			return nil, err
+		}
+		return s.slackOperation(text, operation, s.selectionFromArgs(selectors), newTask), nil
+
+	case "exec":
+		command := strings.TrimSpace(strings.TrimPrefix(text, "exec"))
+		if err := s.checkCommand(command); err != nil {
+			return nil, err
+		}
+		return s.slackOperation(text, "exec", Selection{}, s.execTask(command)), nil
+
+	default:
+		return nil, fmt.Errorf("unknown command '%s'", fields[0])
+	}
+}
+
+// slackOperation wraps a changing operation as a slash command
+func (s *Server) slackOperation(title, operation string, sel Selection, newTask taskBuilder) *slackCommand {
+	return &slackCommand{
+		title:    title,
+		mutating: true,
+		run: func(ctx context.Context) (string, error) {
+			resp, err := s.execute(ctx, operation, sel, newTask)
+			if err != nil {
+				return "", err
+			}
+			return formatSlackOperation(resp, operation == "exec"), nil
+		},
+	}
+}
+
+// selectionFromArgs treats each argument as a group if any repository has it, otherwise as a repository name or glob
+func (s *Server) selectionFromArgs(args []string) Selection {
+	var sel Selection
+	for _, arg := range args {
+		isGroup := slices.ContainsFunc(s.cfg.Repositories, func(repo config.Repository) bool {
+			return repo.HasGroup(arg)
+		})
+		if isGroup {
+			sel.Groups = append(sel.Groups, arg)
+		} else {
+			sel.Repositories = append(sel.Repositories, arg)
+		}
+	}
+	return sel
+}
+
+// formatSlackStatus formats repository statuses as a Slack message body
+func formatSlackStatus(statuses []StatusResponse) string {
+	dirty := 0
+	lines := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		var line string
+		switch {
+		case !status.Cloned:
+			line = fmt.Sprintf("• %s: not cloned", status.Repository)
+		case status.Error != "":
+			line = fmt.Sprintf("• %s: %s", status.Repository, firstLine(status.Error))
+		default:
+			branch := status.Branch
+			if branch == "" {
+				branch = "detached"
+			}
+			line = fmt.Sprintf("• %s: `%s` %s", status.Repository, branch, status.Commit)
+			if status.Dirty {
+				dirty++
+				line += " (local changes)"
+			}
+		}
+		lines = append(lines, line)
+	}
+	return fmt.Sprintf("%d repositories, %d with local changes\n%s", len(statuses), dirty, joinLimited(lines))
+}
+
+// formatSlackOperation formats an operation result as a Slack message body
+// Failures are always listed; with showOutput every repository's message is included
+func formatSlackOperation(resp *OperationResponse, showOutput bool) string {
+	summary := fmt.Sprintf("%d succeeded, %d failed", resp.Success, resp.Failed)
+	if resp.TimedOut > 0 {
+		summary += fmt.Sprintf(" (%d timed out)", resp.TimedOut)
+	}
+	if resp.Skipped > 0 {
+		summary += fmt.Sprintf(", %d skipped", resp.Skipped)
+	}
+	if resp.Cancelled > 0 {
+		summary += fmt.Sprintf(", %d cancelled", resp.Cancelled)
+	}
+	summary += fmt.Sprintf(" (%.1fs)", float64(resp.DurationMS)/1000)
+
+	var lines []string
+	for _, result := range resp.Results {
+		switch {
+		case result.Status == "failed":
+			lines = append(lines, fmt.Sprintf("✗ %s: %s", result.Repository, firstLine(result.Error)))
+		case showOutput && result.Message != "":
+			lines = append(lines, fmt.Sprintf("✓ %s:\n```%s```", result.Repository, result.Message))
+		}
+	}
+	if len(lines) == 0 {
+		return summary
+	}
+	return summary + "\n" + joinLimited(lines)
+}
+
+// joinLimited joins lines, cutting off after slackMaxLines
+func joinLimited(lines []string) string {
+	if len(lines) > slackMaxLines {
+		omitted := len(lines) - slackMaxLines
+		lines = append(lines[:slackMaxLines:slackMaxLines], fmt.Sprintf("... and %d more", omitted))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// firstLine returns the first line of an error message (hints are dropped)
+func firstLine(message string) string {
+	line, _, _ := strings.Cut(message, "\n")
+	return line
+}
+
+// writeSlack writes an immediate slash command response
+func writeSlack(w http.ResponseWriter, responseType, text string) {
+	writeJSON(w, http.StatusOK, slackMessage{ResponseType: responseType, Text: text})
+}
+
+// postSlack posts a delayed response to the response_url of a slash command
+func (s *Server) postSlack(responseURL string, message slackMessage) error {
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(responseURL, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
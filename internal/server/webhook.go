@@ -0,0 +1,206 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/log"
+)
+
+// WebhookPath is the endpoint GitHub and GitLab push webhooks are sent to
+const WebhookPath = "/webhooks/push"
+
+// pushPayload holds the repository URLs of GitHub and GitLab push events
+type pushPayload struct {
+	Repository struct {
+		CloneURL   string `json:"clone_url"`    // GitHub
+		SSHURL     string `json:"ssh_url"`      // GitHub
+		HTMLURL    string `json:"html_url"`     // GitHub
+		GitHTTPURL string `json:"git_http_url"` // GitLab
+		GitSSHURL  string `json:"git_ssh_url"`  // GitLab
+	} `json:"repository"`
+	Project struct {
+		GitHTTPURL string `json:"git_http_url"` // GitLab
+		GitSSHURL  string `json:"git_ssh_url"`  // GitLab
+		WebURL     string `json:"web_url"`      // GitLab
+	} `json:"project"`
+}
+
+// urls returns the non-empty repository URLs of the payload
+func (p pushPayload) urls() []string {
+	var urls []string
+	for _, u := range []string{
+		p.Repository.CloneURL, p.Repository.SSHURL, p.Repository.HTMLURL,
+		p.Repository.GitHTTPURL, p.Repository.GitSSHURL,
+		p.Project.GitHTTPURL, p.Project.GitSSHURL, p.Project.WebURL,
+	} {
+		if u != "" {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
+
+// handleWebhook fetches the repositories a GitHub or GitLab push event is about
+// The request is verified with the webhook secret and answered before fetching
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	// 1. 서명 검증
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "failed to read request body")
+		return
+	}
+	if err := s.verifyWebhook(r.Header, body); err != nil {
+		log.Warnf("serve: rejected webhook from %s: %v", r.RemoteAddr, err)
+		writeError(w, http.StatusUnauthorized, "invalid webhook signature")
+		return
+	}
+
+	// 2. 이벤트 확인 (push와 태그 push만 처리)
+	event := r.Header.Get("X-GitHub-Event")
+	if event == "" {
+		event = r.Header.Get("X-Gitlab-Event")
+	}
+	switch event {
+	case "push", "Push Hook", "Tag Push Hook":
+	case "ping":
+		writeJSON(w, http.StatusOK, map[string]string{"status": "pong"})
+		return
+	default:
+		writeJSON(w, http.StatusAccepted, map[string]string{"status": "ignored", "reason": fmt.Sprintf("event '%s' is not a push", event)})
+		return
+	}
+
+	// 3. 설정된 저장소 찾기
+	var payload pushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid push payload: %v", err))
+		return
+	}
+	repos := s.repositoriesForURLs(payload.urls())
+	if len(repos) == 0 {
+		log.Infof("serve: webhook push for unmanaged repository %v", payload.urls())
+		writeJSON(w, http.StatusAccepted, map[string]string{"status": "ignored", "reason": "repository is not managed by this server"})
+		return
+	}
+
+	// 4. 즉시 응답 후 백그라운드 fetch
+	names := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		names = append(names, repo.Name)
+		s.queueFetch(repo)
+	}
+	log.Infof("serve: webhook push for %s", strings.Join(names, ", "))
+	writeJSON(w, http.StatusAccepted, map[string]any{"status": "fetching", "repositories": names})
+}
+
+// verifyWebhook checks the GitHub signature (X-Hub-Signature-256) or the GitLab token (X-Gitlab-Token)
+func (s *Server) verifyWebhook(header http.Header, body []byte) error {
+	secret := []byte(s.opts.WebhookSecret)
+
+	if signature := header.Get("X-Hub-Signature-256"); signature != "" {
+		hexDigest, ok := strings.CutPrefix(signature, "sha256=")
+		if !ok {
+			return fmt.Errorf("unsupported signature format")
+		}
+		got, err := hex.DecodeString(hexDigest)
+		if err != nil {
+			return fmt.Errorf("malformed signature")
+		}
+		mac := hmac.New(sha256.New, secret)
+		mac.Write(body)
+		if !hmac.Equal(got, mac.Sum(nil)) {
+			return fmt.Errorf("signature mismatch")
+		}
+		return nil
+	}
+
+	if token := header.Get("X-Gitlab-Token"); token != "" {
+		if subtle.ConstantTimeCompare([]byte(token), secret) != 1 {
+			return fmt.Errorf("token mismatch")
+		}
+		return nil
+	}
+
+	return fmt.Errorf("missing X-Hub-Signature-256 or X-Gitlab-Token header")
+}
+
+// repositoriesForURLs returns the configured repositories matching any of the URLs
+// URLs are compared by host, owner, and name, so HTTPS and SSH URLs match each other
+func (s *Server) repositoriesForURLs(urls []string) []config.Repository {
+	var matched []config.Repository
+	for _, repo := range s.cfg.Repositories {
+		web := repo.WebURL()
+		if web == "" {
+			continue
+		}
+		for _, u := range urls {
+			if strings.EqualFold(web, config.Repository{URL: u}.WebURL()) {
+				matched = append(matched, repo)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// queueFetch fetches the repository in the background
+// A push received while the repository is being fetched triggers one more fetch
+func (s *Server) queueFetch(repo config.Repository) {
+	s.fetchMu.Lock()
+	if _, running := s.fetching[repo.Name]; running {
+		s.fetching[repo.Name] = true
+		s.fetchMu.Unlock()
+		return
+	}
+	s.fetching[repo.Name] = false
+	s.fetchMu.Unlock()
+
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		for {
+			s.fetch(repo)
+
+			s.fetchMu.Lock()
+			if s.fetching[repo.Name] {
+				s.fetching[repo.Name] = false
+				s.fetchMu.Unlock()
+				continue
+			}
+			delete(s.fetching, repo.Name)
+			s.fetchMu.Unlock()
+			return
+		}
+	}()
+}
+
+// fetch fetches branches and tags of a cloned repository from the default remote
+func (s *Server) fetch(repo config.Repository) {
+	client := credentials.NewClient(s.cfg, repo)
+	if !client.IsRepository() {
+		log.Infof("serve: webhook fetch skipped for %s: not cloned", repo.Name)
+		return
+	}
+
+	err := client.FetchWithOptions(&git.FetchOptions{
+		Remote: s.cfg.DefaultRemote,
+		Prune:  true,
+		Tags:   true,
+	})
+	if err != nil {
+		log.Errorf("serve: webhook fetch of %s failed: %v", repo.Name, err)
+		return
+	}
+	log.Infof("serve: fetched %s", repo.Name)
+}
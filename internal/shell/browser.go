@@ -0,0 +1,42 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ResolveBrowser returns the command that opens a URL in the browser
+// Priority: $BROWSER > the system opener (open on macOS, xdg-open elsewhere,
+// the URL protocol handler on Windows)
+func ResolveBrowser() string {
+	if browser := os.Getenv("BROWSER"); strings.TrimSpace(browser) != "" {
+		return browser
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		return "open"
+	case "windows":
+		return "rundll32 url.dll,FileProtocolHandler"
+	default:
+		return "xdg-open"
+	}
+}
+
+// OpenInBrowser opens the URL with the browser command without waiting for it
+// The browser string may contain arguments (e.g. "firefox --new-tab")
+func OpenInBrowser(browser, url string) error {
+	fields := strings.Fields(browser)
+	if len(fields) == 0 {
+		return fmt.Errorf("browser command is empty")
+	}
+
+	cmd := exec.Command(fields[0], append(fields[1:], url)...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to run browser '%s': %w", fields[0], err)
+	}
+	// 브라우저가 종료될 때까지 기다리지 않음
+	return cmd.Process.Release()
+}
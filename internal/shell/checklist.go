@@ -0,0 +1,184 @@
+package shell
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// checklistHelp is the key help shown below the checklist
+const checklistHelp = "↑/↓ move  space toggle  a all/none  enter confirm  q cancel"
+
+// Checklist shows the items as a list of checkboxes on the terminal (stderr) and
+// lets the user toggle them with the keyboard: arrow keys or j/k move, space toggles,
+// 'a' toggles all, enter confirms, q or Esc cancels. Long lists scroll.
+// Returns the indexes of the checked items in order, or nil if the user cancelled.
+func Checklist(title string, items []string) ([]int, error) {
+	in, out := int(os.Stdin.Fd()), int(os.Stderr.Fd())
+	if !term.IsTerminal(in) || !term.IsTerminal(out) {
+		return nil, fmt.Errorf("interactive selection requires a terminal")
+	}
+	if err := enableTerminalSequences(os.Stderr); err != nil {
+		return nil, err
+	}
+
+	state, err := term.MakeRaw(in)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the keyboard: %w", err)
+	}
+	defer term.Restore(in, state)
+
+	list := &checklist{title: title, items: items, checked: make([]bool, len(items))}
+	if list.width, list.height, err = term.GetSize(out); err != nil || list.height <= 0 {
+		list.width, list.height = 80, 24 // 크기를 알 수 없는 터미널
+	}
+	fmt.Fprint(os.Stderr, "\x1b[?25l") // 커서 숨기기
+	defer fmt.Fprint(os.Stderr, "\x1b[?25h")
+
+	buf := make([]byte, 16)
+	for {
+		list.render()
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			list.clear()
+			return nil, fmt.Errorf("failed to read the keyboard: %w", err)
+		}
+
+		switch key := string(buf[:n]); key {
+		case "\x1b[A", "\x1bOA", "k":
+			list.move(-1)
+		case "\x1b[B", "\x1bOB", "j":
+			list.move(1)
+		case "\x1b[5~":
+			list.move(-list.rows())
+		case "\x1b[6~":
+			list.move(list.rows())
+		case "\x1b[H", "\x1bOH", "\x1b[1~", "g":
+			list.move(-len(items))
+		case "\x1b[F", "\x1bOF", "\x1b[4~", "G":
+			list.move(len(items))
+		case " ", "x":
+			if len(items) > 0 {
+				list.checked[list.cursor] = !list.checked[list.cursor]
+			}
+		case "a":
+			list.toggleAll()
+		case "\r", "\n":
+			list.clear()
+			selected := []int{}
+			for i, checked := range list.checked {
+				if checked {
+					selected = append(selected, i)
+				}
+			}
+			return selected, nil
+		case "q", "\x1b", "\x03":
+			list.clear()
+			return nil, nil
+		}
+	}
+}
+
+// checklist is the state of a Checklist on the screen
+type checklist struct {
+	title    string
+	items    []string
+	checked  []bool
+	cursor   int // 현재 항목
+	offset   int // 화면 첫 줄의 항목
+	width    int // 터미널 너비
+	height   int // 터미널 높이
+	rendered int // 마지막으로 출력한 줄 수
+}
+
+// rows returns the number of items shown at once (title, scroll, and help lines excluded)
+func (l *checklist) rows() int {
+	return max(min(len(l.items), l.height-4), 1)
+}
+
+// move moves the cursor by delta items and scrolls it into view
+func (l *checklist) move(delta int) {
+	l.cursor = max(min(l.cursor+delta, len(l.items)-1), 0)
+	if l.cursor < l.offset {
+		l.offset = l.cursor
+	}
+	if l.cursor >= l.offset+l.rows() {
+		l.offset = l.cursor - l.rows() + 1
+	}
+}
+
+// toggleAll checks all items, or unchecks them if all are checked
+func (l *checklist) toggleAll() {
+	all := true
+	for _, checked := range l.checked {
+		all = all && checked
+	}
+	for i := range l.checked {
+		l.checked[i] = !all
+	}
+}
+
+// render redraws the list over the previous rendering
+// Raw mode does not translate "\n", so lines end with "\r\n".
+func (l *checklist) render() {
+	count := 0
+	for _, checked := range l.checked {
+		if checked {
+			count++
+		}
+	}
+
+	lines := []string{fmt.Sprintf("%s (%d of %d selected)", l.title, count, len(l.items))}
+	end := min(l.offset+l.rows(), len(l.items))
+	for i := l.offset; i < end; i++ {
+		pointer, box := "  ", "[ ]"
+		if i == l.cursor {
+			pointer = "> "
+		}
+		if l.checked[i] {
+			box = "[x]"
+		}
+		lines = append(lines, pointer+box+" "+l.items[i])
+	}
+	if len(l.items) > l.rows() {
+		lines = append(lines, fmt.Sprintf("  (%d-%d of %d)", l.offset+1, end, len(l.items)))
+	}
+	lines = append(lines, checklistHelp)
+
+	var b bytes.Buffer
+	l.rewind(&b)
+	for _, line := range lines {
+		b.WriteString("\x1b[2K" + truncate(line, l.width) + "\r\n")
+	}
+	l.rendered = len(lines)
+	os.Stderr.Write(b.Bytes())
+}
+
+// clear removes the list from the screen
+func (l *checklist) clear() {
+	var b bytes.Buffer
+	l.rewind(&b)
+	b.WriteString("\x1b[J")
+	l.rendered = 0
+	os.Stderr.Write(b.Bytes())
+}
+
+// rewind moves the cursor to the first line of the previous rendering
+func (l *checklist) rewind(b *bytes.Buffer) {
+	if l.rendered > 0 {
+		fmt.Fprintf(b, "\x1b[%dA", l.rendered)
+	}
+	b.WriteString("\r")
+}
+
+// truncate shortens a line to the terminal width so that it does not wrap
+func truncate(line string, width int) string {
+	runes := []rune(line)
+	if width <= 1 || len(runes) < width {
+		return line
+	}
+	return strings.TrimRight(string(runes[:width-2]), " ") + "…"
+}
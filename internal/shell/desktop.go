@@ -0,0 +1,53 @@
+package shell
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// windowsToastAppID is the application the Windows toast is shown for (PowerShell),
+// since toasts of unregistered application IDs are dropped
+const windowsToastAppID = `{1AC14E77-02E7-4E5D-B744-2EB1AE5198B7}\WindowsPowerShell\v1.0\powershell.exe`
+
+// NotifyDesktop shows a desktop notification with osascript on macOS, a toast
+// through PowerShell on Windows, or notify-send elsewhere (libnotify)
+func NotifyDesktop(title, message string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(message), appleScriptString(title))
+		cmd = exec.Command("osascript", "-e", script)
+	case "windows":
+		script := strings.Join([]string{
+			"[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null",
+			"$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)",
+			"$text = $template.GetElementsByTagName('text')",
+			fmt.Sprintf("$text.Item(0).AppendChild($template.CreateTextNode(%s)) > $null", powerShellString(title)),
+			fmt.Sprintf("$text.Item(1).AppendChild($template.CreateTextNode(%s)) > $null", powerShellString(message)),
+			fmt.Sprintf("[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier(%s).Show([Windows.UI.Notifications.ToastNotification]::new($template))", powerShellString(windowsToastAppID)),
+		}, "; ")
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	default:
+		cmd = exec.Command("notify-send", "--app-name=multi-git", title, message)
+	}
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		if msg := strings.TrimSpace(string(output)); msg != "" {
+			return fmt.Errorf("failed to show notification with %s: %s", cmd.Args[0], msg)
+		}
+		return fmt.Errorf("failed to show notification with %s: %w", cmd.Args[0], err)
+	}
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// powerShellString quotes s as a PowerShell single-quoted string literal
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,47 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// DefaultEditor is used when neither $VISUAL nor $EDITOR is set
+const DefaultEditor = "code"
+
+// ResolveEditor returns the editor command to use
+// Priority: explicit override > $VISUAL > $EDITOR > DefaultEditor
+func ResolveEditor(override string) string {
+	if strings.TrimSpace(override) != "" {
+		return override
+	}
+	if visual := os.Getenv("VISUAL"); strings.TrimSpace(visual) != "" {
+		return visual
+	}
+	if editor := os.Getenv("EDITOR"); strings.TrimSpace(editor) != "" {
+		return editor
+	}
+	return DefaultEditor
+}
+
+// OpenInEditor opens the given paths in the editor
+// The editor string may contain arguments (e.g. "code -n")
+func OpenInEditor(editor string, paths []string) error {
+	fields := strings.Fields(editor)
+	if len(fields) == 0 {
+		return fmt.Errorf("editor command is empty")
+	}
+
+	args := append(fields[1:], paths...)
+	cmd := exec.Command(fields[0], args...)
+	// 터미널 에디터(vim 등)도 동작하도록 표준 입출력 연결
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run editor '%s': %w", fields[0], err)
+	}
+	return nil
+}
@@ -3,6 +3,9 @@ package shell
 import (
 	"bytes"
 	"context"
+	"errors"
+	"io"
+	"os"
 	"os/exec"
 	"time"
 )
@@ -10,6 +13,9 @@ import (
 // DefaultTimeout is the default timeout for command execution
 const DefaultTimeout = 5 * time.Minute
 
+// killWaitDelay is how long a killed command's output is still read
+const killWaitDelay = 2 * time.Second
+
 // Execute runs a shell command in the specified directory
 func Execute(workDir, shell, command string) (string, error) {
 	return ExecuteWithTimeout(workDir, shell, command, DefaultTimeout)
@@ -17,17 +23,65 @@ func Execute(workDir, shell, command string) (string, error) {
 
 // ExecuteWithTimeout runs a shell command with a custom timeout
 func ExecuteWithTimeout(workDir, shell, command string, timeout time.Duration) (string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	return ExecuteWithEnv(workDir, shell, command, nil, timeout)
+}
+
+// ExecuteWithEnv runs a shell command with extra environment variables (KEY=value) and a timeout
+func ExecuteWithEnv(workDir, shell, command string, env []string, timeout time.Duration) (string, error) {
+	return ExecuteStreaming(workDir, shell, command, env, timeout, nil)
+}
+
+// ExecuteStreaming runs a shell command like ExecuteWithEnv and also writes its
+// stdout and stderr to live as they are produced (nil = no live output)
+// The returned output is the same as without streaming.
+func ExecuteStreaming(workDir, shell, command string, env []string, timeout time.Duration, live io.Writer) (string, error) {
+	return ExecuteLimited(context.Background(), workDir, shell, command, env, timeout, live, OutputLimit{})
+}
+
+// ExecuteLimited runs a shell command like ExecuteStreaming and keeps at most
+// limit.Max bytes each of its stdout and stderr in memory, so a runaway command
+// cannot exhaust it. The dropped part is replaced by a "... [N truncated] ..."
+// marker; live output is not limited. Cancelling ctx kills the command.
+func ExecuteLimited(ctx context.Context, workDir, shell, command string, env []string, timeout time.Duration, live io.Writer, limit OutputLimit) (string, error) {
+	return ExecuteConstrained(ctx, workDir, shell, command, env, timeout, live, limit, ResourceLimits{})
+}
+
+// ExecuteConstrained runs a shell command like ExecuteLimited with a lower CPU
+// priority and a memory cap for it and the processes it starts (see ResourceLimits)
+func ExecuteConstrained(ctx context.Context, workDir, shell, command string, env []string, timeout time.Duration, live io.Writer, limit OutputLimit, resources ResourceLimits) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, shell, "-c", command)
+	cmd := commandWithLimits(ctx, shell, command, resources)
 	cmd.Dir = workDir
+	// 셸이 종료된 뒤 출력을 붙잡고 있는 자식 프로세스는 기다리지 않음
+	cmd.WaitDelay = killWaitDelay
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
-	var stdout, stderr bytes.Buffer
-	cmd.Stdout = &stdout
-	cmd.Stderr = &stderr
+	var stdout, stderr outputBuffer = &bytes.Buffer{}, &bytes.Buffer{}
+	if limit.Max > 0 {
+		stdout, stderr = newLimitedBuffer(limit), newLimitedBuffer(limit)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if live != nil {
+		cmd.Stdout = io.MultiWriter(stdout, live)
+		cmd.Stderr = io.MultiWriter(stderr, live)
+	}
 
-	err := cmd.Run()
+	err := cmd.Start()
+	if err == nil {
+		release, limitErr := applyLimits(cmd, resources)
+		if limitErr != nil {
+			cmd.Process.Kill()
+			cmd.Wait()
+			return "", limitErr
+		}
+		err = cmd.Wait()
+		release()
+	}
 
 	output := stdout.String()
 	if stderr.Len() > 0 {
@@ -39,3 +93,23 @@ func ExecuteWithTimeout(workDir, shell, command string, timeout time.Duration) (
 
 	return output, err
 }
+
+// outputBuffer collects the output of a command
+type outputBuffer interface {
+	io.Writer
+	Len() int
+	String() string
+}
+
+// ExitCode returns the exit code of a command from the error returned by Execute
+// Returns 0 for nil and -1 if the command did not exit normally (not started, timed out)
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+	return -1
+}
@@ -0,0 +1,35 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// InteractiveShell returns the user's login shell ($SHELL), or a platform default
+func InteractiveShell() string {
+	if sh := os.Getenv("SHELL"); sh != "" {
+		return sh
+	}
+	return DefaultShell()
+}
+
+// OpenShell starts an interactive shell in dir and waits for it to exit
+// The shell inherits the terminal so the user can work in the repository
+func OpenShell(dir string) error {
+	sh := InteractiveShell()
+	cmd := exec.Command(sh)
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		// 마지막 명령의 종료 코드로 셸이 끝난 경우는 정상 종료로 취급
+		if _, ok := err.(*exec.ExitError); ok {
+			return nil
+		}
+		return fmt.Errorf("failed to run shell '%s': %w", sh, err)
+	}
+	return nil
+}
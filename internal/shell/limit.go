@@ -0,0 +1,128 @@
+package shell
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Output truncation policies: which part of an output over the limit is kept
+const (
+	KeepHead = "head" // 앞부분 유지 (처음 에러가 중요한 경우)
+	KeepTail = "tail" // 뒷부분 유지 (마지막 요약이 중요한 경우)
+	KeepBoth = "both" // 앞뒤 절반씩 유지 (기본)
+)
+
+// OutputLimit caps the output of a command kept in memory
+type OutputLimit struct {
+	Max  int64  // stdout, stderr 각각 유지할 최대 바이트 수 (0 = 제한 없음)
+	Keep string // 잘라낼 때 유지할 부분 (head, tail, both; 빈 값 = both)
+}
+
+// limitedBuffer is a writer that keeps at most Max bytes of what is written to it:
+// the first bytes, the last bytes, or half of each, and counts the bytes dropped
+type limitedBuffer struct {
+	headMax int64
+	tailMax int64
+	head    []byte
+	tail    []byte // 최근 tailMax 바이트 (링 버퍼)
+	next    int    // tail에서 다음에 덮어쓸 위치 (가득 찬 경우)
+	written int64  // 전체 기록된 바이트 수
+}
+
+// newLimitedBuffer returns a buffer for the limit
+func newLimitedBuffer(limit OutputLimit) *limitedBuffer {
+	b := &limitedBuffer{}
+	switch limit.Keep {
+	case KeepHead:
+		b.headMax = limit.Max
+	case KeepTail:
+		b.tailMax = limit.Max
+	default:
+		b.headMax = limit.Max / 2
+		b.tailMax = limit.Max - b.headMax
+	}
+	return b
+}
+
+// Write keeps the bytes within the limit; it never fails so the command keeps running
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	n := len(p)
+	b.written += int64(n)
+
+	// 1. 앞부분 채우기
+	if room := b.headMax - int64(len(b.head)); room > 0 {
+		take := min(room, int64(len(p)))
+		b.head = append(b.head, p[:take]...)
+		p = p[take:]
+	}
+	if b.tailMax == 0 || len(p) == 0 {
+		return n, nil
+	}
+
+	// 2. 뒷부분은 최근 tailMax 바이트만 유지
+	if int64(len(p)) >= b.tailMax {
+		b.tail = append(b.tail[:0], p[int64(len(p))-b.tailMax:]...)
+		b.next = 0
+		return n, nil
+	}
+	for _, c := range p {
+		if int64(len(b.tail)) < b.tailMax {
+			b.tail = append(b.tail, c)
+			continue
+		}
+		b.tail[b.next] = c
+		b.next = (b.next + 1) % len(b.tail)
+	}
+	return n, nil
+}
+
+// Len returns the number of bytes kept
+func (b *limitedBuffer) Len() int {
+	return len(b.head) + len(b.tail)
+}
+
+// String returns the kept bytes with a marker where bytes were dropped
+func (b *limitedBuffer) String() string {
+	tail := string(b.tail[b.next:]) + string(b.tail[:b.next])
+	dropped := b.written - int64(b.Len())
+	if dropped == 0 {
+		return string(b.head) + tail
+	}
+
+	// 잘린 쪽의 불완전한 줄은 버림 (줄이 하나뿐이면 유지)
+	head := string(b.head)
+	if i := strings.LastIndex(head, "\n"); i >= 0 {
+		dropped += int64(len(head) - i - 1)
+		head = head[:i+1]
+	}
+	if i := strings.Index(tail, "\n"); i >= 0 && i < len(tail)-1 {
+		dropped += int64(i + 1)
+		tail = tail[i+1:]
+	}
+
+	var sb strings.Builder
+	sb.WriteString(head)
+	if head != "" && !strings.HasSuffix(head, "\n") {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(fmt.Sprintf("... [%s truncated] ...", formatBytes(dropped)))
+	if tail != "" {
+		sb.WriteString("\n")
+	}
+	sb.WriteString(tail)
+	return sb.String()
+}
+
+// formatBytes formats a byte count with a binary unit (e.g. "3.2 MiB")
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d bytes", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
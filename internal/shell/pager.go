@@ -0,0 +1,63 @@
+package shell
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// DefaultPager is used when $PAGER is not set
+const DefaultPager = "less"
+
+// ResolvePager returns the pager command: $PAGER, or DefaultPager if it is not set
+// Returns "" if paging is disabled ($PAGER set to "" or "cat")
+func ResolvePager() string {
+	pager, ok := os.LookupEnv("PAGER")
+	if !ok {
+		return DefaultPager
+	}
+	if strings.TrimSpace(pager) == "cat" {
+		return ""
+	}
+	return strings.TrimSpace(pager)
+}
+
+// TerminalHeight returns the number of rows of the terminal on stdout
+// Returns 0 if stdout is not a terminal
+func TerminalHeight() int {
+	fd := int(os.Stdout.Fd())
+	if !term.IsTerminal(fd) {
+		return 0
+	}
+	_, height, err := term.GetSize(fd)
+	if err != nil {
+		return 0
+	}
+	return height
+}
+
+// Page shows the text in the pager and waits for the user to quit it
+// The pager string may contain arguments (e.g. "less -S"). less gets LESS=FRX
+// unless LESS is set: quit if the text fits, keep colors, keep the text on screen.
+func Page(pager, text string) error {
+	fields := strings.Fields(pager)
+	if len(fields) == 0 {
+		return fmt.Errorf("pager command is empty")
+	}
+
+	cmd := exec.Command(fields[0], fields[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if _, ok := os.LookupEnv("LESS"); !ok {
+		cmd.Env = append(os.Environ(), "LESS=FRX")
+	}
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("failed to run pager '%s': %w", pager, err)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+package shell
+
+// ResourceLimits lowers the scheduling priority and caps the memory of a command and
+// the processes it starts, so heavy commands (builds, test suites) running in many
+// repositories at once do not make the machine unusable
+type ResourceLimits struct {
+	Nice      int   // CPU 우선순위 낮추기 (1-19, 0 = 변경 없음)
+	MaxMemory int64 // 명령어와 자식 프로세스의 최대 메모리 바이트 (0 = 제한 없음)
+}
+
+// IsZero returns true if no limit is set
+func (l ResourceLimits) IsZero() bool {
+	return l.Nice <= 0 && l.MaxMemory <= 0
+}
@@ -0,0 +1,81 @@
+//go:build !windows
+
+package shell
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// commandWithLimits returns the command running the script with the limits applied by
+// wrapping it: nice (and ionice on Linux) for the priority; for the memory a systemd
+// scope (cgroup MemoryMax, covers all children) if a systemd user session is running,
+// otherwise 'ulimit -d' of /bin/sh, which then execs the shell (data segment of each process;
+// if the limit cannot be set, e.g. on macOS, the command runs without it after a warning)
+// nice, ionice, systemd-run, and sh exec the command, so cancelling ctx still kills the shell.
+func commandWithLimits(ctx context.Context, shellPath, script string, limits ResourceLimits) *exec.Cmd {
+	args := append([]string{shellPath}, scriptArgs(shellPath, script)...)
+
+	// 1. 메모리 제한
+	if limits.MaxMemory > 0 {
+		if runtime.GOOS == "linux" && hasSystemdUserSession() {
+			args = append([]string{"systemd-run", "--user", "--scope", "--quiet", "--collect",
+				"--property=MemoryMax=" + strconv.FormatInt(limits.MaxMemory, 10), "--"}, args...)
+		} else {
+			// 셸 종류와 관계없이 /bin/sh에서 제한을 건 뒤 셸을 exec
+			// (주소 공간(-v)은 큰 가상 영역을 예약하는 JVM, node, Go 바이너리를 깨뜨리므로 데이터 영역 제한,
+			// 설정할 수 없으면 경고만 출력하고 제한 없이 실행)
+			kib := max(limits.MaxMemory/1024, 1)
+			script := fmt.Sprintf("ulimit -d %d 2>/dev/null || echo 'multi-git: warning: cannot limit memory on this system, running without max_memory' >&2\nexec \"$@\"", kib)
+			args = append([]string{"/bin/sh", "-c", script, "sh"}, args...)
+		}
+	}
+
+	// 2. CPU (와 Linux I/O) 우선순위
+	if limits.Nice > 0 {
+		prefix := []string{"nice", "-n", strconv.Itoa(limits.Nice)}
+		if runtime.GOOS == "linux" {
+			if _, err := exec.LookPath("ionice"); err == nil {
+				// best-effort 클래스의 가장 낮은 우선순위 (idle 클래스는 굶을 수 있음)
+				prefix = append(prefix, "ionice", "-c", "2", "-n", "7")
+			}
+		}
+		args = append(prefix, args...)
+	}
+
+	return exec.CommandContext(ctx, args[0], args[1:]...)
+}
+
+// applyLimits applies the limits that need the started process; the wrapping done by
+// commandWithLimits covers all of them on Unix
+func applyLimits(cmd *exec.Cmd, limits ResourceLimits) (func(), error) {
+	return func() {}, nil
+}
+
+var (
+	systemdOnce    sync.Once
+	systemdSession bool
+)
+
+// hasSystemdUserSession returns true if systemd-run can start scopes in the user's
+// systemd instance (not the case in most containers and CI runners)
+func hasSystemdUserSession() bool {
+	systemdOnce.Do(func() {
+		if _, err := exec.LookPath("systemd-run"); err != nil {
+			return
+		}
+		runtimeDir := os.Getenv("XDG_RUNTIME_DIR")
+		if runtimeDir == "" {
+			return
+		}
+		_, err := os.Stat(filepath.Join(runtimeDir, "systemd", "private"))
+		systemdSession = err == nil
+	})
+	return systemdSession
+}
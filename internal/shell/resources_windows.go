@@ -0,0 +1,70 @@
+//go:build windows
+
+package shell
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// commandWithLimits returns the command running the script with a lower priority
+// class for Nice (below normal, idle from 15); the memory limit is applied by applyLimits
+func commandWithLimits(ctx context.Context, shellPath, script string, limits ResourceLimits) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, shellPath, scriptArgs(shellPath, script)...)
+	attr := &syscall.SysProcAttr{}
+	if kindOf(shellPath) == kindCmd {
+		// cmd.exe는 Go의 인자 인용 규칙을 따르지 않으므로 명령줄을 직접 구성
+		// (/s: 바깥 따옴표만 제거하고 스크립트는 그대로 실행)
+		attr.CmdLine = fmt.Sprintf(`%s /d /s /c "%s"`, syscall.EscapeArg(shellPath), script)
+	}
+	if limits.Nice > 0 {
+		priority := uint32(windows.BELOW_NORMAL_PRIORITY_CLASS)
+		if limits.Nice >= 15 {
+			priority = windows.IDLE_PRIORITY_CLASS
+		}
+		attr.CreationFlags = priority
+	}
+	cmd.SysProcAttr = attr
+	return cmd
+}
+
+// applyLimits puts the started process in a job object capping the memory of the
+// process and the children it starts afterwards
+// Returns a function closing the job object once the command has finished.
+func applyLimits(cmd *exec.Cmd, limits ResourceLimits) (func(), error) {
+	if limits.MaxMemory <= 0 {
+		return func() {}, nil
+	}
+
+	// 1. 메모리 제한이 있는 job object 생성
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job object: %w", err)
+	}
+	info := windows.JOBOBJECT_EXTENDED_LIMIT_INFORMATION{JobMemoryLimit: uintptr(limits.MaxMemory)}
+	info.BasicLimitInformation.LimitFlags = windows.JOB_OBJECT_LIMIT_JOB_MEMORY
+	if _, err := windows.SetInformationJobObject(job, windows.JobObjectExtendedLimitInformation,
+		uintptr(unsafe.Pointer(&info)), uint32(unsafe.Sizeof(info))); err != nil {
+		windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to set memory limit: %w", err)
+	}
+
+	// 2. 프로세스를 job object에 할당
+	process, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(cmd.Process.Pid))
+	if err != nil {
+		windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to open process: %w", err)
+	}
+	defer windows.CloseHandle(process)
+	if err := windows.AssignProcessToJobObject(job, process); err != nil {
+		windows.CloseHandle(job)
+		return nil, fmt.Errorf("failed to assign process to job object: %w", err)
+	}
+
+	return func() { windows.CloseHandle(job) }, nil
+}
@@ -0,0 +1,78 @@
+package shell
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// shellKind is the family of a shell, which decides how a script is passed to it
+type shellKind int
+
+const (
+	kindPOSIX      shellKind = iota // sh, bash, zsh 등: -c <script>
+	kindCmd                         // cmd.exe: /d /s /c "<script>"
+	kindPowerShell                  // powershell, pwsh: -NoProfile -NonInteractive -Command <script>
+)
+
+// DefaultShell returns the shell commands run in when none is configured:
+// /bin/sh, or on Windows %COMSPEC% (cmd.exe)
+func DefaultShell() string {
+	if runtime.GOOS != "windows" {
+		return "/bin/sh"
+	}
+	if comspec := os.Getenv("COMSPEC"); comspec != "" {
+		return comspec
+	}
+	return "cmd.exe"
+}
+
+// Validate checks that the shell can be started, so a wrong --shell fails once
+// instead of in every repository
+func Validate(shellPath string) error {
+	if strings.TrimSpace(shellPath) == "" {
+		return fmt.Errorf("shell is empty")
+	}
+	if _, err := exec.LookPath(shellPath); err != nil {
+		hint := "use a shell on the PATH or its full path, e.g. /bin/bash"
+		if runtime.GOOS == "windows" {
+			hint = "use cmd, powershell, pwsh, or the full path of bash.exe (e.g. from Git for Windows)"
+		}
+		return fmt.Errorf("shell '%s' not found: %w\n  hint: %s", shellPath, err, hint)
+	}
+	return nil
+}
+
+// Command returns the command running the script with the shell
+func Command(ctx context.Context, shellPath, script string) *exec.Cmd {
+	return commandWithLimits(ctx, shellPath, script, ResourceLimits{})
+}
+
+// kindOf returns the family of the shell from its file name
+func kindOf(shellPath string) shellKind {
+	name := strings.ToLower(filepath.Base(strings.ReplaceAll(shellPath, `\`, "/")))
+	switch strings.TrimSuffix(name, ".exe") {
+	case "cmd":
+		return kindCmd
+	case "powershell", "pwsh":
+		return kindPowerShell
+	default:
+		return kindPOSIX
+	}
+}
+
+// scriptArgs returns the arguments that make the shell run the script
+func scriptArgs(shellPath, script string) []string {
+	switch kindOf(shellPath) {
+	case kindCmd:
+		return []string{"/d", "/s", "/c", script}
+	case kindPowerShell:
+		return []string{"-NoProfile", "-NonInteractive", "-Command", script}
+	default:
+		return []string{"-c", script}
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !windows
+
+package shell
+
+import "os"
+
+// enableTerminalSequences prepares the terminal for ANSI escape sequences; Unix
+// terminals interpret them already
+func enableTerminalSequences(f *os.File) error {
+	return nil
+}
@@ -0,0 +1,24 @@
+//go:build windows
+
+package shell
+
+import (
+	"fmt"
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// enableTerminalSequences turns on the interpretation of ANSI escape sequences
+// (cursor movement, line clearing) in the console, which is off by default
+func enableTerminalSequences(f *os.File) error {
+	handle := windows.Handle(f.Fd())
+	var mode uint32
+	if err := windows.GetConsoleMode(handle, &mode); err != nil {
+		return fmt.Errorf("failed to get console mode: %w", err)
+	}
+	if err := windows.SetConsoleMode(handle, mode|windows.ENABLE_VIRTUAL_TERMINAL_PROCESSING); err != nil {
+		return fmt.Errorf("console does not support escape sequences: %w", err)
+	}
+	return nil
+}
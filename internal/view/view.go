@@ -0,0 +1,92 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LinkStatus describes what Link did
+type LinkStatus string
+
+const (
+	LinkCreated   LinkStatus = "created"   // 새 링크 생성
+	LinkUpdated   LinkStatus = "updated"   // 다른 대상을 가리키던 링크 교체
+	LinkUnchanged LinkStatus = "unchanged" // 이미 올바른 링크
+)
+
+// Link creates or updates dir/name as a symlink to target
+// An existing file or directory that is not a symlink is never replaced
+func Link(dir, name, target string) (LinkStatus, error) {
+	linkPath := filepath.Join(dir, name)
+
+	info, err := os.Lstat(linkPath)
+	switch {
+	case os.IsNotExist(err):
+		if err := os.MkdirAll(filepath.Dir(linkPath), 0755); err != nil {
+			return "", fmt.Errorf("failed to create directory: %w", err)
+		}
+		if err := os.Symlink(target, linkPath); err != nil {
+			return "", fmt.Errorf("failed to create symlink: %w", err)
+		}
+		return LinkCreated, nil
+	case err != nil:
+		return "", fmt.Errorf("failed to inspect %s: %w", linkPath, err)
+	case info.Mode()&os.ModeSymlink == 0:
+		return "", fmt.Errorf("%s already exists and is not a symlink", linkPath)
+	}
+
+	current, err := os.Readlink(linkPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read symlink: %w", err)
+	}
+	if current == target {
+		return LinkUnchanged, nil
+	}
+
+	if err := os.Remove(linkPath); err != nil {
+		return "", fmt.Errorf("failed to replace symlink: %w", err)
+	}
+	if err := os.Symlink(target, linkPath); err != nil {
+		return "", fmt.Errorf("failed to create symlink: %w", err)
+	}
+	return LinkUpdated, nil
+}
+
+// Prune removes symlinks in dir that point inside root and are not in keep
+// Other files are left untouched. Returns the names of removed links.
+func Prune(dir, root string, keep map[string]bool) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read view directory: %w", err)
+	}
+
+	var removed []string
+	for _, entry := range entries {
+		if entry.Type()&os.ModeSymlink == 0 || keep[entry.Name()] {
+			continue
+		}
+
+		linkPath := filepath.Join(dir, entry.Name())
+		target, err := os.Readlink(linkPath)
+		if err != nil || !isWithin(target, root) {
+			continue
+		}
+
+		if err := os.Remove(linkPath); err != nil {
+			return removed, fmt.Errorf("failed to remove stale link %s: %w", linkPath, err)
+		}
+		removed = append(removed, entry.Name())
+	}
+	return removed, nil
+}
+
+// isWithin returns true if path is root or below it
+func isWithin(path, root string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,468 @@
+package multigit
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/git"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// Client performs git operations on one repository
+type Client struct {
+	client *git.Client
+}
+
+// CloneOptions controls CloneInMemory and CloneToStorage
+type CloneOptions struct {
+	Depth        int             // Shallow clone depth (0 = full clone)
+	Branch       string          // 클론할 브랜치 (비어있으면 원격 HEAD)
+	SingleBranch bool            // Branch(또는 원격 HEAD)의 히스토리만 가져옴
+	Progress     io.Writer       // 진행 상황 출력 (nil이면 출력 안 함)
+	Auth         *AuthOptions    // 인증 정보 (nil이면 저장소에 설정된 인증)
+	Context      context.Context // 취소되면 클론 중단 (nil이면 취소 불가)
+}
+
+// CheckoutOptions controls Client.Checkout
+type CheckoutOptions struct {
+	Branch     string // 체크아웃할 브랜치 이름
+	Create     bool   // 브랜치가 없으면 생성
+	Force      bool   // 로컬 변경사항 무시하고 강제 체크아웃
+	FetchFirst bool   // 체크아웃 전 fetch 수행
+}
+
+// FetchOptions controls Client.FetchWithOptions
+type FetchOptions struct {
+	Remote string // 원격 이름 (기본: origin)
+	Prune  bool   // 원격에서 삭제된 브랜치 참조 제거
+	Tags   bool   // 모든 태그 fetch
+}
+
+// PullOptions controls Client.Pull
+type PullOptions struct {
+	Remote     string          // 원격 이름 (기본: origin)
+	Branch     string          // 풀할 브랜치 이름 (비어있으면 현재 브랜치)
+	Force      bool            // 강제 풀 (로컬 변경사항 무시)
+	FetchFirst bool            // fetch 먼저 수행
+	Progress   io.Writer       // 진행 상황 출력 (nil이면 출력 안 함)
+	Context    context.Context // 취소되면 풀 중단 (nil이면 취소 불가)
+}
+
+// PushOptions controls Client.Push
+type PushOptions struct {
+	Branch       string        // 푸시할 로컬 브랜치 이름
+	RemoteBranch string        // 원격 브랜치 이름 (없으면 Branch와 동일)
+	Remote       string        // 원격 이름 (기본: origin)
+	Force        bool          // 강제 푸시
+	DryRun       bool          // 시뮬레이션만 (실제 푸시 안 함)
+	Timeout      time.Duration // 타임아웃 (0 = 기본값)
+}
+
+// TagOptions controls Client.CreateTag
+type TagOptions struct {
+	Name      string     // 태그 이름
+	Message   string     // 태그 메시지 (annotated tag용)
+	Annotated bool       // annotated tag (true) vs lightweight tag (false)
+	Force     bool       // 기존 태그 덮어쓰기
+	Push      bool       // 원격에 푸시
+	Ref       string     // 태그할 커밋 (해시, 태그, 브랜치; 비어있으면 HEAD)
+	Tagger    *Signature // annotated tag의 tagger (nil이면 multi-git 기본값)
+}
+
+// CommitOptions controls Client.Commit
+type CommitOptions struct {
+	Message    string     // 커밋 메시지 (필수)
+	AddAll     bool       // 추적되지 않은 파일을 포함한 모든 변경사항 stage
+	Include    []string   // 이 glob 패턴에 맞는 경로만 stage
+	AllowEmpty bool       // 변경사항이 없어도 커밋 생성
+	Signoff    bool       // Signed-off-by 트레일러 추가
+	Author     *Signature // 작성자 (nil이면 git config user.name/email)
+	Committer  *Signature // 커미터 (nil이면 작성자)
+}
+
+// AuthOptions holds the credentials of a clone
+type AuthOptions struct {
+	Username         string // 사용자 이름 (HTTPS용)
+	Password         string // 비밀번호 또는 토큰 (HTTPS용)
+	SSHKeyPath       string // SSH 개인 키 경로 (비어있으면 ssh-agent 사용)
+	SSHKeyPassphrase string // SSH 키 암호 (선택적)
+}
+
+// Signature is the name, email, and time of a commit author, committer, or tagger
+type Signature struct {
+	Name  string
+	Email string
+	When  time.Time // zero면 현재 시각
+}
+
+// GraphOptions controls Client.GetCommitGraph
+type GraphOptions struct {
+	Since      string // 이 revision(태그, 브랜치, 커밋)에서 도달 가능한 커밋 제외 (비어있으면 전체)
+	MaxCommits int    // 최대 커밋 수 (0 = 제한 없음)
+}
+
+// LogOptions controls Client.Log
+type LogOptions struct {
+	Ref     string         // 시작 revision (비어있으면 HEAD)
+	Since   time.Time      // 이 시각 이후의 커밋만 (zero면 제한 없음)
+	Author  string         // 작성자 이름 또는 이메일에 포함된 문자열 (대소문자 무시, 선택적)
+	Max     int            // 최대 커밋 수 (0 = 제한 없음)
+	Grep    *regexp.Regexp // 커밋 메시지가 일치하는 커밋만 (선택적)
+	Pickaxe string         // 이 문자열의 등장 횟수를 바꾼 커밋만 (git log -S, 선택적)
+	Paths   []string       // 이 경로 패턴 중 하나와 일치하는 파일을 바꾼 커밋만 (선택적, '**' 지원)
+}
+
+// LogEntry is a commit listed by Client.Log
+type LogEntry struct {
+	Hash    string    `json:"hash"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body,omitempty"`
+	Files   []string  `json:"files,omitempty"` // LogOptions.Paths와 일치하는 변경된 파일
+}
+
+// TagInfo is a tag listed by Client.ListTagInfo
+type TagInfo struct {
+	Name      string // 태그 이름
+	Commit    string // 태그가 가리키는 커밋 해시 (annotated tag는 peel된 커밋)
+	Annotated bool   // annotated tag 여부
+}
+
+// CommitGraph holds the commits, tags, and branch tips of a repository
+type CommitGraph struct {
+	Head      string       `json:"head"`                // HEAD 커밋
+	Since     string       `json:"since,omitempty"`     // GraphOptions.Since가 가리키는 커밋
+	Branches  []RefTip     `json:"branches"`            // 로컬 브랜치 끝
+	Tags      []RefTip     `json:"tags"`                // 태그 (annotated tag는 대상 커밋으로 변환)
+	Commits   []CommitInfo `json:"commits"`             // 최신순 커밋 목록
+	Truncated bool         `json:"truncated,omitempty"` // MaxCommits로 잘렸는지 여부
+}
+
+// RefTip is a branch or tag and the commit it points to
+type RefTip struct {
+	Name   string `json:"name"`
+	Commit string `json:"commit"`
+}
+
+// CommitInfo is a single commit of a CommitGraph
+type CommitInfo struct {
+	Hash    string    `json:"hash"`
+	Parents []string  `json:"parents"`
+	Author  string    `json:"author"`
+	Email   string    `json:"email"`
+	Date    time.Time `json:"date"`
+	Subject string    `json:"subject"`
+}
+
+// Path returns the repository path
+func (c *Client) Path() string {
+	return c.client.Path()
+}
+
+// OpenRepository opens the go-git repository, for operations this client does not cover
+func (c *Client) OpenRepository() (*gogit.Repository, error) {
+	return c.client.OpenRepository()
+}
+
+// IsRepository checks if the path is a valid git repository
+func (c *Client) IsRepository() bool {
+	return c.client.IsRepository()
+}
+
+// GetCurrentBranch returns the name of the checked out branch
+func (c *Client) GetCurrentBranch() (string, error) {
+	return c.client.GetCurrentBranch()
+}
+
+// IsDetachedHead returns true if HEAD does not point to a branch
+func (c *Client) IsDetachedHead() (bool, error) {
+	return c.client.IsDetachedHead()
+}
+
+// ListBranches returns the local branches
+func (c *Client) ListBranches() ([]string, error) {
+	return c.client.ListBranches()
+}
+
+// ListRemoteBranches returns the branch names of a remote as of the last fetch
+func (c *Client) ListRemoteBranches(remoteName string) ([]string, error) {
+	return c.client.ListRemoteBranches(remoteName)
+}
+
+// BranchExists returns true if the local branch exists
+func (c *Client) BranchExists(branchName string) (bool, error) {
+	return c.client.BranchExists(branchName)
+}
+
+// RemoteBranchExists returns true if the remote branch exists (as of the last fetch)
+func (c *Client) RemoteBranchExists(remoteName, branchName string) (bool, error) {
+	return c.client.RemoteBranchExists(remoteName, branchName)
+}
+
+// CreateBranch creates a local branch at startPoint (HEAD if empty)
+func (c *Client) CreateBranch(name, startPoint string) error {
+	return c.client.CreateBranch(name, startPoint)
+}
+
+// DeleteBranch deletes a local branch
+func (c *Client) DeleteBranch(name string) error {
+	return c.client.DeleteBranch(name)
+}
+
+// HasLocalChanges returns true if the working tree has uncommitted changes
+func (c *Client) HasLocalChanges() (bool, error) {
+	return c.client.HasLocalChanges()
+}
+
+// StatusString returns a formatted string of the repository status
+func (c *Client) StatusString() (string, error) {
+	return c.client.StatusString()
+}
+
+// GetRemoteURL returns the URL of a remote
+func (c *Client) GetRemoteURL(remoteName string) (string, error) {
+	return c.client.GetRemoteURL(remoteName)
+}
+
+// ListRemoteNames returns the names of the configured remotes
+func (c *Client) ListRemoteNames() ([]string, error) {
+	return c.client.ListRemoteNames()
+}
+
+// Checkout checks out a branch
+func (c *Client) Checkout(opts *CheckoutOptions) error {
+	if opts == nil {
+		opts = &CheckoutOptions{}
+	}
+	return c.client.Checkout(&git.CheckoutOptions{Branch: opts.Branch, Create: opts.Create, Force: opts.Force, FetchFirst: opts.FetchFirst})
+}
+
+// Fetch fetches from a remote
+func (c *Client) Fetch(remoteName string) error {
+	return c.client.Fetch(remoteName)
+}
+
+// FetchWithOptions fetches from a remote with options
+func (c *Client) FetchWithOptions(opts *FetchOptions) error {
+	if opts == nil {
+		opts = &FetchOptions{}
+	}
+	return c.client.FetchWithOptions(&git.FetchOptions{Remote: opts.Remote, Prune: opts.Prune, Tags: opts.Tags})
+}
+
+// Pull pulls a branch from a remote
+func (c *Client) Pull(opts *PullOptions) error {
+	if opts == nil {
+		opts = &PullOptions{}
+	}
+	return c.client.Pull(&git.PullOptions{
+		Remote:     opts.Remote,
+		Branch:     opts.Branch,
+		Force:      opts.Force,
+		FetchFirst: opts.FetchFirst,
+		Progress:   opts.Progress,
+		Context:    opts.Context,
+	})
+}
+
+// Push pushes a branch to a remote
+func (c *Client) Push(opts *PushOptions) error {
+	if opts == nil {
+		opts = &PushOptions{}
+	}
+	return c.client.Push(&git.PushOptions{
+		Branch:       opts.Branch,
+		RemoteBranch: opts.RemoteBranch,
+		Remote:       opts.Remote,
+		Force:        opts.Force,
+		DryRun:       opts.DryRun,
+		Timeout:      opts.Timeout,
+	})
+}
+
+// Commit stages the changes selected by opts and commits them
+// Returns the hash of the new commit.
+func (c *Client) Commit(opts *CommitOptions) (string, error) {
+	if opts == nil {
+		opts = &CommitOptions{}
+	}
+	return c.client.Commit(&git.CommitOptions{
+		Message:    opts.Message,
+		AddAll:     opts.AddAll,
+		Include:    opts.Include,
+		AllowEmpty: opts.AllowEmpty,
+		Signoff:    opts.Signoff,
+		Author:     opts.Author.toInternal(),
+		Committer:  opts.Committer.toInternal(),
+	})
+}
+
+// CreateTag creates a tag (and pushes it if opts.Push is set)
+func (c *Client) CreateTag(opts *TagOptions) error {
+	if opts == nil {
+		opts = &TagOptions{}
+	}
+	return c.client.CreateTag(&git.TagOptions{
+		Name:      opts.Name,
+		Message:   opts.Message,
+		Annotated: opts.Annotated,
+		Force:     opts.Force,
+		Push:      opts.Push,
+		Ref:       opts.Ref,
+		Tagger:    opts.Tagger.toInternal(),
+	})
+}
+
+// DeleteTag deletes a local tag
+func (c *Client) DeleteTag(tagName string) error {
+	return c.client.DeleteTag(tagName)
+}
+
+// TagExists returns true if the local tag exists
+func (c *Client) TagExists(tagName string) (bool, error) {
+	return c.client.TagExists(tagName)
+}
+
+// ListTags returns all tag names
+func (c *Client) ListTags() ([]string, error) {
+	return c.client.ListTags()
+}
+
+// ListTagInfo returns all tags sorted by name with the commit they point to
+// If contains is set, only tags whose commit contains that revision are returned.
+func (c *Client) ListTagInfo(contains string) ([]TagInfo, error) {
+	tags, err := c.client.ListTagInfo(contains)
+	if err != nil {
+		return nil, err
+	}
+	infos := make([]TagInfo, len(tags))
+	for i, tag := range tags {
+		infos[i] = TagInfo{Name: tag.Name, Commit: tag.Commit, Annotated: tag.Annotated}
+	}
+	return infos, nil
+}
+
+// PushTag pushes a tag to a remote
+func (c *Client) PushTag(tagName, remoteName string) error {
+	return c.client.PushTag(tagName, remoteName)
+}
+
+// DeleteRemoteTag deletes a tag from a remote
+func (c *Client) DeleteRemoteTag(tagName, remoteName string) error {
+	return c.client.DeleteRemoteTag(tagName, remoteName)
+}
+
+// RefHash returns the hash a local reference points to, or "" if it does not exist
+func (c *Client) RefHash(ref string) (string, error) {
+	return c.client.RefHash(ref)
+}
+
+// AheadBehind returns the number of commits ref is ahead of and behind base
+func (c *Client) AheadBehind(base, ref string) (ahead, behind int, err error) {
+	return c.client.AheadBehind(base, ref)
+}
+
+// Log lists the commits selected by opts, newest first
+func (c *Client) Log(opts *LogOptions) ([]LogEntry, error) {
+	if opts == nil {
+		opts = &LogOptions{}
+	}
+	entries, err := c.client.Log(&git.LogOptions{
+		Ref:     opts.Ref,
+		Since:   opts.Since,
+		Author:  opts.Author,
+		Max:     opts.Max,
+		Grep:    opts.Grep,
+		Pickaxe: opts.Pickaxe,
+		Paths:   opts.Paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	logs := make([]LogEntry, len(entries))
+	for i, e := range entries {
+		logs[i] = LogEntry{Hash: e.Hash, Author: e.Author, Email: e.Email, Date: e.Date, Subject: e.Subject, Body: e.Body, Files: e.Files}
+	}
+	return logs, nil
+}
+
+// GetCommitGraph collects the commits reachable from the local branch tips,
+// excluding those reachable from opts.Since
+func (c *Client) GetCommitGraph(opts *GraphOptions) (*CommitGraph, error) {
+	if opts == nil {
+		opts = &GraphOptions{}
+	}
+	graph, err := c.client.GetCommitGraph(&git.GraphOptions{Since: opts.Since, MaxCommits: opts.MaxCommits})
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]CommitInfo, len(graph.Commits))
+	for i, commit := range graph.Commits {
+		commits[i] = CommitInfo{
+			Hash:    commit.Hash,
+			Parents: commit.Parents,
+			Author:  commit.Author,
+			Email:   commit.Email,
+			Date:    commit.Date,
+			Subject: commit.Subject,
+		}
+	}
+	return &CommitGraph{
+		Head:      graph.Head,
+		Since:     graph.Since,
+		Branches:  refTipsFrom(graph.Branches),
+		Tags:      refTipsFrom(graph.Tags),
+		Commits:   commits,
+		Truncated: graph.Truncated,
+	}, nil
+}
+
+// refTipsFrom returns the public form of branch or tag tips
+func refTipsFrom(tips []git.RefTip) []RefTip {
+	public := make([]RefTip, len(tips))
+	for i, tip := range tips {
+		public[i] = RefTip{Name: tip.Name, Commit: tip.Commit}
+	}
+	return public
+}
+
+// toInternal returns the go-git signature, or nil if s is nil
+func (s *Signature) toInternal() *object.Signature {
+	if s == nil {
+		return nil
+	}
+	when := s.When
+	if when.IsZero() {
+		when = time.Now()
+	}
+	return &object.Signature{Name: s.Name, Email: s.Email, When: when}
+}
+
+// toInternal returns the internal clone options (empty ones if opts is nil)
+func (opts *CloneOptions) toInternal() *git.CloneOptions {
+	if opts == nil {
+		return &git.CloneOptions{}
+	}
+	cloneOpts := &git.CloneOptions{
+		Depth:        opts.Depth,
+		Branch:       opts.Branch,
+		SingleBranch: opts.SingleBranch,
+		Progress:     opts.Progress,
+		Context:      opts.Context,
+	}
+	if opts.Auth != nil {
+		cloneOpts.Auth = &git.AuthOptions{
+			Username:         opts.Auth.Username,
+			Password:         opts.Auth.Password,
+			SSHKeyPath:       opts.Auth.SSHKeyPath,
+			SSHKeyPassphrase: opts.Auth.SSHKeyPassphrase,
+		}
+	}
+	return cloneOpts
+}
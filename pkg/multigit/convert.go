@@ -0,0 +1,191 @@
+package multigit
+
+import (
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/repository"
+)
+
+// configFrom returns the public form of a loaded configuration
+func configFrom(cfg *config.Config) *Config {
+	repos := make([]Repository, len(cfg.Repositories))
+	for i, repo := range cfg.Repositories {
+		repos[i] = repositoryFrom(repo)
+	}
+	return &Config{
+		BaseDir:         cfg.BaseDir,
+		DefaultRemote:   cfg.DefaultRemote,
+		ParallelWorkers: cfg.ParallelWorkers,
+		RepoTimeout:     cfg.RepoTimeout,
+		MaxOpsPerSecond: cfg.MaxOpsPerSecond,
+		Auth:            AuthConfig(cfg.Auth),
+		Repositories:    repos,
+		loaded:          cfg,
+	}
+}
+
+// toInternal returns the internal configuration: the loaded one (if any) with the
+// public fields applied, and the defaults of the configuration file for unset fields
+func (c *Config) toInternal() *config.Config {
+	var cfg config.Config
+	var loaded []config.Repository
+	if c.loaded != nil {
+		cfg = *c.loaded
+		loaded = c.loaded.Repositories
+	}
+
+	cfg.BaseDir = c.BaseDir
+	cfg.DefaultRemote = c.DefaultRemote
+	if cfg.DefaultRemote == "" {
+		cfg.DefaultRemote = "origin"
+	}
+	cfg.ParallelWorkers = c.ParallelWorkers
+	if cfg.ParallelWorkers <= 0 {
+		cfg.ParallelWorkers = 3
+	}
+	cfg.RepoTimeout = c.RepoTimeout
+	cfg.MaxOpsPerSecond = c.MaxOpsPerSecond
+	cfg.Auth = config.AuthConfig(c.Auth)
+
+	cfg.Repositories = make([]config.Repository, len(c.Repositories))
+	for i, repo := range c.Repositories {
+		cfg.Repositories[i] = repo.toInternal(loaded)
+	}
+	return &cfg
+}
+
+// repositoryFrom returns the public form of a repository entry
+func repositoryFrom(repo config.Repository) Repository {
+	public := Repository{
+		Name:          repo.Name,
+		URL:           repo.URL,
+		Path:          repo.Path,
+		Groups:        append([]string(nil), repo.Groups...),
+		DefaultBranch: repo.DefaultBranch,
+	}
+	if repo.Auth != nil {
+		auth := AuthConfig(*repo.Auth)
+		public.Auth = &auth
+	}
+	return public
+}
+
+// toInternal returns the internal repository entry: the entry of the same name
+// in repos (keeping its settings from the configuration file) with the public fields applied
+func (r Repository) toInternal(repos []config.Repository) config.Repository {
+	var repo config.Repository
+	for _, loaded := range repos {
+		if loaded.Name == r.Name {
+			repo = loaded
+			break
+		}
+	}
+
+	repo.Name = r.Name
+	repo.URL = r.URL
+	repo.Path = r.Path
+	repo.Groups = append([]string(nil), r.Groups...)
+	repo.DefaultBranch = r.DefaultBranch
+	repo.Auth = nil
+	if r.Auth != nil {
+		auth := config.AuthConfig(*r.Auth)
+		repo.Auth = &auth
+	}
+	return repo
+}
+
+// internalTask adapts a TaskFunc to the task of the repository manager
+func internalTask(task TaskFunc) repository.TaskFunc {
+	return func(repo config.Repository) (repository.Result, error) {
+		result, err := task(repositoryFrom(repo))
+		return result.toInternal(), err
+	}
+}
+
+// resultFrom returns the public form of a result
+func resultFrom(r repository.Result) Result {
+	steps := make([]Step, len(r.Steps))
+	for i, step := range r.Steps {
+		steps[i] = Step{Name: step.Name, Status: step.Status, Error: convertError(step.Error), Message: step.Message, Duration: step.Duration}
+	}
+	if r.Steps == nil {
+		steps = nil
+	}
+	return Result{
+		RepoName: r.RepoName,
+		Status:   Status(r.Status),
+		Success:  r.Success,
+		Error:    convertError(r.Error),
+		Duration: r.Duration,
+		Message:  r.Message,
+		Details:  r.Details,
+		Steps:    steps,
+	}
+}
+
+// resultsFrom returns the public form of results
+func resultsFrom(results []repository.Result) []Result {
+	if results == nil {
+		return nil
+	}
+	public := make([]Result, len(results))
+	for i, r := range results {
+		public[i] = resultFrom(r)
+	}
+	return public
+}
+
+// toInternal returns the internal form of the result
+func (r *Result) toInternal() repository.Result {
+	var steps []repository.Step
+	for _, step := range r.Steps {
+		steps = append(steps, repository.Step{Name: step.Name, Status: step.Status, Error: step.Error, Message: step.Message, Duration: step.Duration})
+	}
+	return repository.Result{
+		RepoName: r.RepoName,
+		Status:   repository.Status(r.Status),
+		Success:  r.Success,
+		Error:    r.Error,
+		Duration: r.Duration,
+		Message:  r.Message,
+		Details:  r.Details,
+		Steps:    steps,
+	}
+}
+
+// summaryFrom returns the public form of a summary
+func summaryFrom(s *repository.Summary) *Summary {
+	return &Summary{
+		TotalCount:     s.TotalCount,
+		SuccessCount:   s.SuccessCount,
+		FailedCount:    s.FailedCount,
+		SkippedCount:   s.SkippedCount,
+		CancelledCount: s.CancelledCount,
+		TimedOutCount:  s.TimedOutCount,
+		TransientCount: s.TransientCount,
+		TotalDuration:  s.TotalDuration,
+		P50Duration:    s.P50Duration,
+		P95Duration:    s.P95Duration,
+		Results:        resultsFrom(s.Results),
+	}
+}
+
+// toInternal returns the internal form of the summary
+func (s *Summary) toInternal() *repository.Summary {
+	results := make([]repository.Result, len(s.Results))
+	for i := range s.Results {
+		results[i] = s.Results[i].toInternal()
+	}
+	return &repository.Summary{
+		TotalCount:     s.TotalCount,
+		SuccessCount:   s.SuccessCount,
+		FailedCount:    s.FailedCount,
+		SkippedCount:   s.SkippedCount,
+		CancelledCount: s.CancelledCount,
+		TimedOutCount:  s.TimedOutCount,
+		TransientCount: s.TransientCount,
+		TotalDuration:  s.TotalDuration,
+		P50Duration:    s.P50Duration,
+		P95Duration:    s.P95Duration,
+		Results:        results,
+	}
+}
@@ -0,0 +1,33 @@
+// Package multigit exposes multi-git's multi-repository orchestration as a library.
+//
+// A MultiGit is created from a validated configuration and runs a TaskFunc
+// against every configured repository, sequentially or in parallel:
+//
+//	cfg, err := multigit.LoadConfig("multi-git.yaml")
+//	if err != nil {
+//		return err
+//	}
+//	mg, err := multigit.New(cfg)
+//	if err != nil {
+//		return err
+//	}
+//	summary, err := mg.Run(ctx, func(repo multigit.Repository) (multigit.Result, error) {
+//		branch, err := mg.Client(repo).GetCurrentBranch()
+//		return multigit.Result{Success: true, Message: branch}, err
+//	})
+//
+// A task that returns an error fails with it; the Result returned with the error
+// is kept, so multi-step tasks can report the steps they completed (see NewSteps).
+// A task with nothing to do reports it with Result.Skip; results without a Status
+// are counted as succeeded or failed according to Success.
+//
+// CloneInMemory and CloneToStorage clone a repository into memory or any go-git
+// storage backend, so history can be analyzed without touching disk.
+//
+// A Config can also be built in code; LoadConfig additionally keeps the settings
+// of the file this package does not expose (hooks, policies, remotes, ...).
+//
+// The exported names in this package are stable. They are defined here and
+// converted to and from the internal packages the runs are built on, which
+// are not.
+package multigit
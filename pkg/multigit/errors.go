@@ -0,0 +1,160 @@
+package multigit
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+)
+
+// ConfigErrorType classifies a ConfigError
+type ConfigErrorType string
+
+// ConfigError types
+const (
+	ErrEmptyRepositories ConfigErrorType = "EMPTY_REPOSITORIES"
+	ErrInvalidURL        ConfigErrorType = "INVALID_URL"
+	ErrDuplicateName     ConfigErrorType = "DUPLICATE_NAME"
+	ErrPathConflict      ConfigErrorType = "PATH_CONFLICT"
+	ErrInvalidConfig     ConfigErrorType = "INVALID_CONFIG"
+)
+
+// ConfigError is returned when a configuration fails to load or validate
+// Use errors.As to inspect its Type and Field
+type ConfigError struct {
+	Type    ConfigErrorType
+	Message string
+	Field   string // 필드 이름 (선택적)
+	Cause   error  // 원본 에러 (선택적)
+}
+
+// Error implements the error interface
+func (e *ConfigError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s: %s (field: %s)", e.Type, e.Message, e.Field)
+	}
+	return fmt.Sprintf("%s: %s", e.Type, e.Message)
+}
+
+// Unwrap returns the underlying error
+func (e *ConfigError) Unwrap() error {
+	return e.Cause
+}
+
+// RepoErrorType classifies a RepoError
+type RepoErrorType string
+
+// RepoError types
+const (
+	ErrRepoNotFound    RepoErrorType = "REPO_NOT_FOUND"
+	ErrNotGitRepo      RepoErrorType = "NOT_GIT_REPO"
+	ErrBranchNotFound  RepoErrorType = "BRANCH_NOT_FOUND"
+	ErrTagExists       RepoErrorType = "TAG_EXISTS"
+	ErrTagNotFound     RepoErrorType = "TAG_NOT_FOUND"
+	ErrAuthFailed      RepoErrorType = "AUTH_FAILED"
+	ErrNetworkError    RepoErrorType = "NETWORK_ERROR"
+	ErrLocalChanges    RepoErrorType = "LOCAL_CHANGES"
+	ErrCloneFailed     RepoErrorType = "CLONE_FAILED"
+	ErrCheckoutFailed  RepoErrorType = "CHECKOUT_FAILED"
+	ErrPushFailed      RepoErrorType = "PUSH_FAILED"
+	ErrOperationFailed RepoErrorType = "OPERATION_FAILED"
+	ErrTimeout         RepoErrorType = "TIMEOUT"
+)
+
+// RepoError describes a failed operation on a repository
+// Use errors.As to inspect its Type and RepoName
+type RepoError struct {
+	Type     RepoErrorType // 에러 타입
+	RepoName string        // 저장소 이름
+	Message  string        // 에러 메시지
+	Cause    error         // 원본 에러
+}
+
+// Error implements the error interface
+func (e *RepoError) Error() string {
+	if e.RepoName != "" {
+		if e.Cause != nil {
+			return fmt.Sprintf("[%s] %s: %s (%v)", e.Type, e.RepoName, e.Message, e.Cause)
+		}
+		return fmt.Sprintf("[%s] %s: %s", e.Type, e.RepoName, e.Message)
+	}
+	if e.Cause != nil {
+		return fmt.Sprintf("[%s] %s (%v)", e.Type, e.Message, e.Cause)
+	}
+	return fmt.Sprintf("[%s] %s", e.Type, e.Message)
+}
+
+// Unwrap returns the underlying error
+func (e *RepoError) Unwrap() error {
+	return e.Cause
+}
+
+var (
+	// ErrNilConfig is returned by New when the configuration is nil
+	ErrNilConfig = errors.New("multigit: config is nil")
+	// ErrNoRepositories is returned by RunWithOptions when the selection matches no repository
+	ErrNoRepositories = errors.New("multigit: no repositories selected")
+	// ErrUnknownRepository is returned by RunWithOptions for a repository name not in the config
+	ErrUnknownRepository = errors.New("multigit: unknown repository")
+	// ErrFailFast is the Error of results cancelled by RunOptions.FailFast
+	ErrFailFast = repository.ErrFailFast
+	// ErrNotOnDisk is returned by clients of in-memory and storage clones for
+	// operations that need a working directory and the git binary
+	ErrNotOnDisk = git.ErrNotOnDisk
+)
+
+// convertError turns the internal error types in err into ConfigError and RepoError
+// An internal error wrapped by another error is left in place, and errors.As finds
+// its converted form through the wrapper.
+func convertError(err error) error {
+	switch e := err.(type) {
+	case *config.ConfigError:
+		return &ConfigError{Type: ConfigErrorType(e.Type), Message: e.Message, Field: e.Field, Cause: convertError(e.Cause)}
+	case *repository.RepoError:
+		return &RepoError{Type: RepoErrorType(e.Type), RepoName: e.RepoName, Message: e.Message, Cause: convertError(e.Cause)}
+	}
+
+	var configErr *config.ConfigError
+	var repoErr *repository.RepoError
+	if errors.As(err, &configErr) || errors.As(err, &repoErr) {
+		return &convertedError{err: err}
+	}
+	return err
+}
+
+// convertedError wraps an error chain holding internal error types so that
+// errors.As finds them as ConfigError and RepoError
+type convertedError struct {
+	err error
+}
+
+// Error implements the error interface
+func (e *convertedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *convertedError) Unwrap() error {
+	return e.err
+}
+
+// As converts the first internal error of the chain for errors.As
+func (e *convertedError) As(target any) bool {
+	switch target := target.(type) {
+	case **ConfigError:
+		var configErr *config.ConfigError
+		if errors.As(e.err, &configErr) {
+			*target = convertError(configErr).(*ConfigError)
+			return true
+		}
+	case **RepoError:
+		var repoErr *repository.RepoError
+		if errors.As(e.err, &repoErr) {
+			*target = convertError(repoErr).(*RepoError)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package multigit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/alexgim961101/multi-git/internal/credentials"
+	"github.com/alexgim961101/multi-git/internal/git"
+	"github.com/alexgim961101/multi-git/internal/repository"
+)
+
+// MultiGit runs tasks across the repositories of a configuration
+// It is safe for concurrent use; Run never modifies the configuration
+type MultiGit struct {
+	cfg config.Config
+}
+
+// RunOptions controls a single run
+type RunOptions struct {
+	Workers         int           // 병렬 작업 수 (0 = config parallel_workers, 1 = 순차 실행)
+	Groups          []string      // 이 그룹 중 하나에 속한 저장소만 (비어있으면 전체)
+	Repositories    []string      // 이 이름의 저장소만 (비어있으면 전체)
+	OnProgress      func()        // 저장소 하나가 끝날 때마다 호출 (선택적)
+	FailFast        bool          // 첫 실패 후 나머지 저장소는 실행하지 않고 Cancelled로 보고
+	RepoTimeout     time.Duration // 저장소별 제한 시간, 넘으면 ErrTimeout으로 보고 (0 = config repo_timeout)
+	MaxOpsPerSecond float64       // 초당 시작할 작업 수 (0 = config max_ops_per_second, 음수 = 제한 없음)
+}
+
+// LoadConfig loads and validates a configuration file
+// Errors are *ConfigError where validation failed
+func LoadConfig(path string) (*Config, error) {
+	cfg, err := config.LoadAndValidate(path)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return configFrom(cfg), nil
+}
+
+// LoadConfigProfile loads and validates a configuration file with the named profile applied
+// The profile overrides the top-level keys it sets; "" uses the top-level settings only.
+// Overlay files are applied in order after the profile: they override settings,
+// modify or add repositories by name, and drop the repositories listed under 'remove'.
+func LoadConfigProfile(path, profile string, overlays ...string) (*Config, error) {
+	cfg, err := config.LoadAndValidateProfile(path, profile, overlays...)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return configFrom(cfg), nil
+}
+
+// New creates a MultiGit from a configuration
+// The configuration is validated and copied; later changes to cfg have no effect
+func New(cfg *Config) (*MultiGit, error) {
+	if cfg == nil {
+		return nil, ErrNilConfig
+	}
+	internal := cfg.toInternal()
+	if err := config.ValidateConfig(internal); err != nil {
+		return nil, convertError(err)
+	}
+	return &MultiGit{cfg: *internal}, nil
+}
+
+// Repositories returns the configured repositories
+func (m *MultiGit) Repositories() []Repository {
+	repos := make([]Repository, len(m.cfg.Repositories))
+	for i, repo := range m.cfg.Repositories {
+		repos[i] = repositoryFrom(repo)
+	}
+	return repos
+}
+
+// Path returns the local path of a repository
+func (m *MultiGit) Path(repo Repository) string {
+	return config.GetRepositoryPath(m.repository(repo), m.cfg.BaseDir)
+}
+
+// IsCloned returns true if the repository exists locally as a git repository
+func (m *MultiGit) IsCloned(repo Repository) bool {
+	return repository.NewManager(&m.cfg).IsGitRepository(m.repository(repo))
+}
+
+// Client returns a git client for the repository with its configured credentials
+func (m *MultiGit) Client(repo Repository) *Client {
+	return &Client{client: credentials.NewClient(&m.cfg, m.repository(repo))}
+}
+
+// repository returns the configured entry of repo with the fields of repo applied
+func (m *MultiGit) repository(repo Repository) config.Repository {
+	return repo.toInternal(m.cfg.Repositories)
+}
+
+// CloneInMemory clones the repository into memory without a working tree
+// Nothing is written to disk, which suits ephemeral analysis jobs (history, branches,
+// tags, commit graph). Operations that need a working directory return ErrNotOnDisk.
+// The repository's configured credentials are used unless opts.Auth is set.
+func (m *MultiGit) CloneInMemory(repo Repository, opts *CloneOptions) (*Client, error) {
+	client, err := git.CloneInMemory(repo.URL, m.storageCloneOptions(repo, opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{client: client}, nil
+}
+
+// CloneToStorage clones the repository into a custom go-git storage backend
+// worktree is the filesystem files are checked out to; nil creates a bare clone.
+func (m *MultiGit) CloneToStorage(repo Repository, storer Storer, worktree Filesystem, opts *CloneOptions) (*Client, error) {
+	client, err := git.CloneToStorage(repo.URL, storer, worktree, m.storageCloneOptions(repo, opts))
+	if err != nil {
+		return nil, err
+	}
+	return &Client{client: client}, nil
+}
+
+// storageCloneOptions converts opts with the repository's credentials applied
+func (m *MultiGit) storageCloneOptions(repo Repository, opts *CloneOptions) *git.CloneOptions {
+	cloneOpts := opts.toInternal()
+	if cloneOpts.Auth == nil {
+		cloneOpts.Auth = credentials.GitAuth(&m.cfg, m.repository(repo))
+	}
+	return cloneOpts
+}
+
+// Run runs the task on all repositories using the configured parallelism
+func (m *MultiGit) Run(ctx context.Context, task TaskFunc) (*Summary, error) {
+	return m.RunWithOptions(ctx, task, nil)
+}
+
+// RunWithOptions runs the task on the repositories selected by opts
+// Results are returned in completion order for parallel runs. When ctx has a deadline,
+// repositories still running at the deadline are reported with an ErrTimeout error.
+func (m *MultiGit) RunWithOptions(ctx context.Context, task TaskFunc, opts *RunOptions) (*Summary, error) {
+	if opts == nil {
+		opts = &RunOptions{}
+	}
+
+	repos, err := m.selectRepositories(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// 실행마다 별도 설정 사본 사용
+	cfg := m.cfg
+	cfg.Repositories = repos
+	if opts.Workers > 0 {
+		cfg.ParallelWorkers = opts.Workers
+	}
+
+	var summary *repository.Summary
+	mgr := repository.NewManager(&cfg)
+	mgr.SetFailFast(opts.FailFast)
+	if opts.RepoTimeout > 0 {
+		mgr.SetRepoTimeout(opts.RepoTimeout)
+	} else {
+		mgr.SetRepoTimeout(cfg.RepoTimeout)
+	}
+	if opts.MaxOpsPerSecond != 0 {
+		mgr.SetRateLimit(opts.MaxOpsPerSecond)
+	} else {
+		mgr.SetRateLimit(cfg.MaxOpsPerSecond)
+	}
+	if mgr.ParallelWorkers() > 1 {
+		summary = mgr.ExecuteParallel(ctx, internalTask(task), opts.OnProgress)
+	} else {
+		summary = mgr.ExecuteSequential(ctx, internalTask(task), opts.OnProgress)
+	}
+	return summaryFrom(summary), nil
+}
+
+// selectRepositories applies the group and name filters of opts
+func (m *MultiGit) selectRepositories(opts *RunOptions) ([]config.Repository, error) {
+	repos := m.cfg.Repositories
+	if len(opts.Groups) > 0 {
+		repos = config.FilterByGroups(repos, opts.Groups)
+	}
+
+	if len(opts.Repositories) > 0 {
+		byName := make(map[string]config.Repository, len(repos))
+		for _, repo := range repos {
+			byName[repo.Name] = repo
+		}
+
+		selected := make([]config.Repository, 0, len(opts.Repositories))
+		for _, name := range opts.Repositories {
+			repo, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", ErrUnknownRepository, name)
+			}
+			selected = append(selected, repo)
+		}
+		repos = selected
+	}
+
+	if len(repos) == 0 {
+		return nil, ErrNoRepositories
+	}
+	return repos, nil
+}
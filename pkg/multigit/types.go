@@ -0,0 +1,257 @@
+package multigit
+
+import (
+	"time"
+
+	"github.com/alexgim961101/multi-git/internal/config"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-git/v5/storage"
+)
+
+// Config is a configuration of repositories to run tasks on
+// LoadConfig fills it from a configuration file and keeps the file's other settings
+// (hooks, policies, remotes, ...) for the runs; a Config built in code has only these fields.
+type Config struct {
+	BaseDir         string        // 저장소들의 기본 디렉토리
+	DefaultRemote   string        // 기본 원격 이름 (비어있으면 origin)
+	ParallelWorkers int           // 병렬 작업 수 (0 = 3)
+	RepoTimeout     time.Duration // 저장소별 제한 시간 (0 = 제한 없음)
+	MaxOpsPerSecond float64       // 초당 시작할 작업 수 (0 = 제한 없음)
+	Auth            AuthConfig    // 전역 인증 설정
+	Repositories    []Repository  // 저장소 목록
+
+	loaded *config.Config // 설정 파일에서 불러온 전체 설정 (LoadConfig로 만든 경우)
+}
+
+// Repository is a single repository entry of the configuration
+type Repository struct {
+	Name          string      // 저장소 이름 (필수)
+	URL           string      // 저장소 URL (필수)
+	Path          string      // 로컬 경로 (비어있으면 BaseDir 아래 Name)
+	Groups        []string    // 소속 그룹
+	DefaultBranch string      // 기본 브랜치 (비어있으면 원격 HEAD)
+	Auth          *AuthConfig // 저장소별 인증 (nil이면 Config.Auth)
+}
+
+// AuthConfig holds the credentials configured for remote operations
+type AuthConfig struct {
+	SSHKey              string // SSH 개인 키 경로
+	SSHKeyPassphraseEnv string // SSH 키 암호를 담은 환경 변수
+	Username            string // HTTPS 사용자 이름
+	Token               string // HTTPS 토큰 (가급적 TokenEnv 사용)
+	TokenEnv            string // HTTPS 토큰을 담은 환경 변수
+}
+
+// TaskFunc performs an operation on one repository and reports its Result
+type TaskFunc func(repo Repository) (Result, error)
+
+// Status is the outcome of a Result (success, failed, skipped, cancelled, timed out)
+type Status string
+
+// Result statuses
+const (
+	StatusSuccess   Status = "success"   // 작업 성공
+	StatusFailed    Status = "failed"    // 작업 실패 (Error에 원인)
+	StatusSkipped   Status = "skipped"   // 할 일이 없어 건너뜀 (Message에 이유)
+	StatusCancelled Status = "cancelled" // 취소됨 (fail-fast, 중단 등, 실패에 포함하지 않음)
+	StatusTimedOut  Status = "timed_out" // 저장소별 제한 시간 초과 (실패에 포함)
+)
+
+// Result is the outcome of a task on one repository
+// Tasks set Status for outcomes other than success and failure (see Skip); when it is
+// empty, it is filled in from Success.
+type Result struct {
+	RepoName string         // 저장소 이름
+	Status   Status         // 결과 상태
+	Success  bool           // 성공 여부 (성공 또는 스킵)
+	Error    error          // 에러 (실패 시)
+	Duration time.Duration  // 소요 시간
+	Message  string         // 추가 메시지 (선택적)
+	Details  map[string]any // 구조화된 추가 정보 (예: commits, files_changed)
+	Steps    []Step         // 여러 단계 작업의 단계별 결과
+}
+
+// Skip marks the result as skipped because there was nothing to do, with the reason
+func (r *Result) Skip(message string) {
+	r.Status = StatusSkipped
+	r.Success = true
+	r.Message = message
+}
+
+// IsSkipped returns true if this result represents a skipped operation
+func (r *Result) IsSkipped() bool {
+	return r.Status == StatusSkipped
+}
+
+// IsCancelled returns true if the repository was cancelled before or while running
+func (r *Result) IsCancelled() bool {
+	return r.Status == StatusCancelled
+}
+
+// SetDetail records a structured detail of the result (e.g. "commits": 3)
+func (r *Result) SetDetail(key string, value any) {
+	if r.Details == nil {
+		r.Details = make(map[string]any)
+	}
+	r.Details[key] = value
+}
+
+// DetailsString returns the details as "key: value" pairs sorted by key
+func (r *Result) DetailsString() string {
+	result := r.toInternal()
+	return result.DetailsString()
+}
+
+// FailedStep returns the step that failed, or nil if no step failed
+func (r *Result) FailedStep() *Step {
+	for i := range r.Steps {
+		if r.Steps[i].Status == StepFailed {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// StepsString returns the steps with their status (e.g. "checkout ✓ → create-tag ✓ → push ✗")
+func (r *Result) StepsString() string {
+	result := r.toInternal()
+	return result.StepsString()
+}
+
+// String returns a string representation of the result
+func (r *Result) String() string {
+	result := r.toInternal()
+	return result.String()
+}
+
+// Step status values
+const (
+	StepSucceeded = "succeeded"
+	StepFailed    = "failed"
+	StepSkipped   = "skipped"
+)
+
+// Step is the outcome of one step of a multi-step task, recorded in Result.Steps
+type Step struct {
+	Name     string        // 단계 이름 (예: checkout)
+	Status   string        // succeeded, failed, skipped
+	Error    error         // 에러 (실패 시)
+	Message  string        // 추가 메시지 (선택적, 스킵 사유 등)
+	Duration time.Duration // 소요 시간
+}
+
+// Steps records the steps of a multi-step task into its Result
+type Steps struct {
+	result *Result
+}
+
+// NewSteps returns a recorder that appends the steps of a multi-step task to result.Steps
+func NewSteps(result *Result) *Steps {
+	return &Steps{result: result}
+}
+
+// Run runs one step and records its outcome; the step's error is returned as is
+func (s *Steps) Run(name string, fn func() error) error {
+	startTime := time.Now()
+	err := fn()
+	step := Step{Name: name, Status: StepSucceeded, Duration: time.Since(startTime)}
+	if err != nil {
+		step.Status = StepFailed
+		step.Error = err
+	}
+	s.result.Steps = append(s.result.Steps, step)
+	return err
+}
+
+// Skip records a step that was not run, with the reason
+func (s *Steps) Skip(name, reason string) {
+	s.result.Steps = append(s.result.Steps, Step{Name: name, Status: StepSkipped, Message: reason})
+}
+
+// Summary aggregates the results of a run across repositories
+type Summary struct {
+	TotalCount     int           // 전체 저장소 개수
+	SuccessCount   int           // 성공한 저장소 개수
+	FailedCount    int           // 실패한 저장소 개수
+	SkippedCount   int           // 스킵된 저장소 개수
+	CancelledCount int           // 취소된 저장소 개수 (실패에 포함하지 않음)
+	TimedOutCount  int           // 실패 중 제한 시간을 넘긴 저장소 개수
+	TransientCount int           // 실패 중 일시적 실패 개수 (네트워크, 제한 시간)
+	TotalDuration  time.Duration // 총 소요 시간
+	P50Duration    time.Duration // 실행된 저장소 소요 시간의 중앙값 (스킵, 취소 제외)
+	P95Duration    time.Duration // 실행된 저장소 소요 시간의 95번째 백분위
+	Results        []Result      // 개별 결과 목록
+}
+
+// HasFailures returns true if there are any failed results
+func (s *Summary) HasFailures() bool {
+	return s.FailedCount > 0
+}
+
+// FailedResults returns only the failed results (excluding cancelled)
+func (s *Summary) FailedResults() []Result {
+	return s.filter(func(r *Result) bool { return !r.Success && !r.IsCancelled() })
+}
+
+// SuccessfulResults returns only the successful results (excluding skipped)
+func (s *Summary) SuccessfulResults() []Result {
+	return s.filter(func(r *Result) bool { return r.Status == StatusSuccess })
+}
+
+// SkippedResults returns only the skipped results
+func (s *Summary) SkippedResults() []Result {
+	return s.filter((*Result).IsSkipped)
+}
+
+// CancelledResults returns only the results of repositories that were cancelled
+func (s *Summary) CancelledResults() []Result {
+	return s.filter((*Result).IsCancelled)
+}
+
+// filter returns the results for which keep returns true
+func (s *Summary) filter(keep func(r *Result) bool) []Result {
+	var results []Result
+	for i := range s.Results {
+		if keep(&s.Results[i]) {
+			results = append(results, s.Results[i])
+		}
+	}
+	return results
+}
+
+// Slowest returns the n slowest results of the repositories the task ran on
+func (s *Summary) Slowest(n int) []Result {
+	return resultsFrom(s.toInternal().Slowest(n))
+}
+
+// StepTimings returns the duration percentiles of each recorded step, slowest first
+func (s *Summary) StepTimings() []StepTiming {
+	var timings []StepTiming
+	for _, timing := range s.toInternal().StepTimings() {
+		timings = append(timings, StepTiming(timing))
+	}
+	return timings
+}
+
+// String returns a string representation of the summary
+func (s *Summary) String() string {
+	return s.toInternal().String()
+}
+
+// StepTiming is the duration of one step across the repositories of a run (Summary.StepTimings)
+type StepTiming struct {
+	Name    string        // 단계 이름
+	Count   int           // 단계를 실행한 저장소 수
+	P50     time.Duration // 중앙값
+	P95     time.Duration // 95번째 백분위
+	Max     time.Duration // 가장 오래 걸린 시간
+	MaxRepo string        // 가장 오래 걸린 저장소
+}
+
+// Storage types for CloneToStorage
+type (
+	// Storer is a go-git storage backend (e.g. memory.NewStorage or filesystem.NewStorage)
+	Storer = storage.Storer
+	// Filesystem is the filesystem a storage clone checks out its working tree to
+	Filesystem = billy.Filesystem
+)
@@ -106,7 +106,12 @@ echo ""
 echo "빌드 중..."
 cd "$PROJECT_DIR"
 
-if go build -o "$BINARY_NAME" ./cmd/multi-git; then
+# 빌드 메타데이터 (multi-git info에 표시)
+COMMIT=$(git rev-parse --short HEAD 2>/dev/null || echo "unknown")
+BUILD_DATE=$(date -u +%Y-%m-%dT%H:%M:%SZ)
+LDFLAGS="-X main.commit=$COMMIT -X main.buildDate=$BUILD_DATE"
+
+if go build -ldflags "$LDFLAGS" -o "$BINARY_NAME" ./cmd/multi-git; then
     print_success "빌드 완료: $PROJECT_DIR/$BINARY_NAME"
 else
     print_error "빌드 실패"